@@ -0,0 +1,183 @@
+package tg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// OffsetStore keeps the ID of the last update, which is handled along with all the previous ones,
+// see LongPoller.Offsets.
+type OffsetStore interface {
+	// Load returns the saved ID, zero means there is none.
+	Load() (int, error)
+	// Save saves the ID.
+	Save(id int) error
+}
+
+// NewMemoryOffsetStore keeps the offset in memory, it is lost on restart,
+// but the updates are still confirmed to telegram only after they are handled.
+func NewMemoryOffsetStore() OffsetStore {
+	return &memoryOffsetStore{}
+}
+
+type memoryOffsetStore struct {
+	mu sync.Mutex
+	id int
+}
+
+func (store *memoryOffsetStore) Load() (int, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return store.id, nil
+}
+
+func (store *memoryOffsetStore) Save(id int) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.id = id
+	return nil
+}
+
+// NewFileOffsetStore keeps the offset in a JSON file, which is rewritten atomically on every save.
+func NewFileOffsetStore(path string) OffsetStore {
+	return &fileOffsetStore{path: path}
+}
+
+type fileOffsetStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+type offsetState struct {
+	UpdateID int `json:"update_id"`
+}
+
+func (store *fileOffsetStore) Load() (int, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	data, err := os.ReadFile(store.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("telebot: fileOffsetStore.Load: %v", err)
+	}
+
+	state := offsetState{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return 0, fmt.Errorf("telebot: fileOffsetStore.Load: %v", err)
+	}
+	return state.UpdateID, nil
+}
+
+func (store *fileOffsetStore) Save(id int) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	data, err := json.Marshal(offsetState{UpdateID: id})
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(store.path, data); err != nil {
+		return fmt.Errorf("telebot: fileOffsetStore.Save: %v", err)
+	}
+	return nil
+}
+
+// updateAcks tracks the updates, received by an at-least-once poller, until their handlers finish.
+// The poller holds a reference of an update until the Start loop has processed it,
+// every handler and every album buffer holds one more.
+type updateAcks struct {
+	mu      sync.Mutex
+	pending map[int]*updateAck
+	changed chan struct{}
+}
+
+type updateAck struct {
+	refs int
+	done chan struct{}
+}
+
+// track starts tracking the update, the reference is released with done.
+func (acks *updateAcks) track(id int) {
+	acks.mu.Lock()
+	defer acks.mu.Unlock()
+
+	if acks.pending == nil {
+		acks.pending = make(map[int]*updateAck)
+	}
+	ack, ok := acks.pending[id]
+	if !ok {
+		ack = &updateAck{done: make(chan struct{})}
+		acks.pending[id] = ack
+	}
+	ack.refs++
+}
+
+// begin adds a reference to the update, if it is tracked.
+func (acks *updateAcks) begin(id int) {
+	acks.mu.Lock()
+	defer acks.mu.Unlock()
+
+	if ack, ok := acks.pending[id]; ok {
+		ack.refs++
+	}
+}
+
+// done releases a reference to the update, if it is tracked.
+func (acks *updateAcks) done(id int) {
+	acks.mu.Lock()
+	defer acks.mu.Unlock()
+
+	ack, ok := acks.pending[id]
+	if !ok {
+		return
+	}
+	ack.refs--
+	if ack.refs > 0 {
+		return
+	}
+	delete(acks.pending, id)
+	close(ack.done)
+	if acks.changed != nil {
+		close(acks.changed)
+		acks.changed = nil
+	}
+}
+
+// busy tells, whether the update is still being handled.
+func (acks *updateAcks) busy(id int) bool {
+	acks.mu.Lock()
+	defer acks.mu.Unlock()
+
+	_, ok := acks.pending[id]
+	return ok
+}
+
+// wait returns a channel, which is closed as soon as the update is handled.
+func (acks *updateAcks) wait(id int) <-chan struct{} {
+	acks.mu.Lock()
+	defer acks.mu.Unlock()
+
+	if ack, ok := acks.pending[id]; ok {
+		return ack.done
+	}
+	done := make(chan struct{})
+	close(done)
+	return done
+}
+
+// next returns a channel, which is closed as soon as any of the updates is handled.
+func (acks *updateAcks) next() <-chan struct{} {
+	acks.mu.Lock()
+	defer acks.mu.Unlock()
+
+	if acks.changed == nil {
+		acks.changed = make(chan struct{})
+	}
+	return acks.changed
+}
@@ -0,0 +1,188 @@
+package tg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLongPollerOffsets(t *testing.T) {
+	mu := sync.Mutex{}
+	offsets := []int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := map[string]string{}
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &params)
+		offset, _ := strconv.Atoi(params["offset"])
+
+		mu.Lock()
+		offsets = append(offsets, offset)
+		mu.Unlock()
+
+		updates := []string{}
+		for id := max(offset, 1); id <= 3; id++ {
+			updates = append(updates, fmt.Sprintf(`{"update_id":%d,"message":{"message_id":%d,"chat":{"id":1},"text":"%d"}}`, id, id, id))
+		}
+		_, _ = fmt.Fprintf(w, `{"ok":true,"result":[%s]}`, bytes.Join(toBytes(updates), []byte(",")))
+	}))
+	defer srv.Close()
+
+	store := NewFileOffsetStore(filepath.Join(t.TempDir(), "offset.json"))
+	poller := &LongPoller{Offsets: store, PollTimeout: 5 * time.Millisecond}
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Poller: poller})
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	handled := sync.Map{}
+	b.Handle(OnText, func(c Context) error {
+		if c.Text() == "2" {
+			<-release
+		}
+		handled.Store(c.Text(), true)
+		return nil
+	})
+
+	go b.Start()
+	defer b.Stop()
+
+	assert.Eventually(t, func() bool {
+		_, ok := handled.Load("3")
+		offset, _ := store.Load()
+		return ok && offset == 1
+	}, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	offset, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 1, offset, "update 2 is in flight")
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return offsets[len(offsets)-1] == 4
+	}, time.Second, time.Millisecond)
+	offset, err = store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, 3, offset)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotContains(t, offsets, 3, "offset must not pass the in-flight update")
+}
+
+func TestWebhookReplyAfterHandling(t *testing.T) {
+	b, err := NewBot(Settings{Offline: true})
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	handled := make(chan struct{})
+	b.Handle(OnText, func(c Context) error {
+		<-release
+		close(handled)
+		return nil
+	})
+
+	hook := &Webhook{ReplyAfterHandling: true}
+	hook.attach(b, b.Updates)
+	go b.Start()
+	defer b.Stop()
+
+	replied := make(chan struct{})
+	go func() {
+		defer close(replied)
+		body := `{"update_id":7,"message":{"message_id":1,"chat":{"id":1},"text":"hi"}}`
+		hook.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body)))
+	}()
+
+	select {
+	case <-replied:
+		t.Fatal("replied before the handler finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	<-replied
+	select {
+	case <-handled:
+	default:
+		t.Fatal("handler is not finished")
+	}
+}
+
+func toBytes(ss []string) [][]byte {
+	ret := make([][]byte, len(ss))
+	for i, s := range ss {
+		ret[i] = []byte(s)
+	}
+	return ret
+}
+
+func TestLongPollerOffsetsRestart(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&params)
+		offset, _ := strconv.Atoi(params["offset"])
+
+		updates := []string{}
+		for id := max(offset, 1); id <= 2; id++ {
+			updates = append(updates, fmt.Sprintf(`{"update_id":%d,"message":{"message_id":%d,"chat":{"id":1},"text":"%d"}}`, id, id, id))
+		}
+		_, _ = fmt.Fprintf(w, `{"ok":true,"result":[%s]}`, bytes.Join(toBytes(updates), []byte(",")))
+	}))
+	defer srv.Close()
+
+	store := NewMemoryOffsetStore()
+	poller := &LongPoller{Offsets: store, PollTimeout: 5 * time.Millisecond}
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Poller: poller})
+	require.NoError(t, err)
+
+	mu := sync.Mutex{}
+	handled := map[string]int{}
+	blocked, release := make(chan struct{}, 2), make(chan struct{})
+	b.Handle(OnText, func(c Context) error {
+		if c.Text() == "2" {
+			blocked <- struct{}{}
+			<-release
+		}
+		mu.Lock()
+		handled[c.Text()]++
+		mu.Unlock()
+		return nil
+	})
+
+	go b.Start()
+	<-blocked
+	require.Eventually(t, func() bool {
+		offset, _ := store.Load()
+		return offset == 1
+	}, time.Second, time.Millisecond)
+
+	// update 2 is still in flight on stop, so it's not confirmed
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	report, _ := b.StopContext(ctx)
+	assert.Equal(t, 1, report.Unfinished)
+	close(release)
+
+	go b.Start()
+	defer b.Stop()
+	require.Eventually(t, func() bool {
+		offset, _ := store.Load()
+		return offset == 2
+	}, time.Second, time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]int{"1": 1, "2": 2}, handled, "update 2 is received again on restart")
+}
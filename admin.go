@@ -132,6 +132,11 @@ func (b *Bot) Ban(chat *Chat, member *ChatMember, revokeMessages ...bool) error
 // Unban will unban user from chat, who would have thought eh?
 // forBanned does nothing if the user is not banned.
 func (b *Bot) Unban(chat *Chat, user *User, forBanned ...bool) error {
+	return b.unban(nil, chat, user, forBanned...)
+}
+
+// unban is Unban, recorded by rec, see Bot.send.
+func (b *Bot) unban(rec *jobRecorder, chat *Chat, user *User, forBanned ...bool) error {
 	params := map[string]string{
 		"chat_id": chat.Recipient(),
 		"user_id": user.Recipient(),
@@ -141,7 +146,7 @@ func (b *Bot) Unban(chat *Chat, user *User, forBanned ...bool) error {
 		params["only_if_banned"] = strconv.FormatBool(forBanned[0])
 	}
 
-	_, err := b.Raw("unbanChatMember", params)
+	_, err := b.raw(rec, "unbanChatMember", params)
 	return err
 }
 
@@ -0,0 +1,373 @@
+package tg
+
+import (
+	"fmt"
+	"github.com/heilkit/tg/clock"
+	"sort"
+	"sync"
+	"time"
+)
+
+// AlbumPolicy configures, how HandleAlbum makes up albums, pass it among HandleAlbum options.
+type AlbumPolicy struct {
+	// Delay after the last message of an album before it's handled, defaulted to half a second.
+	Delay time.Duration
+
+	// MaxWait limits the time since the first message of an album before it's handled, 0 means no limit.
+	MaxWait time.Duration
+
+	// MaxSize makes an album handled as soon as it has that many messages.
+	// Defaulted to MaxAlbumSize, or no limit, if ByTime.
+	MaxSize int
+
+	// ByTime makes up albums of all the messages of a chat, coming within Delay, not by media groups.
+	ByTime bool
+
+	// Edits makes HandleAlbum handle OnEdited and OnEditedChannelPost as well.
+	// Edits of the messages, that are not handled yet, replace them in the album,
+	// edits of the handled media are handled as albums of a single message.
+	// The other edits are passed on to the handlers of OnEdited and OnEditedChannelPost,
+	// registered before HandleAlbum, the ones registered after it replace the album handler.
+	Edits bool
+
+	// OnFlush is called right before an album is handled.
+	OnFlush func(cs Contexts, reason FlushReason)
+}
+
+// FlushReason tells, why an album was handled.
+type FlushReason int
+
+const (
+	// FlushDelay -- no new messages of the album came within AlbumPolicy.Delay.
+	FlushDelay FlushReason = iota
+	// FlushMaxWait -- the album is waiting for longer than AlbumPolicy.MaxWait.
+	FlushMaxWait
+	// FlushMaxSize -- the album has AlbumPolicy.MaxSize messages.
+	FlushMaxSize
+	// FlushNewGroup -- a message of another media group came to the same chat.
+	FlushNewGroup
+	// FlushSingle -- the message is not a part of a media group, or it's an edit of a handled message.
+	FlushSingle
+	// FlushStop -- the aggregator is flushed on the bot stop.
+	FlushStop
+)
+
+func (reason FlushReason) String() string {
+	switch reason {
+	case FlushDelay:
+		return "delay"
+	case FlushMaxWait:
+		return "max_wait"
+	case FlushMaxSize:
+		return "max_size"
+	case FlushNewGroup:
+		return "new_group"
+	case FlushSingle:
+		return "single"
+	case FlushStop:
+		return "stop"
+	default:
+		return fmt.Sprintf("FlushReason(%d)", int(reason))
+	}
+}
+
+func (policy AlbumPolicy) defaults() AlbumPolicy {
+	if policy.Delay <= 0 {
+		policy.Delay = time.Second / 2
+	}
+	if policy.MaxSize <= 0 && !policy.ByTime {
+		policy.MaxSize = MaxAlbumSize
+	}
+	return policy
+}
+
+// albumBuffer is an album of a chat, which is not handled yet.
+type albumBuffer struct {
+	group    string
+	contexts []Context
+	first    time.Time
+	last     time.Time
+}
+
+func (buffer *albumBuffer) deadline(policy AlbumPolicy) (time.Time, FlushReason) {
+	deadline, reason := buffer.last.Add(policy.Delay), FlushDelay
+	if policy.MaxWait > 0 {
+		if maxWait := buffer.first.Add(policy.MaxWait); maxWait.Before(deadline) {
+			deadline, reason = maxWait, FlushMaxWait
+		}
+	}
+	return deadline, reason
+}
+
+// albumAggregator buffers messages per chat, until their media group is complete.
+// A chat has at most one buffer, a message of another group flushes it.
+// All buffers share a single timer, set to the nearest deadline.
+type albumAggregator struct {
+	bot     *Bot
+	handler AlbumHandlerFunc
+	policy  AlbumPolicy
+	clock   clock.Clock
+
+	// endpoints are the ones of the aggregator, edits are the previous handlers of the edits
+	endpoints map[string]bool
+	edits     map[string]HandlerFunc
+
+	mu      sync.Mutex
+	buffers map[int64]*albumBuffer
+	timer   clock.Timer
+	timerAt time.Time
+}
+
+func newAlbumAggregator(b *Bot, handler AlbumHandlerFunc, policy AlbumPolicy, clk clock.Clock) *albumAggregator {
+	if clk == nil {
+		clk = b.clock
+	}
+	return &albumAggregator{
+		bot:     b,
+		handler: handler,
+		policy:  policy.defaults(),
+		clock:   clk,
+
+		endpoints: make(map[string]bool),
+		edits:     make(map[string]HandlerFunc),
+		buffers:   make(map[int64]*albumBuffer),
+	}
+}
+
+type albumFlush struct {
+	contexts Contexts
+	reason   FlushReason
+}
+
+func (aggr *albumAggregator) add(ctx Context) error {
+	msg := ctx.Message()
+	if msg == nil {
+		return nil
+	}
+	if ctx.Update().EditedMessage != nil || ctx.Update().EditedChannelPost != nil {
+		return aggr.edit(ctx)
+	}
+	// the update is handled, when its album is
+	aggr.bot.acks.begin(ctx.Update().ID)
+
+	chatID := albumChatID(msg)
+	group := albumGroupID(msg, aggr.policy.ByTime)
+	now := aggr.clock.Now()
+
+	flushes := []albumFlush{}
+	aggr.mu.Lock()
+	buffer := aggr.buffers[chatID]
+	if buffer != nil && buffer.group != group {
+		delete(aggr.buffers, chatID)
+		flushes = append(flushes, albumFlush{buffer.contexts, FlushNewGroup})
+		buffer = nil
+	}
+
+	switch {
+	case group == "":
+		flushes = append(flushes, albumFlush{Contexts{ctx}, FlushSingle})
+	case buffer == nil:
+		buffer = &albumBuffer{group: group, contexts: Contexts{ctx}, first: now, last: now}
+		aggr.buffers[chatID] = buffer
+	default:
+		buffer.contexts = append(buffer.contexts, ctx)
+		buffer.last = now
+	}
+	if buffer != nil && aggr.policy.MaxSize > 0 && len(buffer.contexts) >= aggr.policy.MaxSize {
+		delete(aggr.buffers, chatID)
+		flushes = append(flushes, albumFlush{buffer.contexts, FlushMaxSize})
+	}
+	aggr.scheduleLocked()
+	aggr.mu.Unlock()
+
+	aggr.handle(flushes)
+	return nil
+}
+
+// edit replaces the buffered message with its edited version, or handles it on its own.
+func (aggr *albumAggregator) edit(ctx Context) error {
+	msg := ctx.Message()
+	if !aggr.owns(msg) {
+		end := OnEdited
+		if ctx.Update().EditedChannelPost != nil {
+			end = OnEditedChannelPost
+		}
+		if handler := aggr.edits[end]; handler != nil {
+			return handler(ctx)
+		}
+		return nil
+	}
+	aggr.bot.acks.begin(ctx.Update().ID)
+
+	aggr.mu.Lock()
+	if buffer, ok := aggr.buffers[albumChatID(msg)]; ok {
+		for i, buffered := range buffer.contexts {
+			if buffered.Message().ID == msg.ID {
+				buffer.contexts[i] = ctx
+				aggr.mu.Unlock()
+				aggr.bot.acks.done(buffered.Update().ID)
+				return nil
+			}
+		}
+	}
+	aggr.mu.Unlock()
+
+	aggr.handle([]albumFlush{{Contexts{ctx}, FlushSingle}})
+	return nil
+}
+
+// owns tells, whether the message would be handled by the aggregator, if it was a new one.
+func (aggr *albumAggregator) owns(msg *Message) bool {
+	var end string
+	switch {
+	case msg.Photo != nil:
+		end = OnPhoto
+	case msg.Voice != nil:
+		end = OnVoice
+	case msg.Audio != nil:
+		end = OnAudio
+	case msg.Animation != nil:
+		end = OnAnimation
+	case msg.Document != nil:
+		end = OnDocument
+	case msg.Sticker != nil:
+		end = OnSticker
+	case msg.Video != nil:
+		end = OnVideo
+	case msg.VideoNote != nil:
+		end = OnVideoNote
+	case msg.Text != "":
+		return aggr.endpoints[OnText]
+	default:
+		return false
+	}
+	return aggr.endpoints[end] || aggr.endpoints[OnMedia]
+}
+
+// scheduleLocked sets the timer to the nearest deadline.
+func (aggr *albumAggregator) scheduleLocked() {
+	next := time.Time{}
+	for _, buffer := range aggr.buffers {
+		if deadline, _ := buffer.deadline(aggr.policy); next.IsZero() || deadline.Before(next) {
+			next = deadline
+		}
+	}
+
+	if next.Equal(aggr.timerAt) && aggr.timer != nil {
+		return
+	}
+	if aggr.timer != nil {
+		aggr.timer.Stop()
+		aggr.timer = nil
+	}
+	aggr.timerAt = next
+	if !next.IsZero() {
+		aggr.timer = aggr.clock.AfterFunc(next.Sub(aggr.clock.Now()), aggr.tick)
+	}
+}
+
+// tick flushes the buffers, which deadlines have passed.
+func (aggr *albumAggregator) tick() {
+	now := aggr.clock.Now()
+	flushes := []albumFlush{}
+
+	aggr.mu.Lock()
+	aggr.timer = nil
+	aggr.timerAt = time.Time{}
+	for chatID, buffer := range aggr.buffers {
+		if deadline, reason := buffer.deadline(aggr.policy); !deadline.After(now) {
+			delete(aggr.buffers, chatID)
+			flushes = append(flushes, albumFlush{buffer.contexts, reason})
+		}
+	}
+	aggr.scheduleLocked()
+	aggr.mu.Unlock()
+
+	aggr.handle(flushes)
+}
+
+// flush handles all the buffered albums right away, returning their number.
+func (aggr *albumAggregator) flush() int {
+	flushes := []albumFlush{}
+
+	aggr.mu.Lock()
+	for chatID, buffer := range aggr.buffers {
+		delete(aggr.buffers, chatID)
+		flushes = append(flushes, albumFlush{buffer.contexts, FlushStop})
+	}
+	aggr.scheduleLocked()
+	aggr.mu.Unlock()
+
+	aggr.handle(flushes)
+	return len(flushes)
+}
+
+// handle runs the album handler the way the other handlers are run, see Bot.run, so the albums
+// of different chats don't wait for each other. The albums of a chat are handled in order.
+func (aggr *albumAggregator) handle(flushes []albumFlush) {
+	chats := []int64{}
+	byChat := make(map[int64][]albumFlush)
+	for _, flush := range flushes {
+		chatID := albumChatID(flush.contexts[0].Message())
+		if _, ok := byChat[chatID]; !ok {
+			chats = append(chats, chatID)
+		}
+		byChat[chatID] = append(byChat[chatID], flush)
+	}
+
+	for _, chatID := range chats {
+		flushes := byChat[chatID]
+		aggr.bot.inflight.add()
+		release := func() {
+			for _, flush := range flushes {
+				for _, c := range flush.contexts {
+					aggr.bot.acks.done(c.Update().ID)
+				}
+			}
+			aggr.bot.inflight.done()
+		}
+		aggr.bot.run(flushes[0].contexts[0], func() {
+			defer release()
+			for _, flush := range flushes {
+				aggr.handleFlush(flush)
+			}
+		}, release)
+	}
+}
+
+func (aggr *albumAggregator) handleFlush(flush albumFlush) {
+	contexts := flush.contexts
+	sort.SliceStable(contexts, func(i, j int) bool { return contexts[i].Message().ID < contexts[j].Message().ID })
+
+	defer func() {
+		if r := recover(); r != nil {
+			aggr.bot.OnError(fmt.Errorf("panic at tg.albumAggregator.handler: %v", r), contexts[0])
+		}
+	}()
+
+	if aggr.policy.OnFlush != nil {
+		aggr.policy.OnFlush(contexts, flush.reason)
+	}
+	if err := aggr.handler(contexts); err != nil {
+		aggr.bot.OnError(err, contexts[0])
+	}
+}
+
+func albumChatID(msg *Message) int64 {
+	if msg.Chat == nil {
+		return 0
+	}
+	return msg.Chat.ID
+}
+
+// albumGroupID returns the group of the message within its chat, "" means the message is handled on its own.
+func albumGroupID(msg *Message, byTime bool) string {
+	switch {
+	case byTime:
+		return "time"
+	case msg.AlbumID != "":
+		return msg.AlbumID
+	default:
+		return ""
+	}
+}
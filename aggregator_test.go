@@ -0,0 +1,189 @@
+package tg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type albumFlushRecord struct {
+	ids    []int
+	reason FlushReason
+}
+
+func TestAlbumAggregator(t *testing.T) {
+	b, err := NewBot(Settings{Synchronous: true, Offline: true})
+	require.NoError(t, err)
+
+	setup := func(policy AlbumPolicy) (*albumAggregator, *clocktest.Clock, *[]albumFlushRecord) {
+		clock := clocktest.New(time.Unix(0, 0))
+		records := &[]albumFlushRecord{}
+		policy.OnFlush = func(cs Contexts, reason FlushReason) {
+			ids := []int{}
+			for _, msg := range cs.Messages() {
+				ids = append(ids, msg.ID)
+			}
+			*records = append(*records, albumFlushRecord{ids, reason})
+		}
+		aggr := newAlbumAggregator(b, func(cs Contexts) error { return nil }, policy, clock)
+		aggr.endpoints[OnMedia] = true
+		return aggr, clock, records
+	}
+	message := func(chat int64, id int, album string) Context {
+		return b.NewContext(Update{Message: &Message{ID: id, Chat: &Chat{ID: chat}, AlbumID: album, Photo: &Photo{}}})
+	}
+
+	t.Run("Delay", func(t *testing.T) {
+		aggr, clock, records := setup(AlbumPolicy{Delay: time.Second})
+		aggr.add(message(1, 3, "a"))
+		clock.Advance(800 * time.Millisecond)
+		aggr.add(message(1, 2, "a"))
+		clock.Advance(800 * time.Millisecond)
+		assert.Empty(t, *records)
+
+		clock.Advance(200 * time.Millisecond)
+		assert.Equal(t, []albumFlushRecord{{[]int{2, 3}, FlushDelay}}, *records)
+		assert.Zero(t, clock.Waiters())
+	})
+
+	t.Run("NewGroup", func(t *testing.T) {
+		aggr, clock, records := setup(AlbumPolicy{})
+		aggr.add(message(1, 1, "a"))
+		aggr.add(message(2, 2, "b"))
+		aggr.add(message(1, 3, "c"))
+		aggr.add(message(1, 4, ""))
+		assert.Equal(t, []albumFlushRecord{
+			{[]int{1}, FlushNewGroup},
+			{[]int{3}, FlushNewGroup},
+			{[]int{4}, FlushSingle},
+		}, *records)
+		assert.Equal(t, 1, clock.Waiters(), "all the buffers share a single timer")
+
+		clock.Advance(time.Second)
+		assert.Equal(t, albumFlushRecord{[]int{2}, FlushDelay}, (*records)[3])
+	})
+
+	t.Run("MaxSize", func(t *testing.T) {
+		aggr, clock, records := setup(AlbumPolicy{MaxSize: 2})
+		aggr.add(message(1, 1, "a"))
+		aggr.add(message(1, 2, "a"))
+		assert.Equal(t, []albumFlushRecord{{[]int{1, 2}, FlushMaxSize}}, *records)
+		assert.Zero(t, clock.Waiters())
+	})
+
+	t.Run("MaxWait", func(t *testing.T) {
+		aggr, clock, records := setup(AlbumPolicy{Delay: time.Second, MaxWait: 2 * time.Second, ByTime: true})
+		for id := 1; id <= 5; id++ {
+			aggr.add(message(1, id, ""))
+			clock.Advance(600 * time.Millisecond)
+		}
+		assert.Equal(t, []albumFlushRecord{{[]int{1, 2, 3, 4}, FlushMaxWait}}, *records)
+	})
+
+	t.Run("Edits", func(t *testing.T) {
+		aggr, clock, records := setup(AlbumPolicy{Edits: true})
+		aggr.add(message(1, 1, "a"))
+		edited := b.NewContext(Update{EditedMessage: &Message{ID: 1, Chat: &Chat{ID: 1}, AlbumID: "a", Photo: &Photo{}, Caption: "edited"}})
+		aggr.add(edited)
+		assert.Empty(t, *records)
+
+		var handled Contexts
+		aggr.handler = func(cs Contexts) error {
+			handled = cs
+			return nil
+		}
+		clock.Advance(time.Second)
+		require.Len(t, handled, 1)
+		assert.Equal(t, "edited", handled.Message().Caption)
+
+		aggr.add(edited)
+		assert.Equal(t, FlushSingle, (*records)[1].reason)
+	})
+
+	t.Run("OtherEdits", func(t *testing.T) {
+		b, err := NewBot(Settings{Synchronous: true, Offline: true})
+		require.NoError(t, err)
+
+		edits := []string{}
+		b.Handle(OnEdited, func(c Context) error {
+			edits = append(edits, c.Message().Text)
+			return nil
+		})
+		albums := 0
+		b.HandleAlbum(func(cs Contexts) error {
+			albums++
+			return nil
+		}, OnPhoto, AlbumPolicy{Edits: true})
+
+		b.ProcessUpdate(Update{EditedMessage: &Message{ID: 1, Chat: &Chat{ID: 1}, Text: "text"}})
+		b.ProcessUpdate(Update{EditedMessage: &Message{ID: 2, Chat: &Chat{ID: 1}, Video: &Video{}, Text: "video"}})
+		b.ProcessUpdate(Update{EditedMessage: &Message{ID: 3, Chat: &Chat{ID: 1}, Photo: &Photo{}}})
+		assert.Equal(t, []string{"text", "video"}, edits, "the edits of the other messages are passed on")
+		assert.Equal(t, 1, albums)
+	})
+
+	t.Run("Flush", func(t *testing.T) {
+		aggr, clock, records := setup(AlbumPolicy{})
+		aggr.add(message(1, 1, "a"))
+		aggr.add(message(2, 2, "b"))
+		aggr.flush()
+		assert.Len(t, *records, 2)
+		assert.Equal(t, FlushStop, (*records)[0].reason)
+		assert.Zero(t, clock.Waiters())
+	})
+
+	t.Run("Chats", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true})
+		require.NoError(t, err)
+
+		// the album of the first chat waits for the one of the second, they are handled in parallel
+		clock := clocktest.New(time.Unix(0, 0))
+		second := make(chan struct{})
+		handled := make(chan int64, 2)
+		aggr := newAlbumAggregator(b, func(cs Contexts) error {
+			switch cs.Message().Chat.ID {
+			case 1:
+				select {
+				case <-second:
+				case <-time.After(time.Second):
+					t.Error("the albums are handled one by one")
+				}
+			case 2:
+				close(second)
+			}
+			handled <- cs.Message().Chat.ID
+			return nil
+		}, AlbumPolicy{}, clock)
+		aggr.endpoints[OnMedia] = true
+
+		aggr.add(message(1, 1, "a"))
+		aggr.add(message(2, 2, "b"))
+		clock.Advance(time.Second)
+		assert.Equal(t, int64(2), <-handled)
+		assert.Equal(t, int64(1), <-handled)
+	})
+}
+
+func TestHandleAlbumOptions(t *testing.T) {
+	b, err := NewBot(Settings{Synchronous: true, Offline: true})
+	require.NoError(t, err)
+	handler := func(cs Contexts) error { return nil }
+
+	aggr := b.Group().handleAlbum(handler, nil, time.Second, AlbumPolicy{MaxSize: 3}, HandleAlbumByTimeOption)
+	assert.Equal(t, time.Second, aggr.policy.Delay)
+	assert.Equal(t, 3, aggr.policy.MaxSize)
+	assert.True(t, aggr.policy.ByTime)
+
+	aggr = b.Group().handleAlbum(handler, nil, &AlbumPolicy{Delay: time.Second}, time.Second)
+	assert.Equal(t, time.Second, aggr.policy.Delay)
+
+	assert.Panics(t, func() {
+		b.Group().handleAlbum(handler, nil, time.Minute, AlbumPolicy{Delay: time.Second})
+	})
+	assert.Panics(t, func() {
+		b.Group().handleAlbum(handler, nil, AlbumPolicy{}, AlbumPolicy{ByTime: true})
+	})
+}
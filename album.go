@@ -4,24 +4,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"sort"
+	"github.com/heilkit/tg/clock"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 )
 
 // Messages are enhanced list of contexts.
 type Messages []Message
 
-// ForwardMessages request is retarded. The API returns list of message ids, not list of messages.
-// Bot.ForwardMessages returns list messages. They are not perfect, don't trust them, but could be useful still.
-func (b *Bot) ForwardMessages(to Recipient, messages []Message, opts ...interface{}) ([]Message, error) {
-	if to == nil {
-		return nil, ErrBadRecipient
-	}
+func (b *Bot) DeleteMessages(messages []Message, opts ...interface{}) error {
 	if len(messages) == 0 {
-		return nil, ErrEmptyMessage
+		return ErrEmptyMessage
 	}
 
 	_, chatID := messages[0].MessageSig()
@@ -31,197 +25,232 @@ func (b *Bot) ForwardMessages(to Recipient, messages []Message, opts ...interfac
 	}
 
 	params := map[string]string{
-		"chat_id":      to.Recipient(),
-		"from_chat_id": strconv.FormatInt(chatID, 10),
-		"message_ids":  fmt.Sprintf("[%s]", strings.Join(ids, ",")),
+		"chat_id":     strconv.FormatInt(chatID, 10),
+		"message_ids": fmt.Sprintf("[%s]", strings.Join(ids, ",")),
 	}
 
 	sendOpts := extractOptions(opts)
 	b.embedSendOptions(params, sendOpts)
 
-	data, err := b.Raw("forwardMessages", params)
+	data, err := b.Raw("deleteMessages", params)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var resp struct {
-		Result []struct {
-			MessageId int `json:"message_id"`
-		} `json:"result"`
+		Result bool `json:"result"`
 	}
 	if err := extractOk(data); err != nil {
-		return nil, err
+		return err
 	}
 	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, err
+		return err
 	}
-
-	ret := []Message{}
-	toId := to.Recipient()
-	toChat := Chat{}
-	if id, err := strconv.ParseInt(toId, 10, 64); err == nil {
-		toChat.ID = id
-	} else {
-		toChat.Username = toId
-	}
-	// THIS WOULD ALMOST CERTAINLY HAVE BUGS.
-	// FUCK YOU TELEGRAM API, WHY COULDN'T YOU RETURN A LIST OF MESSAGES?
-	for i, msgID := range resp.Result {
-		msg := messages[i]
-		msg.ID = msgID.MessageId
-		msg.Sender = b.Me
-		toChat := toChat
-		msg.Chat = &toChat
-		msg.ReplyTo = nil
-		msg.OriginalMessageID = messages[i].ID
-
-		msg.OriginalUnixtime = int(messages[i].Unixtime)
-		msg.Unixtime = time.Now().Unix()
-
-		msg.OriginalSignature = messages[i].Signature
-		msg.Signature = ""
-
-		msg.OriginalChat = messages[i].Chat
-		msg.OriginalSender = messages[i].Sender
-
-		if sendOpts.ThreadID != 0 {
-			msg.ThreadID = sendOpts.ThreadID
-		}
-
-		ret = append(ret, msg)
+	// could this even happen?
+	if !resp.Result {
+		return ErrNotFoundToDelete
 	}
-	return ret, nil
+	return nil
 }
 
-// CopyMessages request is retarded. The API returns list of message ids, not list of messages.
-// Bot.CopyMessages returns list messages. They are not perfect, don't trust them, but could be useful still.
-func (b *Bot) CopyMessages(to Recipient, messages []Message, opts ...interface{}) ([]Message, error) {
-	if to == nil {
-		return nil, ErrBadRecipient
+// Album lets you group multiple media into a single message.
+type Album []Inputtable
+
+func (album Album) WithCaption(text string) Album {
+	if len(album) == 0 {
+		return album
 	}
-	if len(messages) == 0 {
-		return nil, ErrEmptyMessage
+	album[0].WithCaption(text)
+	if len(album) > 1 {
+		for _, media := range album[1:] {
+			media.WithCaption("")
+		}
 	}
+	return album
+}
 
-	_, chatID := messages[0].MessageSig()
-	ids := []string{}
-	for _, msg := range messages {
-		ids = append(ids, strconv.FormatInt(int64(msg.ID), 10))
-	}
+// MaxAlbumSize is the maximum number of items in a single media group.
+const MaxAlbumSize = 10
 
-	params := map[string]string{
-		"chat_id":      to.Recipient(),
-		"from_chat_id": strconv.FormatInt(chatID, 10),
-		"message_ids":  fmt.Sprintf("[%s]", strings.Join(ids, ",")),
-	}
+// AlbumCaption defines, where the album caption goes, when the album is sent in multiple chunks.
+type AlbumCaption int
 
-	sendOpts := extractOptions(opts)
-	b.embedSendOptions(params, sendOpts)
+const (
+	// CaptionEachChunk repeats the album caption on the first item of each chunk,
+	// unless the item has a caption of its own.
+	CaptionEachChunk AlbumCaption = iota
 
-	data, err := b.Raw("copyMessages", params)
-	if err != nil {
-		return nil, err
-	}
+	// CaptionFirstChunk sends captions as they are, so the album caption only shows up on the first chunk.
+	CaptionFirstChunk
+)
 
-	var resp struct {
-		Result []struct {
-			MessageId int `json:"message_id"`
-		} `json:"result"`
-	}
-	if err := extractOk(data); err != nil {
-		return nil, err
-	}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, err
+// AlbumOptions configure SendAlbum, pass them along with the other send options.
+type AlbumOptions struct {
+	// Caption defines, how the album caption is spread over the chunks, CaptionEachChunk by default.
+	Caption AlbumCaption
+
+	// ChunkSize is the maximum number of items in a chunk, MaxAlbumSize by default.
+	ChunkSize int
+}
+
+func (opts AlbumOptions) chunkSize() int {
+	if opts.ChunkSize <= 0 || opts.ChunkSize > MaxAlbumSize {
+		return MaxAlbumSize
 	}
+	return opts.ChunkSize
+}
 
-	ret := []Message{}
-	toId := to.Recipient()
-	toChat := Chat{}
-	if id, err := strconv.ParseInt(toId, 10, 64); err == nil {
-		toChat.ID = id
-	} else {
-		toChat.Username = toId
-	}
-	// THIS WOULD ALMOST CERTAINLY HAVE BUGS.
-	// FUCK YOU TELEGRAM API, WHY COULDN'T YOU RETURN A LIST OF MESSAGES?
-	for i, msgID := range resp.Result {
-		msg := messages[i]
-		msg.ID = msgID.MessageId
-		toChat := toChat
-		msg.Chat = &toChat
-		msg.ReplyTo = sendOpts.ReplyTo
-		msg.Sender = b.Me
-
-		if sendOpts.RemoveCaption {
-			msg.Text = ""
-			msg.Entities = nil
-			msg.Caption = ""
-			msg.CaptionEntities = nil
-		}
-		if sendOpts.ThreadID != 0 {
-			msg.ThreadID = sendOpts.ThreadID
+func extractAlbumOptions(how []interface{}) (AlbumOptions, []interface{}) {
+	opts := AlbumOptions{}
+	rest := make([]interface{}, 0, len(how))
+	for _, prop := range how {
+		switch opt := prop.(type) {
+		case AlbumOptions:
+			opts = opt
+		case *AlbumOptions:
+			if opt != nil {
+				opts = *opt
+			}
+		default:
+			rest = append(rest, prop)
 		}
-
-		ret = append(ret, msg)
 	}
-	return ret, nil
+	return opts, rest
 }
 
-func (b *Bot) DeleteMessages(messages []Message, opts ...interface{}) error {
-	if len(messages) == 0 {
-		return ErrEmptyMessage
+// AlbumChunkError is a chunk album[From:To], which failed to be sent.
+type AlbumChunkError struct {
+	From int
+	To   int
+	Err  error
+}
+
+// AlbumError is returned by SendAlbum, when some of the album chunks failed to be sent.
+type AlbumError struct {
+	Chunks []AlbumChunkError
+}
+
+func (err *AlbumError) Error() string {
+	reasons := make([]string, len(err.Chunks))
+	for i, chunk := range err.Chunks {
+		reasons[i] = fmt.Sprintf("[%d:%d]: %v", chunk.From, chunk.To, chunk.Err)
 	}
+	return fmt.Sprintf("telebot: failed to send %d album chunk(s): %s", len(err.Chunks), strings.Join(reasons, "; "))
+}
 
-	_, chatID := messages[0].MessageSig()
-	ids := []string{}
-	for _, msg := range messages {
-		ids = append(ids, strconv.FormatInt(int64(msg.ID), 10))
+func (err *AlbumError) Unwrap() []error {
+	errs := make([]error, len(err.Chunks))
+	for i, chunk := range err.Chunks {
+		errs[i] = chunk.Err
 	}
+	return errs
+}
 
-	params := map[string]string{
-		"chat_id":     strconv.FormatInt(chatID, 10),
-		"message_ids": fmt.Sprintf("[%s]", strings.Join(ids, ",")),
+// albumGroup returns the kind of media, the item could be grouped with, or "" if it has to be sent alone.
+func albumGroup(med Inputtable) string {
+	switch med.(type) {
+	case *Photo, *Video:
+		return "visual"
+	case *Document:
+		return "document"
+	case *Audio:
+		return "audio"
+	default:
+		return ""
 	}
+}
 
-	sendOpts := extractOptions(opts)
-	b.embedSendOptions(params, sendOpts)
+// chunkAlbum splits the album into valid media groups, preserving the order of items.
+// Runs of compatible items are split evenly, so 11 photos become 6+5 rather than 10+1.
+func chunkAlbum(album Album, size int) [][2]int {
+	chunks := [][2]int{}
+	for from := 0; from < len(album); {
+		group := albumGroup(album[from])
+		to := from + 1
+		for group != "" && to < len(album) && albumGroup(album[to]) == group {
+			to++
+		}
 
-	data, err := b.Raw("deleteMessages", params)
-	if err != nil {
-		return err
+		n := to - from
+		parts := (n + size - 1) / size
+		for i := 0; i < parts; i++ {
+			length := n / parts
+			if i < n%parts {
+				length++
+			}
+			chunks = append(chunks, [2]int{from, from + length})
+			from += length
+		}
 	}
+	return chunks
+}
 
-	var resp struct {
-		Result bool `json:"result"`
+func (b *Bot) sendAlbumChunked(to Recipient, album Album, albumOpts AlbumOptions, sendOpts *SendOptions) ([]Message, error) {
+	media := make([]Media, len(album))
+	for i, med := range album {
+		media[i] = med
 	}
-	if err := extractOk(data); err != nil {
-		return err
+	cleanup, err := b.PrepareMedia(media...)
+	if err != nil {
+		return nil, err
 	}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return err
+	defer cleanup()
+
+	caption := album[0].InputMedia().Caption
+	chunks := chunkAlbum(album, albumOpts.chunkSize())
+
+	sent := []Message{}
+	albumErr := &AlbumError{}
+	for i, chunk := range chunks {
+		opts := sendOpts.copy()
+		if opts.ReplyTo == nil && len(sent) > 0 {
+			first := sent[0]
+			opts.ReplyTo = &first
+		}
+
+		chunkCaption := ""
+		if i > 0 && albumOpts.Caption == CaptionEachChunk {
+			chunkCaption = caption
+		}
+
+		msgs, err := b.sendAlbumChunk(to, album[chunk[0]:chunk[1]], chunkCaption, opts)
+		if err != nil {
+			albumErr.Chunks = append(albumErr.Chunks, AlbumChunkError{From: chunk[0], To: chunk[1], Err: err})
+			continue
+		}
+		sent = append(sent, msgs...)
 	}
-	// could this even happen?
-	if !resp.Result {
-		return ErrNotFoundToDelete
+
+	switch {
+	case len(albumErr.Chunks) == 0:
+		return sent, nil
+	case len(chunks) == 1:
+		return nil, albumErr.Chunks[0].Err
+	default:
+		return sent, albumErr
 	}
-	return nil
 }
 
-// Album lets you group multiple media into a single message.
-type Album []Inputtable
+func (b *Bot) sendAlbumChunk(to Recipient, chunk Album, caption string, opts *SendOptions) ([]Message, error) {
+	if len(chunk) > 1 {
+		return b.sendMediaGroup(to, chunk, []string{caption}, opts)
+	}
 
-func (album Album) WithCaption(text string) Album {
-	if len(album) == 0 {
-		return album
+	med := chunk[0]
+	sendable, ok := med.(Sendable)
+	if !ok {
+		return nil, ErrUnsupportedWhat
 	}
-	album[0].WithCaption(text)
-	if len(album) > 1 {
-		for _, media := range album[1:] {
-			media.WithCaption("")
-		}
+	if original := med.InputMedia().Caption; caption != "" && original == "" {
+		med.WithCaption(caption)
+		defer med.WithCaption(original)
 	}
-	return album
+
+	msg, err := sendable.Send(b, to, opts)
+	if err != nil {
+		return nil, err
+	}
+	return []Message{*msg}, nil
 }
 
 // Contexts are enhanced list of contexts.
@@ -271,263 +300,77 @@ func (f AlbumHandlerFunc) ToHandlerFunc() HandlerFunc {
 }
 
 // HandleAlbumByTimeOption instructs HandleAlbum function to make up albums by time, not by message grouping.
+// Same as AlbumPolicy.ByTime.
 const HandleAlbumByTimeOption = 2<<17 + 17
 
-// HandleAlbum opts -- MiddlewareFunc / endpoints (OnPhoto, OnVideo...) -- default=telebot.OnMedia.
+// HandleAlbum opts -- MiddlewareFunc / endpoints (OnPhoto, OnVideo...) / AlbumPolicy -- default=telebot.OnMedia.
 // I.e. bot.HandleAlbum(userHandler, telebot.OnPhoto, telebot.OnVideo, middleware.WhiteList(777)).
 // Sadly, there's no way to define both bot.Handle(telebot.OnPhoto,..) and bot.HandleAlbum(telebot.OnPhoto,..).
+// A time.Duration option is a shortcut for AlbumPolicy.Delay, it panics, if AlbumPolicy sets another one.
 func (b *Bot) HandleAlbum(handler AlbumHandlerFunc, opts ...interface{}) {
 	b.Group().HandleAlbum(handler, opts...)
 }
 
 func (g *Group) HandleAlbum(handler AlbumHandlerFunc, opts ...interface{}) {
+	g.handleAlbum(handler, nil, opts...)
+}
+
+func (g *Group) handleAlbum(handler AlbumHandlerFunc, clk clock.Clock, opts ...interface{}) *albumAggregator {
 	endpoints := make([]interface{}, 0)
 	middlewares := make([]MiddlewareFunc, 0)
-	handleByTime := false
-	delay := time.Second / 2
+	policy, policies := AlbumPolicy{}, 0
+	byTime, delay := false, time.Duration(0)
 	for _, opt := range opts {
 		switch o := opt.(type) {
 		case MiddlewareFunc:
 			middlewares = append(middlewares, o)
 		case int:
 			if o == HandleAlbumByTimeOption {
-				handleByTime = true
+				byTime = true
 			}
 		case time.Duration:
 			delay = o
+		case AlbumPolicy:
+			policy, policies = o, policies+1
+		case *AlbumPolicy:
+			policy, policies = *o, policies+1
 		default:
 			endpoints = append(endpoints, o)
 		}
 	}
+	// the shortcuts are merged into the policy, whatever order they come in
+	if policies > 1 {
+		panic("telebot: more than one AlbumPolicy option")
+	}
+	policy.ByTime = policy.ByTime || byTime
+	if delay != 0 {
+		if policy.Delay != 0 && policy.Delay != delay {
+			panic("telebot: time.Duration option conflicts with AlbumPolicy.Delay")
+		}
+		policy.Delay = delay
+	}
 	if len(endpoints) == 0 {
 		endpoints = append(endpoints, OnMedia)
 	}
-
-	var albumHandler handleManager
-	switch {
-	case handleByTime:
-		albumHandler = newTimeBasedManager(g.b, handler, delay)
-	case g.b.synchronous:
-		albumHandler = newSyncedManager(g.b, handler, delay)
-	default:
-		albumHandler = newUnsyncedManager(g.b, handler, delay)
-	}
-
+	aggregator := newAlbumAggregator(g.b, handler, policy, clk)
 	for _, endpoint := range endpoints {
-		g.Handle(endpoint, func(ctx Context) error {
-			return albumHandler.add(ctx)
-		}, middlewares...)
-	}
-}
-
-type handleManager interface {
-	add(ctx Context) error
-}
-
-var _ handleManager = &syncedManager{}
-var _ handleManager = &unsyncedManager{}
-var _ handleManager = &timeBasedManager{}
-
-func newTimeBasedManager(b *Bot, handler AlbumHandlerFunc, delay time.Duration) handleManager {
-	return &timeBasedManager{
-		bot:     b,
-		fn:      handler,
-		timeout: delay,
-		sync:    &sync.Mutex{},
-		data:    nil,
-	}
-}
-
-type timeBasedManager struct {
-	bot     *Bot
-	fn      AlbumHandlerFunc
-	timeout time.Duration
-	sync    *sync.Mutex
-
-	data []Context
-}
-
-func (mngr *timeBasedManager) add(ctx Context) error {
-	mngr.sync.Lock()
-	defer mngr.sync.Unlock()
-
-	if len(mngr.data) > 0 {
-		mngr.data = append(mngr.data, ctx)
-		return nil
-	}
-
-	mngr.data = append(mngr.data, ctx)
-	go time.AfterFunc(mngr.timeout, func() {
-		mngr.sync.Lock()
-		defer mngr.sync.Unlock()
-		defer func() {
-			if r := recover(); r != nil {
-				mngr.bot.onError(fmt.Errorf("panic at tg.timeBasedManager.fn: %v", r), nil)
-			}
-		}()
-
-		sort.Slice(mngr.data, func(i, j int) bool {
-			return mngr.data[i].Message().ID < mngr.data[j].Message().ID
-		})
-		if err := mngr.fn(mngr.data); err != nil {
-			mngr.bot.OnError(err, mngr.data[0])
+		if end, ok := endpoint.(string); ok {
+			aggregator.endpoints[end] = true
 		}
-	})
-	return nil
-}
-
-type syncedManager struct {
-	bot     *Bot
-	fn      AlbumHandlerFunc
-	delay   time.Duration
-	current string
-	ctx     []Context
-	sync    *sync.Mutex
-}
-
-func newSyncedManager(bot *Bot, fn AlbumHandlerFunc, delay time.Duration) *syncedManager {
-	return &syncedManager{
-		bot:     bot,
-		fn:      fn,
-		delay:   delay,
-		current: "",
-		ctx:     nil,
-		sync:    &sync.Mutex{},
 	}
-}
-
-func (mngr *syncedManager) delayHandling(id string) {
-	go time.AfterFunc(mngr.delay, func() {
-		mngr.sync.Lock()
-		defer mngr.sync.Unlock()
-		defer func() {
-			if r := recover(); r != nil {
-				mngr.bot.onError(fmt.Errorf("panic at tg.syncedManager.fn: %v", r), nil)
+	if policy.Edits {
+		for _, end := range []string{OnEdited, OnEditedChannelPost} {
+			if handler, ok := g.b.handler(end); ok {
+				aggregator.edits[end] = handler
 			}
-		}()
-
-		if len(mngr.ctx) == 0 {
-			return
-		}
-
-		if id != mngr.current {
-			return
+			endpoints = append(endpoints, end)
 		}
-
-		if err := mngr.fn(mngr.ctx); err != nil {
-			mngr.bot.onError(err, mngr.ctx[0])
-		}
-
-		mngr.current = ""
-		mngr.ctx = nil
-	})
-}
-
-func (mngr *syncedManager) add(ctx Context) (err error) {
-	mngr.sync.Lock()
-	defer mngr.sync.Unlock()
-
-	msg := ctx.Message()
-	id := mediaGroupToId(msg)
-	if mngr.current == id {
-		mngr.ctx = append(mngr.ctx, ctx)
-		return
 	}
-
-	if len(mngr.ctx) != 0 {
-		err = mngr.fn(mngr.ctx)
-	}
-	mngr.current = id
-	mngr.ctx = []Context{ctx}
-
-	mngr.delayHandling(id)
-
-	return
-}
-
-type handleSchedulerUnit struct {
-	delays int
-	ctx    []Context
-}
-
-type unsyncedManager struct {
-	handler         AlbumHandlerFunc
-	bot             *Bot
-	delay           time.Duration
-	unscheduled     map[string]handleSchedulerUnit
-	unscheduledSync *sync.Mutex
-}
-
-func newUnsyncedManager(bot *Bot, handler AlbumHandlerFunc, timeout time.Duration) *unsyncedManager {
-	return &unsyncedManager{
-		bot:             bot,
-		handler:         handler,
-		delay:           timeout,
-		unscheduled:     map[string]handleSchedulerUnit{},
-		unscheduledSync: &sync.Mutex{},
-	}
-}
-
-func (mngr *unsyncedManager) add(ctx Context) error {
-	mngr.unscheduledSync.Lock()
-	defer mngr.unscheduledSync.Unlock()
-
-	id := mediaGroupToId(ctx.Message())
-	if unit, ok := mngr.unscheduled[id]; ok {
-		unit.ctx = append(unit.ctx, ctx)
-		unit.delays += 1
-		mngr.unscheduled[id] = unit
-		go time.AfterFunc(mngr.delay, func() { mngr.handle(id) })
-		return nil
-	}
-
-	mngr.unscheduled[id] = handleSchedulerUnit{
-		delays: 1,
-		ctx:    []Context{ctx},
-	}
-	go time.AfterFunc(mngr.delay, func() {
-		defer func() {
-			if r := recover(); r != nil {
-				mngr.bot.onError(fmt.Errorf("panic at tg.unsyncedManager.fn: %v", r), nil)
-			}
-		}()
-		mngr.handle(id)
-	})
-
-	return nil
-}
-
-func (mngr *unsyncedManager) handle(id string) {
-	mngr.unscheduledSync.Lock()
-	defer mngr.unscheduledSync.Unlock()
-
-	unit, ok := mngr.unscheduled[id]
-	if !ok {
-		return
-	}
-	unit.delays -= 1
-	mngr.unscheduled[id] = unit
-
-	if unit.delays == 0 {
-		contexts := unit.ctx
-		sort.Slice(contexts, func(i, j int) bool { return contexts[i].Message().ID < contexts[j].Message().ID })
-
-		if err := mngr.handler(unit.ctx); err != nil {
-			ctx := unit.ctx[0]
-			ctx.Bot().OnError(err, ctx)
-		}
-	}
-}
-
-func singleMessage(msg *Message) bool {
-	return msg.AlbumID == ""
-}
-
-func mediaGroupToId(msg *Message) string {
-	if !singleMessage(msg) {
-		return msg.AlbumID
+	g.b.aggregators.add(aggregator)
+	for _, endpoint := range endpoints {
+		g.Handle(endpoint, aggregator.add, middlewares...)
 	}
-	return fmt.Sprintf("%d_%d", msg.Chat.ID, msg.ID)
+	return aggregator
 }
 
 func (contexts Contexts) Bot() *Bot {
@@ -760,19 +603,17 @@ func (contexts Contexts) Delete() error {
 	})
 }
 
-func (contexts Contexts) DeleteAfter(d time.Duration) *time.Timer {
+func (contexts Contexts) DeleteAfter(d time.Duration) clock.Timer {
 	if len(contexts) == 0 {
 		return nil
 	}
-	timer := time.NewTimer(d)
-	go func() {
-		<-timer.C
-		if err := contexts.Delete(); err != nil {
-			contexts.Bot().OnError(err, contexts[0])
+	msgs := []Editable{}
+	for _, ctx := range contexts {
+		if msg := ctx.Message(); msg != nil {
+			msgs = append(msgs, msg)
 		}
-	}()
-
-	return timer
+	}
+	return contexts.Bot().deleteAfter(d, contexts[0], msgs...)
 }
 
 func (contexts Contexts) Notify(action ChatAction) error {
@@ -0,0 +1,54 @@
+package tg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkAlbum(t *testing.T) {
+	photos := func(n int) Album {
+		album := Album{}
+		for i := 0; i < n; i++ {
+			album = append(album, &Photo{})
+		}
+		return album
+	}
+
+	assert.Equal(t, [][2]int{{0, 2}}, chunkAlbum(photos(2), MaxAlbumSize))
+	assert.Equal(t, [][2]int{{0, 10}}, chunkAlbum(photos(10), MaxAlbumSize))
+	assert.Equal(t, [][2]int{{0, 6}, {6, 11}}, chunkAlbum(photos(11), MaxAlbumSize))
+	assert.Equal(t, [][2]int{{0, 9}, {9, 17}, {17, 25}}, chunkAlbum(photos(25), MaxAlbumSize))
+	assert.Equal(t, [][2]int{{0, 2}, {2, 4}}, chunkAlbum(photos(4), 2))
+
+	mixed := Album{&Photo{}, &Video{}, &Document{}, &Document{}, &Animation{}, &Audio{}, &Audio{}, &Photo{}}
+	assert.Equal(t,
+		[][2]int{{0, 2}, {2, 4}, {4, 5}, {5, 7}, {7, 8}},
+		chunkAlbum(mixed, MaxAlbumSize),
+	)
+
+	animations := Album{&Animation{}, &Animation{}}
+	assert.Equal(t, [][2]int{{0, 1}, {1, 2}}, chunkAlbum(animations, MaxAlbumSize))
+}
+
+func TestExtractAlbumOptions(t *testing.T) {
+	opts, rest := extractAlbumOptions([]interface{}{Silent, AlbumOptions{ChunkSize: 4}, ModeHTML})
+	assert.Equal(t, 4, opts.chunkSize())
+	assert.Equal(t, []interface{}{Silent, ModeHTML}, rest)
+
+	opts, rest = extractAlbumOptions([]interface{}{&AlbumOptions{Caption: CaptionFirstChunk, ChunkSize: 42}})
+	assert.Equal(t, CaptionFirstChunk, opts.Caption)
+	assert.Equal(t, MaxAlbumSize, opts.chunkSize())
+	assert.Empty(t, rest)
+}
+
+func TestAlbumError(t *testing.T) {
+	err := &AlbumError{Chunks: []AlbumChunkError{
+		{From: 0, To: 10, Err: ErrTooLarge},
+		{From: 10, To: 12, Err: ErrWrongFileID},
+	}}
+	assert.True(t, errors.Is(err, ErrTooLarge))
+	assert.True(t, errors.Is(err, ErrWrongFileID))
+	assert.Contains(t, err.Error(), "[10:12]")
+}
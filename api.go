@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/heilkit/tg/botapi"
 	"io"
 	"log"
 	"mime/multipart"
@@ -27,14 +28,23 @@ func (b *Bot) RawNoSync(method string, payload interface{}) (data []byte, err er
 		return nil, err
 	}
 	if b.logger != nil {
+		// the request drains buf, but keeps its bytes
+		body := buf.Bytes()
 		rawStart := time.Now()
 		defer func() {
-			b.logger.OnRaw(method, buf.Bytes(), data, err, time.Since(rawStart))
+			b.logger.OnRaw(method, body, data, err, time.Since(rawStart))
 		}()
 	}
 
 	// Cancel the request immediately without waiting for the timeout  when bot is about to stop.
 	// This may become important if doing long polling with long timeout.
+	// Other requests are cancelled only if the handlers are not finished within the deadline, see StopContext.
+	b.stopMu.Lock()
+	stopClient, stopPoll := b.stopClient, b.stopPoll
+	b.stopMu.Unlock()
+	if method != "getUpdates" {
+		stopPoll = nil
+	}
 	exit := make(chan struct{})
 	defer close(exit)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -42,7 +52,9 @@ func (b *Bot) RawNoSync(method string, payload interface{}) (data []byte, err er
 
 	go func() {
 		select {
-		case <-b.stopClient:
+		case <-stopClient:
+			cancel()
+		case <-stopPoll:
 			cancel()
 		case <-exit:
 		}
@@ -74,28 +86,26 @@ func (b *Bot) RawNoSync(method string, payload interface{}) (data []byte, err er
 	return data, extractOk(data)
 }
 
-func (b *Bot) rawWithScheduling(method string, payload interface{}) ([]byte, error) {
-	switch m := payload.(type) {
-	case map[string]string:
-		if chatID, ok := m["chat_id"]; ok {
-			return b.scheduler.SyncFunc(1, chatID, func() ([]byte, error) {
-				return b.RawNoSync(method, payload)
-			})
-		}
+// rawWithScheduling sends the request, scheduled by its chat, if any.
+func (b *Bot) rawWithScheduling(method string, payload interface{}, chat string) ([]byte, error) {
+	if chat != "" {
+		return b.scheduler.SyncFunc(1, chat, func() ([]byte, error) {
+			return b.RawNoSync(method, payload)
+		})
 	}
 	return b.RawNoSync(method, payload)
 }
 
-func (b *Bot) rawWithRetries(method string, payload interface{}, try int, lastRet []byte, lastError error) ([]byte, error) {
+func (b *Bot) rawWithRetries(method string, payload interface{}, chat string, try int, lastRet []byte, lastError error) ([]byte, error) {
 	if try > b.retries {
 		return lastRet, lastError
 	}
-	ret, err := b.rawWithScheduling(method, payload)
-	var floodErr *FloodError
+	ret, err := b.rawWithScheduling(method, payload, chat)
+	var floodErr FloodError
 	if errors.As(err, &floodErr) {
 		b.OnError(err, nil)
-		time.Sleep(time.Second * time.Duration(floodErr.RetryAfter))
-		return b.rawWithRetries(method, payload, try+1, ret, err)
+		b.clock.Sleep(time.Second * time.Duration(floodErr.RetryAfter))
+		return b.rawWithRetries(method, payload, chat, try+1, ret, err)
 	}
 
 	return ret, err
@@ -103,7 +113,28 @@ func (b *Bot) rawWithRetries(method string, payload interface{}, try int, lastRe
 
 // Raw is a synced wrapper around RawNoSync method
 func (b *Bot) Raw(method string, payload interface{}) ([]byte, error) {
-	return b.rawWithRetries(method, payload, 0, nil, nil)
+	return b.raw(nil, method, payload)
+}
+
+// raw is Raw, which records the request instead of sending it, if rec isn't nil, see Bot.record.
+func (b *Bot) raw(rec *jobRecorder, method string, payload interface{}) ([]byte, error) {
+	if rec != nil {
+		return rec.record(method, payload)
+	}
+
+	params, isParams := payload.(map[string]string)
+	if !isParams {
+		// the other payloads, i.e. the recorded ones, are sent as they are,
+		// their params are only read to schedule them and to follow the migrations
+		params, _ = botapi.EncodeParams(payload)
+	}
+	b.replies.flushChat(params["chat_id"])
+	return b.followMigrations(params, func(params map[string]string, migrated bool) ([]byte, error) {
+		if !isParams && !migrated {
+			return b.rawWithRetries(method, payload, params["chat_id"], 0, nil, nil)
+		}
+		return b.rawWithRetries(method, params, params["chat_id"], 0, nil, nil)
+	})
 }
 
 func (b *Bot) sendFilesNoSync(method string, files map[string]File, params map[string]string) (data []byte, err error) {
@@ -193,34 +224,46 @@ func (b *Bot) sendFilesNoSync(method string, files map[string]File, params map[s
 	return data, extractOk(data)
 }
 
-func (b *Bot) sendFilesWithScheduling(method string, files map[string]File, params map[string]string) ([]byte, error) {
+func (b *Bot) sendFilesWithScheduling(method string, files map[string]File, params map[string]string, cost int) ([]byte, error) {
 	if chatID, ok := params["chat_id"]; ok {
-		return b.scheduler.SyncFunc(len(files), chatID, func() ([]byte, error) {
+		return b.scheduler.SyncFunc(cost, chatID, func() ([]byte, error) {
 			return b.sendFilesNoSync(method, files, params)
 		})
 	}
 	return b.sendFilesNoSync(method, files, params)
 }
 
-func (b *Bot) sendFilesWithRetries(method string, files map[string]File, params map[string]string, try int, lastRet []byte, lastError error) ([]byte, error) {
+func (b *Bot) sendFilesWithRetries(method string, files map[string]File, params map[string]string, cost int, try int, lastRet []byte, lastError error) ([]byte, error) {
 	if try > b.retries {
 		return lastRet, lastError
 	}
-	ret, err := b.sendFilesWithScheduling(method, files, params)
+	ret, err := b.sendFilesWithScheduling(method, files, params, cost)
 	if err != nil {
 		b.OnError(err, nil)
-		var floodErr *FloodError
+		var floodErr FloodError
 		if errors.As(err, &floodErr) {
-			time.Sleep(time.Second * time.Duration(floodErr.RetryAfter))
+			b.clock.Sleep(time.Second * time.Duration(floodErr.RetryAfter))
 		}
-		return b.sendFilesWithRetries(method, files, params, try+1, ret, err)
+		return b.sendFilesWithRetries(method, files, params, cost, try+1, ret, err)
 	}
 
 	return ret, err
 }
 
 func (b *Bot) sendFiles(method string, files map[string]File, params map[string]string) ([]byte, error) {
-	return b.sendFilesWithRetries(method, files, params, 0, nil, nil)
+	return b.sendFilesCost(nil, method, files, params, len(files))
+}
+
+// sendFilesCost is sendFiles, which is accounted by the scheduler as cost messages, i.e. a media group.
+// The request is recorded instead of sending it, if rec isn't nil, see Bot.record.
+func (b *Bot) sendFilesCost(rec *jobRecorder, method string, files map[string]File, params map[string]string, cost int) ([]byte, error) {
+	if rec != nil {
+		return rec.recordFiles(method, files, params)
+	}
+	b.replies.flushChat(params["chat_id"])
+	return b.followMigrations(params, func(params map[string]string, _ bool) ([]byte, error) {
+		return b.sendFilesWithRetries(method, files, params, cost, 0, nil, nil)
+	})
 }
 
 func addFileToWriter(writer *multipart.Writer, filename, field string, file interface{}) error {
@@ -254,7 +297,7 @@ func (b *Bot) sendText(to Recipient, text string, opt *SendOptions) (*Message, e
 	}
 	b.embedSendOptions(params, opt)
 
-	data, err := b.Raw("sendMessage", params)
+	data, err := b.raw(opt.recording(), "sendMessage", params)
 	if err != nil {
 		return nil, err
 	}
@@ -262,7 +305,7 @@ func (b *Bot) sendText(to Recipient, text string, opt *SendOptions) (*Message, e
 	return extractMessage(data)
 }
 
-func (b *Bot) sendMedia(media Media, params map[string]string, files map[string]File) (*Message, error) {
+func (b *Bot) sendMedia(rec *jobRecorder, media Media, params map[string]string, files map[string]File) (*Message, error) {
 	kind := media.MediaType()
 	what := "send" + strings.Title(kind)
 
@@ -275,7 +318,7 @@ func (b *Bot) sendMedia(media Media, params map[string]string, files map[string]
 		sendFiles[k] = v
 	}
 
-	data, err := b.sendFiles(what, sendFiles, params)
+	data, err := b.sendFilesCost(rec, what, sendFiles, params, len(sendFiles))
 	if err != nil {
 		return nil, err
 	}
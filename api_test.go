@@ -7,8 +7,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/heilkit/tg/clock/clocktest"
 	"github.com/stretchr/testify/require"
 
 	"github.com/stretchr/testify/assert"
@@ -118,3 +121,76 @@ func TestExtractMessage(t *testing.T) {
 	_, err = extractMessage(data)
 	require.NoError(t, err)
 }
+
+func TestRawPayload(t *testing.T) {
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer srv.Close()
+
+	sch := &countingScheduler{}
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Scheduler: sch})
+	require.NoError(t, err)
+
+	// the payload is sent as it is, only its chat is read
+	payload := struct {
+		ChatID int64           `json:"chat_id"`
+		Action string          `json:"action"`
+		Extra  *SendOptions    `json:"extra"`
+		Raw    json.RawMessage `json:"raw"`
+	}{ChatID: -1, Action: "typing", Raw: json.RawMessage(`{"a":[1]}`)}
+	_, err = b.Raw("sendChatAction", payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"chat_id":-1,"action":"typing","extra":null,"raw":{"a":[1]}}`, string(body))
+	assert.Equal(t, []string{"-1"}, sch.chats)
+
+	_, err = b.Raw("getMe", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "null\n", string(body))
+	assert.Equal(t, []string{"-1"}, sch.chats)
+}
+
+func TestRawFlood(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5","parameters":{"retry_after":5}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":1},"photo":[{"file_id":"photo"}]}}`))
+	}))
+	defer srv.Close()
+
+	clk := clocktest.New(time.Unix(0, 0))
+	b, err := NewBot(Settings{
+		Offline: true, URL: srv.URL, Client: srv.Client(), Clock: clk, Retries: 1,
+		OnError: func(error, Context) {},
+	})
+	require.NoError(t, err)
+
+	for name, send := range map[string]func() error{
+		"Raw": func() error {
+			_, err := b.Raw("sendMessage", map[string]string{"chat_id": "1", "text": "flood"})
+			return err
+		},
+		"Files": func() error {
+			_, err := b.Send(&Chat{ID: 1}, &Photo{File: File{FileID: "photo"}})
+			return err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			requests.Store(0)
+			done := make(chan error, 1)
+			go func() { done <- send() }()
+
+			// the retry waits for the bot clock
+			clk.BlockUntil(1)
+			assert.EqualValues(t, 1, requests.Load())
+			clk.Advance(5 * time.Second)
+			require.NoError(t, <-done)
+			assert.EqualValues(t, 2, requests.Load())
+		})
+	}
+}
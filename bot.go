@@ -3,14 +3,17 @@ package tg
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/heilkit/tg/clock"
 	"github.com/heilkit/tg/scheduler"
 	"io"
 	"log"
 	"net/http"
 	"os"
 	"regexp"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -38,6 +41,12 @@ func NewBot(pref Settings) (*Bot, error) {
 	if pref.Scheduler == nil {
 		pref.Scheduler = scheduler.Nil()
 	}
+	if err := scheduler.UseClock(pref.Scheduler, pref.Clock); err != nil {
+		return nil, err
+	}
+	if pref.MediaWorkers <= 0 {
+		pref.MediaWorkers = runtime.NumCPU()
+	}
 
 	bot := &Bot{
 		Token:   pref.Token,
@@ -47,7 +56,7 @@ func NewBot(pref Settings) (*Bot, error) {
 
 		Updates:  make(chan Update, pref.Updates),
 		handlers: make(map[string]HandlerFunc),
-		stop:     make(chan chan struct{}),
+		stop:     make(chan stopRequest),
 
 		synchronous: pref.Synchronous,
 		verbose:     pref.Verbose,
@@ -57,7 +66,19 @@ func NewBot(pref Settings) (*Bot, error) {
 		logger:      pref.Logger,
 		scheduler:   pref.Scheduler,
 		retries:     pref.Retries,
+
+		mediaWorkers: make(chan struct{}, pref.MediaWorkers),
+		workspace:    pref.Workspace,
+		clock:        clock.Or(pref.Clock),
+		migrations:   pref.Migrations,
+		onMigrated:   pref.OnMigrated,
 	}
+	bot.jobs = newJobRunner(bot, pref.Jobs)
+	bot.crons = newCronRunner(bot)
+	if pref.Dispatcher != nil {
+		bot.dispatcher = newDispatcher(*pref.Dispatcher)
+	}
+	sweepWorkspaces(pref.Workspace)
 
 	if pref.URL == "" {
 		bot.local = nil
@@ -86,18 +107,40 @@ type Bot struct {
 	Poller  Poller
 	onError func(error, Context)
 
-	group       *Group
-	handlers    map[string]HandlerFunc
-	synchronous bool
-	verbose     bool
-	parseMode   ParseMode
-	local       Local
-	scheduler   scheduler.Scheduler
-	logger      Logger
-	stop        chan chan struct{}
-	client      *http.Client
-	stopClient  chan struct{}
-	retries     int
+	group      *Group
+	handlersMu sync.RWMutex
+	handlers   map[string]HandlerFunc
+	// allowedUpdates are the update types of the handlers, see AllowedUpdates
+	allowedUpdates []string
+	subscribeMu    sync.Mutex
+	synchronous    bool
+	verbose        bool
+	parseMode      ParseMode
+	local          Local
+	scheduler      scheduler.Scheduler
+	logger         Logger
+	stop           chan stopRequest
+	client         *http.Client
+	stopMu         sync.Mutex
+	stopClient     chan struct{}
+	stopPoll       chan struct{}
+	retries        int
+
+	mediaWorkers chan struct{}
+	workspace    WorkspaceSettings
+	clock        clock.Clock
+	migrations   MigrationStore
+	migrateMu    sync.Mutex
+	onMigrated   func(from, to int64)
+
+	jobs  *jobRunner
+	crons *cronRunner
+
+	inflight    inflight
+	acks        updateAcks
+	replies     webhookReplies
+	aggregators albumAggregators
+	dispatcher  *dispatcher
 }
 
 // Settings represent a utility struct for passing certain
@@ -116,6 +159,10 @@ type Settings struct {
 	// It makes ProcessUpdate return after the handler is finished.
 	Synchronous bool
 
+	// Dispatcher runs handlers on a bounded worker pool, keeping the order of updates within a chat.
+	// If nil, every handler runs in its own goroutine. Ignored, if Synchronous.
+	Dispatcher *DispatcherSettings
+
 	// Verbose forces bot to logger all upcoming requests.
 	// Use for debugging purposes only.
 	Verbose bool
@@ -148,6 +195,35 @@ type Settings struct {
 	Retries int
 
 	Logger Logger
+
+	// Workspace configures temporary files of media modifiers.
+	Workspace WorkspaceSettings
+
+	// MediaWorkers limits the number of media items, which modifiers are run concurrently, see PrepareMedia.
+	// Modifiers usually spawn CPU-heavy ffmpeg processes, so it's defaulted to runtime.NumCPU().
+	MediaWorkers int
+
+	// Clock is used by the album handlers, Context.DeleteAfter, LongPoller, retries and the Scheduler,
+	// defaulted to clock.Real(). Set a fake one (see clocktest) to test the timing without sleeps.
+	// The Scheduler is bound to the clock of the first bot, so NewBot fails, if it's shared by the bots
+	// of different clocks, see scheduler.UseClock. The schedulers of scheduler.CustomClock keep their own clock.
+	Clock clock.Clock
+
+	// Jobs keeps the delayed actions, i.e. SendLater and DeleteAfter, defaulted to NewMemoryJobStore().
+	// Use NewFileJobStore to keep them between restarts, the pending jobs are recovered on Start.
+	Jobs JobStore
+
+	// Migrations enables following the groups, upgraded to supergroups: a request, failed with GroupError,
+	// is retried with the supergroup, and the later requests to the group go to the supergroup directly.
+	// The migrations of OnMigration updates are saved as well. See NewMemoryMigrationStore.
+	Migrations MigrationStore
+	// OnMigrated is called once for every migration, followed by Migrations, i.e. to update the stored chat IDs.
+	OnMigrated func(from, to int64)
+}
+
+// Clock returns the clock of the bot, see Settings.Clock.
+func (b *Bot) Clock() clock.Clock {
+	return b.clock
 }
 
 var defaultOnError = func(err error, c Context) {
@@ -211,14 +287,28 @@ func (b *Bot) Handle(endpoint interface{}, h HandlerFunc, m ...MiddlewareFunc) {
 		return applyMiddleware(h, m...)(c)
 	}
 
-	switch end := endpoint.(type) {
+	var end string
+	switch e := endpoint.(type) {
 	case string:
-		b.handlers[end] = handler
+		end = e
 	case CallbackEndpoint:
-		b.handlers[end.CallbackUnique()] = handler
+		end = e.CallbackUnique()
 	default:
 		panic("telebot: unsupported endpoint")
 	}
+
+	b.handlersMu.Lock()
+	b.handlers[end] = handler
+	changed := b.updateAllowedLocked()
+	b.handlersMu.Unlock()
+
+	// handlers, added to a started bot, are checked and subscribed to right away
+	if b.started() {
+		b.checkHandlers(end)
+		if changed {
+			b.resubscribe()
+		}
+	}
 }
 
 // Start brings bot into motion by consuming incoming
@@ -229,10 +319,19 @@ func (b *Bot) Start() {
 	}
 
 	// do nothing if called twice
+	b.stopMu.Lock()
 	if b.stopClient != nil {
+		b.stopMu.Unlock()
 		return
 	}
 	b.stopClient = make(chan struct{})
+	b.stopPoll = make(chan struct{})
+	b.stopMu.Unlock()
+	if err := b.jobs.recover(); err != nil {
+		b.OnError(err, nil)
+	}
+	b.crons.start()
+	b.checkHandlers()
 
 	stop := make(chan struct{})
 	stopConfirm := make(chan struct{})
@@ -247,25 +346,38 @@ func (b *Bot) Start() {
 		// handle incoming updates
 		case upd := <-b.Updates:
 			b.ProcessUpdate(upd)
+			b.acks.done(upd.ID)
 			// call to stop polling
-		case confirm := <-b.stop:
+		case req := <-b.stop:
+			close(b.stopPoll)
 			close(stop)
-			<-stopConfirm
-			close(confirm)
+
+			// keep receiving, so the poller is not blocked on a full channel
+			pending := []Update{}
+		polling:
+			for {
+				select {
+				case upd := <-b.Updates:
+					pending = append(pending, upd)
+				case <-stopConfirm:
+					break polling
+				}
+			}
+
+			req.report <- b.shutdown(req.ctx, pending)
+			b.stopMu.Lock()
 			b.stopClient = nil
+			b.stopMu.Unlock()
 			return
 		}
 	}
 }
 
-// Stop gracefully shuts the poller down.
-func (b *Bot) Stop() {
-	if b.stopClient != nil {
-		close(b.stopClient)
-	}
-	confirm := make(chan struct{})
-	b.stop <- confirm
-	<-confirm
+// started tells whether the bot is started and not stopped yet.
+func (b *Bot) started() bool {
+	b.stopMu.Lock()
+	defer b.stopMu.Unlock()
+	return b.stopClient != nil
 }
 
 // NewMarkup simply returns newly created markup instance.
@@ -297,31 +409,60 @@ func (b *Bot) NewContext(u Update) Context {
 //   - Option (a shortcut flag for popular options)
 //   - ParseMode (HTML, Markdown, etc)
 func (b *Bot) Send(to Recipient, what interface{}, opts ...interface{}) (*Message, error) {
+	return b.send(nil, to, what, opts...)
+}
+
+// send is Send, which records the request instead of sending it, if rec isn't nil, see Bot.record.
+// Only the Sendables of the package pass the recorder on, so the rest can't be recorded.
+func (b *Bot) send(rec *jobRecorder, to Recipient, what interface{}, opts ...interface{}) (*Message, error) {
 	if to == nil {
 		return nil, ErrBadRecipient
 	}
 
 	sendOpts := extractOptions(opts)
+	sendOpts.recorder = rec
 
 	switch object := what.(type) {
 	case string:
 		return b.sendText(to, object, sendOpts)
 	case Sendable:
+		if rec != nil && !recordable(object) {
+			return nil, ErrJobSendable
+		}
 		return object.Send(b, to, sendOpts)
 	default:
 		return nil, ErrUnsupportedWhat
 	}
 }
 
-// SendAlbum sends multiple instances of media as a single message.
+// SendAlbum sends multiple instances of media as one or more media groups.
 // To include the caption, make sure the first Inputtable of an album has it.
+//
+// Albums, which do not fit into a single media group, are split into chunks of at most 10 items:
+// documents are grouped only with documents, audio only with audio, photos and videos together.
+// Everything else, as well as lone items, is sent on its own. Chunks after the first one reply
+// to the first sent message, unless ReplyTo is given. Pass AlbumOptions to tune chunking and captions.
+//
+// Failed chunks do not stop sending the rest: all the sent messages are returned along with *AlbumError.
 // From all existing options, it only supports tele.Silent.
 func (b *Bot) SendAlbum(to Recipient, album Album, opts ...interface{}) ([]Message, error) {
 	if to == nil {
 		return nil, ErrBadRecipient
 	}
+	if len(album) == 0 {
+		return nil, ErrEmptyMessage
+	}
+
+	albumOpts, opts := extractAlbumOptions(opts)
+	return b.sendAlbumChunked(to, album, albumOpts, extractOptions(opts))
+}
+
+// sendMediaGroup sends a valid media group, i.e. 2-10 items of compatible types, in a single request.
+// Non-empty captions fill in the empty captions of the corresponding items.
+func (b *Bot) sendMediaGroup(to Recipient, album Album, captions []string, sendOpts *SendOptions) ([]Message, error) {
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
 
-	sendOpts := extractOptions(opts)
 	inputMedias := make([]string, len(album))
 	files := make(map[string]File)
 
@@ -341,31 +482,26 @@ func (b *Bot) SendAlbum(to Recipient, album Album, opts ...interface{}) ([]Messa
 		case file.OnDisk() || file.FileReader != nil:
 			switch media := med.(type) {
 			case *Photo:
-				for _, mod := range media.Mods {
-					temporaries, err := mod(media)
-					for _, tmp := range temporaries {
-						if tmp != "" {
-							defer os.Remove(tmp)
-						}
-					}
-					if err != nil {
-						return nil, err
-					}
+				if err := applyMods(ws, media, media.Mods); err != nil {
+					return nil, err
 				}
 				med = media
 				file = &media.File
 
 			case *Video:
-				for _, mod := range media.Mods {
-					temporaries, err := mod(media)
-					for _, tmp := range temporaries {
-						if tmp != "" {
-							defer os.Remove(tmp)
-						}
-					}
-					if err != nil {
-						return nil, err
-					}
+				if err := applyMods(ws, media, media.Mods); err != nil {
+					return nil, err
+				}
+				med = media
+				file = &media.File
+				if media.Thumbnail != nil {
+					thumbnailRepr = "attach://thumbnail" + strconv.Itoa(i)
+					files["thumbnail"+strconv.Itoa(i)] = media.Thumbnail.File
+				}
+
+			case *Audio:
+				if err := applyMods(ws, media, media.Mods); err != nil {
+					return nil, err
 				}
 				med = media
 				file = &media.File
@@ -376,16 +512,8 @@ func (b *Bot) SendAlbum(to Recipient, album Album, opts ...interface{}) ([]Messa
 
 			case *Animation:
 				v := media.ToVideo()
-				for _, mod := range media.Mods {
-					temporaries, err := mod(v)
-					for _, tmp := range temporaries {
-						if tmp != "" {
-							defer os.Remove(tmp)
-						}
-					}
-					if err != nil {
-						return nil, err
-					}
+				if err := applyMods(ws, v, media.Mods); err != nil {
+					return nil, err
 				}
 				media = v.ToAnimation()
 				med = media
@@ -404,6 +532,9 @@ func (b *Bot) SendAlbum(to Recipient, album Album, opts ...interface{}) ([]Messa
 		}
 
 		inputMedia := med.InputMedia()
+		if i < len(captions) && captions[i] != "" && inputMedia.Caption == "" {
+			inputMedia.Caption = captions[i]
+		}
 		if len(sendOpts.Entities) > 0 {
 			inputMedia.Entities = sendOpts.Entities
 		} else {
@@ -425,7 +556,7 @@ func (b *Bot) SendAlbum(to Recipient, album Album, opts ...interface{}) ([]Messa
 	}
 	b.embedSendOptions(params, sendOpts)
 
-	data, err := b.sendFiles("sendMediaGroup", files, params)
+	data, err := b.sendFilesCost(nil, "sendMediaGroup", files, params, len(album))
 	if err != nil {
 		return nil, err
 	}
@@ -438,7 +569,11 @@ func (b *Bot) SendAlbum(to Recipient, album Album, opts ...interface{}) ([]Messa
 	}
 
 	for attachName := range files {
-		i, _ := strconv.Atoi(attachName)
+		i, err := strconv.Atoi(attachName)
+		if err != nil || i >= len(resp.Result) {
+			// thumbnails are not album entries
+			continue
+		}
 		r := resp.Result[i]
 
 		var newID string
@@ -462,13 +597,18 @@ func (b *Bot) SendAlbum(to Recipient, album Album, opts ...interface{}) ([]Messa
 // Reply behaves just like Send() with an exception of "reply-to" indicator.
 // This function will panic upon nil Message.
 func (b *Bot) Reply(to *Message, what interface{}, opts ...interface{}) (*Message, error) {
+	return b.reply(nil, to, what, opts...)
+}
+
+// reply is Reply, recorded by rec, see send.
+func (b *Bot) reply(rec *jobRecorder, to *Message, what interface{}, opts ...interface{}) (*Message, error) {
 	sendOpts := extractOptions(opts)
 	if sendOpts == nil {
 		sendOpts = &SendOptions{}
 	}
 
 	sendOpts.ReplyTo = to
-	return b.Send(to.Chat, what, sendOpts)
+	return b.send(rec, to.Chat, what, sendOpts)
 }
 
 // Forward behaves just like Send() but of all options it only supports Silent (see Bots API).
@@ -538,6 +678,11 @@ func (b *Bot) Copy(to Recipient, msg Editable, options ...interface{}) (*Message
 //	b.Edit(c, "edit inline message from the callback")
 //	b.Edit(r, "edit message from chosen inline result")
 func (b *Bot) Edit(msg Editable, what interface{}, opts ...interface{}) (*Message, error) {
+	return b.edit(nil, msg, what, opts...)
+}
+
+// edit is Edit, recorded by rec, see send.
+func (b *Bot) edit(rec *jobRecorder, msg Editable, what interface{}, opts ...interface{}) (*Message, error) {
 	var (
 		method string
 		params = make(map[string]string)
@@ -545,9 +690,9 @@ func (b *Bot) Edit(msg Editable, what interface{}, opts ...interface{}) (*Messag
 
 	switch v := what.(type) {
 	case *ReplyMarkup:
-		return b.EditReplyMarkup(msg, v)
+		return b.editReplyMarkup(rec, msg, v)
 	case Inputtable:
-		return b.EditMedia(msg, v, opts...)
+		return b.editMedia(rec, msg, v, opts...)
 	case string:
 		method = "editMessageText"
 		params["text"] = v
@@ -581,7 +726,7 @@ func (b *Bot) Edit(msg Editable, what interface{}, opts ...interface{}) (*Messag
 	sendOpts := extractOptions(opts)
 	b.embedSendOptions(params, sendOpts)
 
-	data, err := b.Raw(method, params)
+	data, err := b.raw(rec, method, params)
 	if err != nil {
 		return nil, err
 	}
@@ -596,6 +741,11 @@ func (b *Bot) Edit(msg Editable, what interface{}, opts ...interface{}) (*Messag
 // If edited message is sent by the bot, returns it,
 // otherwise returns nil and ErrTrueResult.
 func (b *Bot) EditReplyMarkup(msg Editable, markup *ReplyMarkup) (*Message, error) {
+	return b.editReplyMarkup(nil, msg, markup)
+}
+
+// editReplyMarkup is EditReplyMarkup, recorded by rec, see send.
+func (b *Bot) editReplyMarkup(rec *jobRecorder, msg Editable, markup *ReplyMarkup) (*Message, error) {
 	msgID, chatID := msg.MessageSig()
 	params := make(map[string]string)
 
@@ -615,7 +765,7 @@ func (b *Bot) EditReplyMarkup(msg Editable, markup *ReplyMarkup) (*Message, erro
 	data, _ := json.Marshal(markup)
 	params["reply_markup"] = string(data)
 
-	data, err := b.Raw("editMessageReplyMarkup", params)
+	data, err := b.raw(rec, "editMessageReplyMarkup", params)
 	if err != nil {
 		return nil, err
 	}
@@ -664,6 +814,11 @@ func (b *Bot) EditCaption(msg Editable, caption string, opts ...interface{}) (*M
 //	b.EditMedia(m, &tele.Photo{File: tele.FromDisk("chicken.jpg")})
 //	b.EditMedia(m, &tele.Video{File: tele.FromURL("http://video.mp4")})
 func (b *Bot) EditMedia(msg Editable, media Inputtable, opts ...interface{}) (*Message, error) {
+	return b.editMedia(nil, msg, media, opts...)
+}
+
+// editMedia is EditMedia, recorded by rec, see send.
+func (b *Bot) editMedia(rec *jobRecorder, msg Editable, media Inputtable, opts ...interface{}) (*Message, error) {
 	var (
 		repr  string
 		file  = media.MediaFile()
@@ -733,7 +888,7 @@ func (b *Bot) EditMedia(msg Editable, media Inputtable, opts ...interface{}) (*M
 		params["message_id"] = msgID
 	}
 
-	data, err := b.sendFiles("editMessageMedia", files, params)
+	data, err := b.sendFilesCost(rec, "editMessageMedia", files, params, len(files))
 	if err != nil {
 		return nil, err
 	}
@@ -753,6 +908,11 @@ func (b *Bot) EditMedia(msg Editable, media Inputtable, opts ...interface{}) (*M
 //   - If the bot has can_delete_messages permission in a supergroup or a
 //     channel, it can delete any message there.
 func (b *Bot) Delete(msg Editable) error {
+	return b.delete(nil, msg)
+}
+
+// delete is Delete, recorded by rec, see send.
+func (b *Bot) delete(rec *jobRecorder, msg Editable) error {
 	msgID, chatID := msg.MessageSig()
 
 	params := map[string]string{
@@ -760,7 +920,7 @@ func (b *Bot) Delete(msg Editable) error {
 		"message_id": msgID,
 	}
 
-	_, err := b.Raw("deleteMessage", params)
+	_, err := b.raw(rec, "deleteMessage", params)
 	return err
 }
 
@@ -854,6 +1014,11 @@ func (b *Bot) Accept(query *PreCheckoutQuery, errorMessage ...string) error {
 //	b.Respond(c)
 //	b.Respond(c, response)
 func (b *Bot) Respond(c *Callback, resp ...*CallbackResponse) error {
+	return b.respond(nil, c, resp...)
+}
+
+// respond is Respond, recorded by rec, see send.
+func (b *Bot) respond(rec *jobRecorder, c *Callback, resp ...*CallbackResponse) error {
 	var r *CallbackResponse
 	if resp == nil {
 		r = &CallbackResponse{}
@@ -862,7 +1027,7 @@ func (b *Bot) Respond(c *Callback, resp ...*CallbackResponse) error {
 	}
 
 	r.CallbackID = c.ID
-	_, err := b.Raw("answerCallbackQuery", r)
+	_, err := b.raw(rec, "answerCallbackQuery", r)
 	return err
 }
 
@@ -1081,6 +1246,11 @@ func (b *Bot) Leave(chat *Chat) error {
 // It supports Silent option.
 // This function will panic upon nil Editable.
 func (b *Bot) Pin(msg Editable, opts ...interface{}) error {
+	return b.pin(nil, msg, opts...)
+}
+
+// pin is Pin, recorded by rec, see send.
+func (b *Bot) pin(rec *jobRecorder, msg Editable, opts ...interface{}) error {
 	msgID, chatID := msg.MessageSig()
 
 	params := map[string]string{
@@ -1091,13 +1261,18 @@ func (b *Bot) Pin(msg Editable, opts ...interface{}) error {
 	sendOpts := extractOptions(opts)
 	b.embedSendOptions(params, sendOpts)
 
-	_, err := b.Raw("pinChatMessage", params)
+	_, err := b.raw(rec, "pinChatMessage", params)
 	return err
 }
 
 // Unpin unpins a message in a supergroup or a channel.
 // It supports tb.Silent option.
 func (b *Bot) Unpin(chat *Chat, messageID ...int) error {
+	return b.unpin(nil, chat, messageID...)
+}
+
+// unpin is Unpin, recorded by rec, see send.
+func (b *Bot) unpin(rec *jobRecorder, chat *Chat, messageID ...int) error {
 	params := map[string]string{
 		"chat_id": chat.Recipient(),
 	}
@@ -1105,7 +1280,7 @@ func (b *Bot) Unpin(chat *Chat, messageID ...int) error {
 		params["message_id"] = strconv.Itoa(messageID[0])
 	}
 
-	_, err := b.Raw("unpinChatMessage", params)
+	_, err := b.raw(rec, "unpinChatMessage", params)
 	return err
 }
 
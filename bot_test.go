@@ -11,6 +11,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/heilkit/tg/scheduler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,6 +75,15 @@ func TestNewBot(t *testing.T) {
 	assert.Equal(t, pref.Poller, b.Poller)
 	assert.Equal(t, 50, cap(b.Updates))
 	assert.Equal(t, ModeHTML, b.parseMode)
+
+	// the scheduler is bound to the clock of the first bot
+	sch := scheduler.Default()
+	_, err = NewBot(Settings{Offline: true, Scheduler: sch})
+	require.NoError(t, err)
+	_, err = NewBot(Settings{Offline: true, Scheduler: sch})
+	require.NoError(t, err)
+	_, err = NewBot(Settings{Offline: true, Scheduler: sch, Clock: clocktest.New(time.Now())})
+	assert.Error(t, err)
 }
 
 func TestBotHandle(t *testing.T) {
@@ -0,0 +1,6574 @@
+// Code generated by botapigen from Bot API 7.2. DO NOT EDIT.
+
+package botapi
+
+// Animation is the Animation object, see https://core.telegram.org/bots/api#animation
+//
+// This object represents an animation file (GIF or H.264/MPEG-4 AVC video without sound).
+type Animation struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Video width as defined by sender
+	Width int64 `json:"width"`
+	// Video height as defined by sender
+	Height int64 `json:"height"`
+	// Duration of the video in seconds as defined by sender
+	Duration int64 `json:"duration"`
+	// Animation thumbnail as defined by sender
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+	// Original animation filename as defined by sender
+	FileName string `json:"file_name,omitempty"`
+	// MIME type of the file as defined by sender
+	MimeType string `json:"mime_type,omitempty"`
+	// File size in bytes. It can be bigger than 2^31 and some programming languages may have
+	// difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed
+	// 64-bit integer or double-precision float type are safe for storing this value.
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// Audio is the Audio object, see https://core.telegram.org/bots/api#audio
+//
+// This object represents an audio file to be treated as music by the Telegram clients.
+type Audio struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Duration of the audio in seconds as defined by sender
+	Duration int64 `json:"duration"`
+	// Performer of the audio as defined by sender or by audio tags
+	Performer string `json:"performer,omitempty"`
+	// Title of the audio as defined by sender or by audio tags
+	Title string `json:"title,omitempty"`
+	// Original filename as defined by sender
+	FileName string `json:"file_name,omitempty"`
+	// MIME type of the file as defined by sender
+	MimeType string `json:"mime_type,omitempty"`
+	// File size in bytes. It can be bigger than 2^31 and some programming languages may have
+	// difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed
+	// 64-bit integer or double-precision float type are safe for storing this value.
+	FileSize int64 `json:"file_size,omitempty"`
+	// Thumbnail of the album cover to which the music file belongs
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+}
+
+// Birthdate is the Birthdate object, see https://core.telegram.org/bots/api#birthdate
+//
+// Describes the birthdate of a user.
+type Birthdate struct {
+	// Day of the user's birth; 1-31
+	Day int64 `json:"day"`
+	// Month of the user's birth; 1-12
+	Month int64 `json:"month"`
+	// Year of the user's birth
+	Year int64 `json:"year,omitempty"`
+}
+
+// BotCommand is the BotCommand object, see https://core.telegram.org/bots/api#botcommand
+//
+// This object represents a bot command.
+type BotCommand struct {
+	// Text of the command; 1-32 characters. Can contain only lowercase English letters, digits and
+	// underscores.
+	Command string `json:"command"`
+	// Description of the command; 1-256 characters.
+	Description string `json:"description"`
+}
+
+// BotCommandScope is the BotCommandScope object, see
+// https://core.telegram.org/bots/api#botcommandscope
+//
+// This object represents the scope to which bot commands are applied. Currently, the following 7
+// scopes are supported:
+//
+// The fields of BotCommandScopeDefault, BotCommandScopeAllPrivateChats, BotCommandScopeAllGroupChats,
+// BotCommandScopeAllChatAdministrators, BotCommandScopeChat, BotCommandScopeChatAdministrators,
+// BotCommandScopeChatMember are merged.
+type BotCommandScope struct {
+	// Scope type, one of BotCommandScopeDefault, BotCommandScopeAllPrivateChats,
+	// BotCommandScopeAllGroupChats, BotCommandScopeAllChatAdministrators, BotCommandScopeChat,
+	// BotCommandScopeChatAdministrators, BotCommandScopeChatMember
+	Type string `json:"type"`
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id,omitempty"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id,omitempty"`
+}
+
+const (
+	// BotCommandScopeDefault is the type of BotCommandScope, see
+	// https://core.telegram.org/bots/api#botcommandscopedefault
+	//
+	// Represents the default scope of bot commands. Default commands are used if no commands with a
+	// narrower scope are specified for the user.
+	BotCommandScopeDefault = "default"
+	// BotCommandScopeAllPrivateChats is the type of BotCommandScope, see
+	// https://core.telegram.org/bots/api#botcommandscopeallprivatechats
+	//
+	// Represents the scope of bot commands, covering all private chats.
+	BotCommandScopeAllPrivateChats = "all_private_chats"
+	// BotCommandScopeAllGroupChats is the type of BotCommandScope, see
+	// https://core.telegram.org/bots/api#botcommandscopeallgroupchats
+	//
+	// Represents the scope of bot commands, covering all group and supergroup chats.
+	BotCommandScopeAllGroupChats = "all_group_chats"
+	// BotCommandScopeAllChatAdministrators is the type of BotCommandScope, see
+	// https://core.telegram.org/bots/api#botcommandscopeallchatadministrators
+	//
+	// Represents the scope of bot commands, covering all group and supergroup chat administrators.
+	BotCommandScopeAllChatAdministrators = "all_chat_administrators"
+	// BotCommandScopeChat is the type of BotCommandScope, see
+	// https://core.telegram.org/bots/api#botcommandscopechat
+	//
+	// Represents the scope of bot commands, covering a specific chat.
+	BotCommandScopeChat = "chat"
+	// BotCommandScopeChatAdministrators is the type of BotCommandScope, see
+	// https://core.telegram.org/bots/api#botcommandscopechatadministrators
+	//
+	// Represents the scope of bot commands, covering all administrators of a specific group or supergroup
+	// chat.
+	BotCommandScopeChatAdministrators = "chat_administrators"
+	// BotCommandScopeChatMember is the type of BotCommandScope, see
+	// https://core.telegram.org/bots/api#botcommandscopechatmember
+	//
+	// Represents the scope of bot commands, covering a specific member of a group or supergroup chat.
+	BotCommandScopeChatMember = "chat_member"
+)
+
+// BotDescription is the BotDescription object, see https://core.telegram.org/bots/api#botdescription
+//
+// This object represents the bot's description.
+type BotDescription struct {
+	// The bot's description
+	Description string `json:"description"`
+}
+
+// BotName is the BotName object, see https://core.telegram.org/bots/api#botname
+//
+// This object represents the bot's name.
+type BotName struct {
+	// The bot's name
+	Name string `json:"name"`
+}
+
+// BotShortDescription is the BotShortDescription object, see
+// https://core.telegram.org/bots/api#botshortdescription
+//
+// This object represents the bot's short description.
+type BotShortDescription struct {
+	// The bot's short description
+	ShortDescription string `json:"short_description"`
+}
+
+// BusinessConnection is the BusinessConnection object, see
+// https://core.telegram.org/bots/api#businessconnection
+//
+// Describes the connection of the bot with a business account.
+type BusinessConnection struct {
+	// Unique identifier of the business connection
+	ID string `json:"id"`
+	// Business account user that created the business connection
+	User User `json:"user"`
+	// Identifier of a private chat with the user who created the business connection. This number may have
+	// more than 32 significant bits and some programming languages may have difficulty/silent defects in
+	// interpreting it. But it has at most 52 significant bits, so a 64-bit integer or double-precision
+	// float type are safe for storing this identifier.
+	UserChatID int64 `json:"user_chat_id"`
+	// Date the connection was established in Unix time
+	Date int64 `json:"date"`
+	// True, if the bot can act on behalf of the business account in chats that were active in the last 24
+	// hours
+	CanReply bool `json:"can_reply"`
+	// True, if the connection is active
+	IsEnabled bool `json:"is_enabled"`
+}
+
+// BusinessIntro is the BusinessIntro object, see https://core.telegram.org/bots/api#businessintro
+//
+// Contains information about the start page settings of a Telegram Business account.
+type BusinessIntro struct {
+	// Title text of the business intro
+	Title string `json:"title,omitempty"`
+	// Message text of the business intro
+	Message string `json:"message,omitempty"`
+	// Sticker of the business intro
+	Sticker *Sticker `json:"sticker,omitempty"`
+}
+
+// BusinessLocation is the BusinessLocation object, see
+// https://core.telegram.org/bots/api#businesslocation
+//
+// Contains information about the location of a Telegram Business account.
+type BusinessLocation struct {
+	// Address of the business
+	Address string `json:"address"`
+	// Location of the business
+	Location *Location `json:"location,omitempty"`
+}
+
+// BusinessMessagesDeleted is the BusinessMessagesDeleted object, see
+// https://core.telegram.org/bots/api#businessmessagesdeleted
+//
+// This object is received when messages are deleted from a connected business account.
+type BusinessMessagesDeleted struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+	// Information about a chat in the business account. The bot may not have access to the chat or the
+	// corresponding user.
+	Chat Chat `json:"chat"`
+	// A JSON-serialized list of identifiers of deleted messages in the chat of the business account
+	MessageIds []int64 `json:"message_ids"`
+}
+
+// BusinessOpeningHours is the BusinessOpeningHours object, see
+// https://core.telegram.org/bots/api#businessopeninghours
+//
+// Describes the opening hours of a business.
+type BusinessOpeningHours struct {
+	// Unique name of the time zone for which the opening hours are defined
+	TimeZoneName string `json:"time_zone_name"`
+	// List of time intervals describing business opening hours
+	OpeningHours []BusinessOpeningHoursInterval `json:"opening_hours"`
+}
+
+// BusinessOpeningHoursInterval is the BusinessOpeningHoursInterval object, see
+// https://core.telegram.org/bots/api#businessopeninghoursinterval
+//
+// Describes an interval of time during which a business is open.
+type BusinessOpeningHoursInterval struct {
+	// The minute's sequence number in a week, starting on Monday, marking the start of the time interval
+	// during which the business is open; 0 - 7 * 24 * 60
+	OpeningMinute int64 `json:"opening_minute"`
+	// The minute's sequence number in a week, starting on Monday, marking the end of the time interval
+	// during which the business is open; 0 - 8 * 24 * 60
+	ClosingMinute int64 `json:"closing_minute"`
+}
+
+// CallbackGame is the CallbackGame object, see https://core.telegram.org/bots/api#callbackgame
+//
+// A placeholder, currently holds no information. Use BotFather to set up your game.
+type CallbackGame struct {
+}
+
+// CallbackQuery is the CallbackQuery object, see https://core.telegram.org/bots/api#callbackquery
+//
+// This object represents an incoming callback query from a callback button in an inline keyboard. If
+// the button that originated the query was attached to a message sent by the bot, the field message
+// will be present. If the button was attached to a message sent via the bot (in inline mode), the
+// field inline_message_id will be present. Exactly one of the fields data or game_short_name will be
+// present.
+type CallbackQuery struct {
+	// Unique identifier for this query
+	ID string `json:"id"`
+	// Sender
+	From User `json:"from"`
+	// Message sent by the bot with the callback button that originated the query
+	Message *MaybeInaccessibleMessage `json:"message,omitempty"`
+	// Identifier of the message sent via the bot in inline mode, that originated the query.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// Global identifier, uniquely corresponding to the chat to which the message with the callback button
+	// was sent. Useful for high scores in games.
+	ChatInstance string `json:"chat_instance"`
+	// Data associated with the callback button. Be aware that the message originated the query can contain
+	// no callback buttons with this data.
+	Data string `json:"data,omitempty"`
+	// Short name of a Game to be returned, serves as the unique identifier for the game
+	GameShortName string `json:"game_short_name,omitempty"`
+}
+
+// Chat is the Chat object, see https://core.telegram.org/bots/api#chat
+//
+// This object represents a chat.
+type Chat struct {
+	// Unique identifier for this chat. This number may have more than 32 significant bits and some
+	// programming languages may have difficulty/silent defects in interpreting it. But it has at most 52
+	// significant bits, so a signed 64-bit integer or double-precision float type are safe for storing
+	// this identifier.
+	ID int64 `json:"id"`
+	// Type of chat, can be either “private”, “group”, “supergroup” or “channel”
+	Type string `json:"type"`
+	// Title, for supergroups, channels and group chats
+	Title string `json:"title,omitempty"`
+	// Username, for private chats, supergroups and channels if available
+	Username string `json:"username,omitempty"`
+	// First name of the other party in a private chat
+	FirstName string `json:"first_name,omitempty"`
+	// Last name of the other party in a private chat
+	LastName string `json:"last_name,omitempty"`
+	// True, if the supergroup chat is a forum (has topics enabled)
+	IsForum bool `json:"is_forum,omitempty"`
+	// Chat photo. Returned only in getChat.
+	Photo *ChatPhoto `json:"photo,omitempty"`
+	// If non-empty, the list of all active chat usernames; for private chats, supergroups and channels.
+	// Returned only in getChat.
+	ActiveUsernames []string `json:"active_usernames,omitempty"`
+	// For private chats, the date of birth of the user. Returned only in getChat.
+	Birthdate *Birthdate `json:"birthdate,omitempty"`
+	// For private chats with business accounts, the intro of the business. Returned only in getChat.
+	BusinessIntro *BusinessIntro `json:"business_intro,omitempty"`
+	// For private chats with business accounts, the location of the business. Returned only in getChat.
+	BusinessLocation *BusinessLocation `json:"business_location,omitempty"`
+	// For private chats with business accounts, the opening hours of the business. Returned only in
+	// getChat.
+	BusinessOpeningHours *BusinessOpeningHours `json:"business_opening_hours,omitempty"`
+	// For private chats, the personal channel of the user. Returned only in getChat.
+	PersonalChat *Chat `json:"personal_chat,omitempty"`
+	// List of available reactions allowed in the chat. If omitted, then all emoji reactions are allowed.
+	// Returned only in getChat.
+	AvailableReactions []ReactionType `json:"available_reactions,omitempty"`
+	// Identifier of the accent color for the chat name and backgrounds of the chat photo, reply header,
+	// and link preview. See accent colors for more details. Always returned in getChat.
+	AccentColorID int64 `json:"accent_color_id,omitempty"`
+	// Custom emoji identifier of emoji chosen by the chat for the reply header and link preview
+	// background. Returned only in getChat.
+	BackgroundCustomEmojiID string `json:"background_custom_emoji_id,omitempty"`
+	// Identifier of the accent color for the chat's profile background. See profile accent colors for more
+	// details. Returned only in getChat.
+	ProfileAccentColorID int64 `json:"profile_accent_color_id,omitempty"`
+	// Custom emoji identifier of the emoji chosen by the chat for its profile background. Returned only in
+	// getChat.
+	ProfileBackgroundCustomEmojiID string `json:"profile_background_custom_emoji_id,omitempty"`
+	// Custom emoji identifier of the emoji status of the chat or the other party in a private chat.
+	// Returned only in getChat.
+	EmojiStatusCustomEmojiID string `json:"emoji_status_custom_emoji_id,omitempty"`
+	// Expiration date of the emoji status of the chat or the other party in a private chat, in Unix time,
+	// if any. Returned only in getChat.
+	EmojiStatusExpirationDate int64 `json:"emoji_status_expiration_date,omitempty"`
+	// Bio of the other party in a private chat. Returned only in getChat.
+	Bio string `json:"bio,omitempty"`
+	// True, if privacy settings of the other party in the private chat allows to use
+	// tg://user?id=<user_id> links only in chats with the user. Returned only in getChat.
+	HasPrivateForwards bool `json:"has_private_forwards,omitempty"`
+	// True, if the privacy settings of the other party restrict sending voice and video note messages in
+	// the private chat. Returned only in getChat.
+	HasRestrictedVoiceAndVideoMessages bool `json:"has_restricted_voice_and_video_messages,omitempty"`
+	// True, if users need to join the supergroup before they can send messages. Returned only in getChat.
+	JoinToSendMessages bool `json:"join_to_send_messages,omitempty"`
+	// True, if all users directly joining the supergroup without using an invite link need to be approved
+	// by supergroup administrators. Returned only in getChat.
+	JoinByRequest bool `json:"join_by_request,omitempty"`
+	// Description, for groups, supergroups and channel chats. Returned only in getChat.
+	Description string `json:"description,omitempty"`
+	// Primary invite link, for groups, supergroups and channel chats. Returned only in getChat.
+	InviteLink string `json:"invite_link,omitempty"`
+	// The most recent pinned message (by sending date). Returned only in getChat.
+	PinnedMessage *Message `json:"pinned_message,omitempty"`
+	// Default chat member permissions, for groups and supergroups. Returned only in getChat.
+	Permissions *ChatPermissions `json:"permissions,omitempty"`
+	// For supergroups, the minimum allowed delay between consecutive messages sent by each unprivileged
+	// user; in seconds. Returned only in getChat.
+	SlowModeDelay int64 `json:"slow_mode_delay,omitempty"`
+	// For supergroups, the minimum number of boosts that a non-administrator user needs to add in order to
+	// ignore slow mode and chat permissions. Returned only in getChat.
+	UnrestrictBoostCount int64 `json:"unrestrict_boost_count,omitempty"`
+	// The time after which all messages sent to the chat will be automatically deleted; in seconds.
+	// Returned only in getChat.
+	MessageAutoDeleteTime int64 `json:"message_auto_delete_time,omitempty"`
+	// True, if aggressive anti-spam checks are enabled in the supergroup. The field is only available to
+	// chat administrators. Returned only in getChat.
+	HasAggressiveAntiSpamEnabled bool `json:"has_aggressive_anti_spam_enabled,omitempty"`
+	// True, if non-administrators can only get the list of bots and administrators in the chat. Returned
+	// only in getChat.
+	HasHiddenMembers bool `json:"has_hidden_members,omitempty"`
+	// True, if messages from the chat can't be forwarded to other chats. Returned only in getChat.
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+	// True, if new chat members will have access to old messages; available only to chat administrators.
+	// Returned only in getChat.
+	HasVisibleHistory bool `json:"has_visible_history,omitempty"`
+	// For supergroups, name of group sticker set. Returned only in getChat.
+	StickerSetName string `json:"sticker_set_name,omitempty"`
+	// True, if the bot can change the group sticker set. Returned only in getChat.
+	CanSetStickerSet bool `json:"can_set_sticker_set,omitempty"`
+	// For supergroups, the name of the group's custom emoji sticker set. Custom emoji from this set can be
+	// used by all users and bots in the group. Returned only in getChat.
+	CustomEmojiStickerSetName string `json:"custom_emoji_sticker_set_name,omitempty"`
+	// Unique identifier for the linked chat, i.e. the discussion group identifier for a channel and vice
+	// versa; for supergroups and channel chats. Returned only in getChat.
+	LinkedChatID int64 `json:"linked_chat_id,omitempty"`
+	// For supergroups, the location to which the supergroup is connected. Returned only in getChat.
+	Location *ChatLocation `json:"location,omitempty"`
+}
+
+// ChatAdministratorRights is the ChatAdministratorRights object, see
+// https://core.telegram.org/bots/api#chatadministratorrights
+//
+// Represents the rights of an administrator in a chat.
+type ChatAdministratorRights struct {
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous"`
+	// True, if the administrator can access the chat event log, get boost list, see hidden supergroup and
+	// channel members, report spam messages and ignore slow mode. Implied by any other administrator
+	// privilege.
+	CanManageChat bool `json:"can_manage_chat"`
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages"`
+	// True, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	// True, if the administrator can restrict, ban or unban chat members, or access supergroup statistics
+	CanRestrictMembers bool `json:"can_restrict_members"`
+	// True, if the administrator can add new administrators with a subset of their own privileges or
+	// demote administrators that they have promoted, directly or indirectly (promoted by administrators
+	// that were appointed by the user)
+	CanPromoteMembers bool `json:"can_promote_members"`
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info"`
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users"`
+	// True, if the administrator can post stories to the chat
+	CanPostStories bool `json:"can_post_stories"`
+	// True, if the administrator can edit stories posted by other users
+	CanEditStories bool `json:"can_edit_stories"`
+	// True, if the administrator can delete stories posted by other users
+	CanDeleteStories bool `json:"can_delete_stories"`
+	// True, if the administrator can post messages in the channel, or access channel statistics; for
+	// channels only
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+	// True, if the administrator can edit messages of other users and can pin messages; for channels only
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+	// True, if the user is allowed to pin messages; for groups and supergroups only
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+	// True, if the user is allowed to create, rename, close, and reopen forum topics; for supergroups only
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+// ChatBoost is the ChatBoost object, see https://core.telegram.org/bots/api#chatboost
+//
+// This object contains information about a chat boost.
+type ChatBoost struct {
+	// Unique identifier of the boost
+	BoostID string `json:"boost_id"`
+	// Point in time (Unix timestamp) when the chat was boosted
+	AddDate int64 `json:"add_date"`
+	// Point in time (Unix timestamp) when the boost will automatically expire, unless the booster's
+	// Telegram Premium subscription is prolonged
+	ExpirationDate int64 `json:"expiration_date"`
+	// Source of the added boost
+	Source ChatBoostSource `json:"source"`
+}
+
+// ChatBoostAdded is the ChatBoostAdded object, see https://core.telegram.org/bots/api#chatboostadded
+//
+// This object represents a service message about a user boosting a chat.
+type ChatBoostAdded struct {
+	// Number of boosts added by the user
+	BoostCount int64 `json:"boost_count"`
+}
+
+// ChatBoostRemoved is the ChatBoostRemoved object, see
+// https://core.telegram.org/bots/api#chatboostremoved
+//
+// This object represents a boost removed from a chat.
+type ChatBoostRemoved struct {
+	// Chat which was boosted
+	Chat Chat `json:"chat"`
+	// Unique identifier of the boost
+	BoostID string `json:"boost_id"`
+	// Point in time (Unix timestamp) when the boost was removed
+	RemoveDate int64 `json:"remove_date"`
+	// Source of the removed boost
+	Source ChatBoostSource `json:"source"`
+}
+
+// ChatBoostSource is the ChatBoostSource object, see
+// https://core.telegram.org/bots/api#chatboostsource
+//
+// This object describes the source of a chat boost. It can be one of
+//
+// The fields of ChatBoostSourcePremium, ChatBoostSourceGiftCode, ChatBoostSourceGiveaway are merged.
+type ChatBoostSource struct {
+	// Source of the boost, one of ChatBoostSourcePremium, ChatBoostSourceGiftCode, ChatBoostSourceGiveaway
+	Source string `json:"source"`
+	// User that boosted the chat
+	User *User `json:"user,omitempty"`
+	// Identifier of a message in the chat with the giveaway; the message could have been deleted already.
+	// May be 0 if the message isn't sent yet.
+	GiveawayMessageID int64 `json:"giveaway_message_id,omitempty"`
+	// True, if the giveaway was completed, but there was no user to win the prize
+	IsUnclaimed bool `json:"is_unclaimed,omitempty"`
+}
+
+const (
+	// ChatBoostSourcePremium is the source of ChatBoostSource, see
+	// https://core.telegram.org/bots/api#chatboostsourcepremium
+	//
+	// The boost was obtained by subscribing to Telegram Premium or by gifting a Telegram Premium
+	// subscription to another user.
+	ChatBoostSourcePremium = "premium"
+	// ChatBoostSourceGiftCode is the source of ChatBoostSource, see
+	// https://core.telegram.org/bots/api#chatboostsourcegiftcode
+	//
+	// The boost was obtained by the creation of Telegram Premium gift codes to boost a chat. Each such
+	// code boosts the chat 4 times for the duration of the corresponding Telegram Premium subscription.
+	ChatBoostSourceGiftCode = "gift_code"
+	// ChatBoostSourceGiveaway is the source of ChatBoostSource, see
+	// https://core.telegram.org/bots/api#chatboostsourcegiveaway
+	//
+	// The boost was obtained by the creation of a Telegram Premium giveaway. This boosts the chat 4 times
+	// for the duration of the corresponding Telegram Premium subscription.
+	ChatBoostSourceGiveaway = "giveaway"
+)
+
+// ChatBoostUpdated is the ChatBoostUpdated object, see
+// https://core.telegram.org/bots/api#chatboostupdated
+//
+// This object represents a boost added to a chat or changed.
+type ChatBoostUpdated struct {
+	// Chat which was boosted
+	Chat Chat `json:"chat"`
+	// Information about the chat boost
+	Boost ChatBoost `json:"boost"`
+}
+
+// ChatInviteLink is the ChatInviteLink object, see https://core.telegram.org/bots/api#chatinvitelink
+//
+// Represents an invite link for a chat.
+type ChatInviteLink struct {
+	// The invite link. If the link was created by another chat administrator, then the second part of the
+	// link will be replaced with “…”.
+	InviteLink string `json:"invite_link"`
+	// Creator of the link
+	Creator User `json:"creator"`
+	// True, if users joining the chat via the link need to be approved by chat administrators
+	CreatesJoinRequest bool `json:"creates_join_request"`
+	// True, if the link is primary
+	IsPrimary bool `json:"is_primary"`
+	// True, if the link is revoked
+	IsRevoked bool `json:"is_revoked"`
+	// Invite link name
+	Name string `json:"name,omitempty"`
+	// Point in time (Unix timestamp) when the link will expire or has been expired
+	ExpireDate int64 `json:"expire_date,omitempty"`
+	// The maximum number of users that can be members of the chat simultaneously after joining the chat
+	// via this invite link; 1-99999
+	MemberLimit int64 `json:"member_limit,omitempty"`
+	// Number of pending join requests created using this link
+	PendingJoinRequestCount int64 `json:"pending_join_request_count,omitempty"`
+}
+
+// ChatJoinRequest is the ChatJoinRequest object, see
+// https://core.telegram.org/bots/api#chatjoinrequest
+//
+// Represents a join request sent to a chat.
+type ChatJoinRequest struct {
+	// Chat to which the request was sent
+	Chat Chat `json:"chat"`
+	// User that sent the join request
+	From User `json:"from"`
+	// Identifier of a private chat with the user who sent the join request. This number may have more than
+	// 32 significant bits and some programming languages may have difficulty/silent defects in
+	// interpreting it. But it has at most 52 significant bits, so a 64-bit integer or double-precision
+	// float type are safe for storing this identifier. The bot can use this identifier for 5 minutes to
+	// send messages until the join request is processed, assuming no other administrator contacted the
+	// user.
+	UserChatID int64 `json:"user_chat_id"`
+	// Date the request was sent in Unix time
+	Date int64 `json:"date"`
+	// Bio of the user.
+	Bio string `json:"bio,omitempty"`
+	// Chat invite link that was used by the user to send the join request
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+}
+
+// ChatLocation is the ChatLocation object, see https://core.telegram.org/bots/api#chatlocation
+//
+// Represents a location to which a chat is connected.
+type ChatLocation struct {
+	// The location to which the supergroup is connected. Can't be a live location.
+	Location Location `json:"location"`
+	// Location address; 1-64 characters, as defined by the chat owner
+	Address string `json:"address"`
+}
+
+// ChatMember is the ChatMember object, see https://core.telegram.org/bots/api#chatmember
+//
+// This object contains information about one member of a chat. Currently, the following 6 types of
+// chat members are supported:
+//
+// The fields of ChatMemberOwner, ChatMemberAdministrator, ChatMemberMember, ChatMemberRestricted,
+// ChatMemberLeft, ChatMemberBanned are merged.
+type ChatMember struct {
+	// The member's status in the chat, one of ChatMemberOwner, ChatMemberAdministrator, ChatMemberMember,
+	// ChatMemberRestricted, ChatMemberLeft, ChatMemberBanned
+	Status string `json:"status"`
+	// Information about the user
+	User User `json:"user"`
+	// True, if the user's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous,omitempty"`
+	// Custom title for this user
+	CustomTitle string `json:"custom_title,omitempty"`
+	// True, if the bot is allowed to edit administrator privileges of that user
+	CanBeEdited bool `json:"can_be_edited,omitempty"`
+	// True, if the administrator can access the chat event log, get boost list, see hidden supergroup and
+	// channel members, report spam messages and ignore slow mode. Implied by any other administrator
+	// privilege.
+	CanManageChat bool `json:"can_manage_chat,omitempty"`
+	// True, if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages,omitempty"`
+	// True, if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats,omitempty"`
+	// True, if the administrator can restrict, ban or unban chat members, or access supergroup statistics
+	CanRestrictMembers bool `json:"can_restrict_members,omitempty"`
+	// True, if the administrator can add new administrators with a subset of their own privileges or
+	// demote administrators that they have promoted, directly or indirectly (promoted by administrators
+	// that were appointed by the user)
+	CanPromoteMembers bool `json:"can_promote_members,omitempty"`
+	// True, if the user is allowed to change the chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+	// True, if the administrator can post stories to the chat
+	CanPostStories bool `json:"can_post_stories,omitempty"`
+	// True, if the administrator can edit stories posted by other users
+	CanEditStories bool `json:"can_edit_stories,omitempty"`
+	// True, if the administrator can delete stories posted by other users
+	CanDeleteStories bool `json:"can_delete_stories,omitempty"`
+	// True, if the administrator can post messages in the channel, or access channel statistics; for
+	// channels only
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+	// True, if the administrator can edit messages of other users and can pin messages; for channels only
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+	// True, if the user is allowed to pin messages; for groups and supergroups only
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+	// True, if the user is allowed to create, rename, close, and reopen forum topics; for supergroups only
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+	// Date when the user's subscription will expire; Unix time
+	UntilDate int64 `json:"until_date,omitempty"`
+	// True, if the user is a member of the chat at the moment of the request
+	IsMember bool `json:"is_member,omitempty"`
+	// True, if the user is allowed to send text messages, contacts, giveaways, giveaway winners, invoices,
+	// locations and venues
+	CanSendMessages bool `json:"can_send_messages,omitempty"`
+	// True, if the user is allowed to send audios
+	CanSendAudios bool `json:"can_send_audios,omitempty"`
+	// True, if the user is allowed to send documents
+	CanSendDocuments bool `json:"can_send_documents,omitempty"`
+	// True, if the user is allowed to send photos
+	CanSendPhotos bool `json:"can_send_photos,omitempty"`
+	// True, if the user is allowed to send videos
+	CanSendVideos bool `json:"can_send_videos,omitempty"`
+	// True, if the user is allowed to send video notes
+	CanSendVideoNotes bool `json:"can_send_video_notes,omitempty"`
+	// True, if the user is allowed to send voice notes
+	CanSendVoiceNotes bool `json:"can_send_voice_notes,omitempty"`
+	// True, if the user is allowed to send polls
+	CanSendPolls bool `json:"can_send_polls,omitempty"`
+	// True, if the user is allowed to send animations, games, stickers and use inline bots
+	CanSendOtherMessages bool `json:"can_send_other_messages,omitempty"`
+	// True, if the user is allowed to add web page previews to their messages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+}
+
+const (
+	// ChatMemberOwner is the status of ChatMember, see https://core.telegram.org/bots/api#chatmemberowner
+	//
+	// Represents a chat member that owns the chat and has all administrator privileges.
+	ChatMemberOwner = "creator"
+	// ChatMemberAdministrator is the status of ChatMember, see
+	// https://core.telegram.org/bots/api#chatmemberadministrator
+	//
+	// Represents a chat member that has some additional privileges.
+	ChatMemberAdministrator = "administrator"
+	// ChatMemberMember is the status of ChatMember, see
+	// https://core.telegram.org/bots/api#chatmembermember
+	//
+	// Represents a chat member that has no additional privileges or restrictions.
+	ChatMemberMember = "member"
+	// ChatMemberRestricted is the status of ChatMember, see
+	// https://core.telegram.org/bots/api#chatmemberrestricted
+	//
+	// Represents a chat member that is under certain restrictions in the chat. Supergroups only.
+	ChatMemberRestricted = "restricted"
+	// ChatMemberLeft is the status of ChatMember, see https://core.telegram.org/bots/api#chatmemberleft
+	//
+	// Represents a chat member that isn't currently a member of the chat, but may join it themselves.
+	ChatMemberLeft = "left"
+	// ChatMemberBanned is the status of ChatMember, see
+	// https://core.telegram.org/bots/api#chatmemberbanned
+	//
+	// Represents a chat member that was banned in the chat and can't return to the chat or view chat
+	// messages.
+	ChatMemberBanned = "kicked"
+)
+
+// ChatMemberUpdated is the ChatMemberUpdated object, see
+// https://core.telegram.org/bots/api#chatmemberupdated
+//
+// This object represents changes in the status of a chat member.
+type ChatMemberUpdated struct {
+	// Chat the user belongs to
+	Chat Chat `json:"chat"`
+	// Performer of the action, which resulted in the change
+	From User `json:"from"`
+	// Date the change was done in Unix time
+	Date int64 `json:"date"`
+	// Previous information about the chat member
+	OldChatMember ChatMember `json:"old_chat_member"`
+	// New information about the chat member
+	NewChatMember ChatMember `json:"new_chat_member"`
+	// Chat invite link, which was used by the user to join the chat; for joining by invite link events
+	// only.
+	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
+	// True, if the user joined the chat via a chat folder invite link
+	ViaChatFolderInviteLink bool `json:"via_chat_folder_invite_link,omitempty"`
+}
+
+// ChatPermissions is the ChatPermissions object, see
+// https://core.telegram.org/bots/api#chatpermissions
+//
+// Describes actions that a non-administrator user is allowed to take in a chat.
+type ChatPermissions struct {
+	// True, if the user is allowed to send text messages, contacts, giveaways, giveaway winners, invoices,
+	// locations and venues
+	CanSendMessages bool `json:"can_send_messages,omitempty"`
+	// True, if the user is allowed to send audios
+	CanSendAudios bool `json:"can_send_audios,omitempty"`
+	// True, if the user is allowed to send documents
+	CanSendDocuments bool `json:"can_send_documents,omitempty"`
+	// True, if the user is allowed to send photos
+	CanSendPhotos bool `json:"can_send_photos,omitempty"`
+	// True, if the user is allowed to send videos
+	CanSendVideos bool `json:"can_send_videos,omitempty"`
+	// True, if the user is allowed to send video notes
+	CanSendVideoNotes bool `json:"can_send_video_notes,omitempty"`
+	// True, if the user is allowed to send voice notes
+	CanSendVoiceNotes bool `json:"can_send_voice_notes,omitempty"`
+	// True, if the user is allowed to send polls
+	CanSendPolls bool `json:"can_send_polls,omitempty"`
+	// True, if the user is allowed to send animations, games, stickers and use inline bots
+	CanSendOtherMessages bool `json:"can_send_other_messages,omitempty"`
+	// True, if the user is allowed to add web page previews to their messages
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews,omitempty"`
+	// True, if the user is allowed to change the chat title, photo and other settings. Ignored in public
+	// supergroups
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+	// True, if the user is allowed to invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+	// True, if the user is allowed to pin messages. Ignored in public supergroups
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+	// True, if the user is allowed to create forum topics. If omitted defaults to the value of
+	// can_pin_messages
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+// ChatPhoto is the ChatPhoto object, see https://core.telegram.org/bots/api#chatphoto
+//
+// This object represents a chat photo.
+type ChatPhoto struct {
+	// File identifier of small (160x160) chat photo. This file_id can be used only for photo download and
+	// only for as long as the photo is not changed.
+	SmallFileID string `json:"small_file_id"`
+	// Unique file identifier of small (160x160) chat photo, which is supposed to be the same over time and
+	// for different bots. Can't be used to download or reuse the file.
+	SmallFileUniqueID string `json:"small_file_unique_id"`
+	// File identifier of big (640x640) chat photo. This file_id can be used only for photo download and
+	// only for as long as the photo is not changed.
+	BigFileID string `json:"big_file_id"`
+	// Unique file identifier of big (640x640) chat photo, which is supposed to be the same over time and
+	// for different bots. Can't be used to download or reuse the file.
+	BigFileUniqueID string `json:"big_file_unique_id"`
+}
+
+// ChatShared is the ChatShared object, see https://core.telegram.org/bots/api#chatshared
+//
+// This object contains information about a chat that was shared with the bot using a
+// KeyboardButtonRequestChat button.
+type ChatShared struct {
+	// Identifier of the request
+	RequestID int64 `json:"request_id"`
+	// Identifier of the shared chat. This number may have more than 32 significant bits and some
+	// programming languages may have difficulty/silent defects in interpreting it. But it has at most 52
+	// significant bits, so a 64-bit integer or double-precision float type are safe for storing this
+	// identifier. The bot may not have access to the chat and could be unable to use this identifier,
+	// unless the chat is already known to the bot by some other means.
+	ChatID int64 `json:"chat_id"`
+	// Title of the chat, if the title was requested by the bot.
+	Title string `json:"title,omitempty"`
+	// Username of the chat, if the username was requested by the bot and available.
+	Username string `json:"username,omitempty"`
+	// Available sizes of the chat photo, if the photo was requested by the bot
+	Photo []PhotoSize `json:"photo,omitempty"`
+}
+
+// ChosenInlineResult is the ChosenInlineResult object, see
+// https://core.telegram.org/bots/api#choseninlineresult
+//
+// Represents a result of an inline query that was chosen by the user and sent to their chat partner.
+type ChosenInlineResult struct {
+	// The unique identifier for the result that was chosen
+	ResultID string `json:"result_id"`
+	// The user that chose the result
+	From User `json:"from"`
+	// Sender location, only for bots that require user location
+	Location *Location `json:"location,omitempty"`
+	// Identifier of the sent inline message. Available only if there is an inline keyboard attached to the
+	// message. Will be also received in callback queries and can be used to edit the message.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// The query that was used to obtain the result
+	Query string `json:"query"`
+}
+
+// Contact is the Contact object, see https://core.telegram.org/bots/api#contact
+//
+// This object represents a phone contact.
+type Contact struct {
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+	// Contact's first name
+	FirstName string `json:"first_name"`
+	// Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Contact's user identifier in Telegram. This number may have more than 32 significant bits and some
+	// programming languages may have difficulty/silent defects in interpreting it. But it has at most 52
+	// significant bits, so a 64-bit integer or double-precision float type are safe for storing this
+	// identifier.
+	UserID int64 `json:"user_id,omitempty"`
+	// Additional data about the contact in the form of a vCard
+	Vcard string `json:"vcard,omitempty"`
+}
+
+// Dice is the Dice object, see https://core.telegram.org/bots/api#dice
+//
+// This object represents an animated emoji that displays a random value.
+type Dice struct {
+	// Emoji on which the dice throw animation is based
+	Emoji string `json:"emoji"`
+	// Value of the dice, 1-6 for “🎲”, “🎯” and “🎳” base emoji, 1-5 for “🏀” and
+	// “⚽” base emoji, 1-64 for “🎰” base emoji
+	Value int64 `json:"value"`
+}
+
+// Document is the Document object, see https://core.telegram.org/bots/api#document
+//
+// This object represents a general file (as opposed to photos, voice messages and audio files).
+type Document struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Document thumbnail as defined by sender
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+	// Original filename as defined by sender
+	FileName string `json:"file_name,omitempty"`
+	// MIME type of the file as defined by sender
+	MimeType string `json:"mime_type,omitempty"`
+	// File size in bytes. It can be bigger than 2^31 and some programming languages may have
+	// difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed
+	// 64-bit integer or double-precision float type are safe for storing this value.
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// EncryptedCredentials is the EncryptedCredentials object, see
+// https://core.telegram.org/bots/api#encryptedcredentials
+//
+// Describes data required for decrypting and authenticating EncryptedPassportElement. See the Telegram
+// Passport Documentation for a complete description of the data decryption and authentication
+// processes.
+type EncryptedCredentials struct {
+	// Base64-encoded encrypted JSON-serialized data with unique user's payload, data hashes and secrets
+	// required for EncryptedPassportElement decryption and authentication
+	Data string `json:"data"`
+	// Base64-encoded data hash for data authentication
+	Hash string `json:"hash"`
+	// Base64-encoded secret, encrypted with the bot's public RSA key, required for data decryption
+	Secret string `json:"secret"`
+}
+
+// EncryptedPassportElement is the EncryptedPassportElement object, see
+// https://core.telegram.org/bots/api#encryptedpassportelement
+//
+// Describes documents or other Telegram Passport elements shared with the bot by the user.
+type EncryptedPassportElement struct {
+	// Element type. One of “personal_details”, “passport”, “driver_license”,
+	// “identity_card”, “internal_passport”, “address”, “utility_bill”,
+	// “bank_statement”, “rental_agreement”, “passport_registration”,
+	// “temporary_registration”, “phone_number”, “email”.
+	Type string `json:"type"`
+	// Base64-encoded encrypted Telegram Passport element data provided by the user; available only for
+	// “personal_details”, “passport”, “driver_license”, “identity_card”,
+	// “internal_passport” and “address” types. Can be decrypted and verified using the
+	// accompanying EncryptedCredentials.
+	Data string `json:"data,omitempty"`
+	// User's verified phone number; available only for “phone_number” type
+	PhoneNumber string `json:"phone_number,omitempty"`
+	// User's verified email address; available only for “email” type
+	Email string `json:"email,omitempty"`
+	// Array of encrypted files with documents provided by the user; available only for “utility_bill”,
+	// “bank_statement”, “rental_agreement”, “passport_registration” and
+	// “temporary_registration” types. Files can be decrypted and verified using the accompanying
+	// EncryptedCredentials.
+	Files []PassportFile `json:"files,omitempty"`
+	// Encrypted file with the front side of the document, provided by the user; available only for
+	// “passport”, “driver_license”, “identity_card” and “internal_passport”. The file can
+	// be decrypted and verified using the accompanying EncryptedCredentials.
+	FrontSide *PassportFile `json:"front_side,omitempty"`
+	// Encrypted file with the reverse side of the document, provided by the user; available only for
+	// “driver_license” and “identity_card”. The file can be decrypted and verified using the
+	// accompanying EncryptedCredentials.
+	ReverseSide *PassportFile `json:"reverse_side,omitempty"`
+	// Encrypted file with the selfie of the user holding a document, provided by the user; available if
+	// requested for “passport”, “driver_license”, “identity_card” and “internal_passport”.
+	// The file can be decrypted and verified using the accompanying EncryptedCredentials.
+	Selfie *PassportFile `json:"selfie,omitempty"`
+	// Array of encrypted files with translated versions of documents provided by the user; available if
+	// requested for “passport”, “driver_license”, “identity_card”, “internal_passport”,
+	// “utility_bill”, “bank_statement”, “rental_agreement”, “passport_registration” and
+	// “temporary_registration” types. Files can be decrypted and verified using the accompanying
+	// EncryptedCredentials.
+	Translation []PassportFile `json:"translation,omitempty"`
+	// Base64-encoded element hash for using in PassportElementErrorUnspecified
+	Hash string `json:"hash"`
+}
+
+// ExternalReplyInfo is the ExternalReplyInfo object, see
+// https://core.telegram.org/bots/api#externalreplyinfo
+//
+// This object contains information about a message that is being replied to, which may come from
+// another chat or forum topic.
+type ExternalReplyInfo struct {
+	// Origin of the message replied to by the given message
+	Origin MessageOrigin `json:"origin"`
+	// Chat the original message belongs to. Available only if the chat is a supergroup or a channel.
+	Chat *Chat `json:"chat,omitempty"`
+	// Unique message identifier inside the original chat. Available only if the original chat is a
+	// supergroup or a channel.
+	MessageID int64 `json:"message_id,omitempty"`
+	// Options used for link preview generation for the original message, if it is a text message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Message is an animation, information about the animation
+	Animation *Animation `json:"animation,omitempty"`
+	// Message is an audio file, information about the file
+	Audio *Audio `json:"audio,omitempty"`
+	// Message is a general file, information about the file
+	Document *Document `json:"document,omitempty"`
+	// Message is a photo, available sizes of the photo
+	Photo []PhotoSize `json:"photo,omitempty"`
+	// Message is a sticker, information about the sticker
+	Sticker *Sticker `json:"sticker,omitempty"`
+	// Message is a forwarded story
+	Story *Story `json:"story,omitempty"`
+	// Message is a video, information about the video
+	Video *Video `json:"video,omitempty"`
+	// Message is a video note, information about the video message
+	VideoNote *VideoNote `json:"video_note,omitempty"`
+	// Message is a voice message, information about the file
+	Voice *Voice `json:"voice,omitempty"`
+	// True, if the message media is covered by a spoiler animation
+	HasMediaSpoiler bool `json:"has_media_spoiler,omitempty"`
+	// Message is a shared contact, information about the contact
+	Contact *Contact `json:"contact,omitempty"`
+	// Message is a dice with random value
+	Dice *Dice `json:"dice,omitempty"`
+	// Message is a game, information about the game.
+	Game *Game `json:"game,omitempty"`
+	// Message is a scheduled giveaway, information about the giveaway
+	Giveaway *Giveaway `json:"giveaway,omitempty"`
+	// A giveaway with public winners was completed
+	GiveawayWinners *GiveawayWinners `json:"giveaway_winners,omitempty"`
+	// Message is an invoice for a payment, information about the invoice.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Message is a shared location, information about the location
+	Location *Location `json:"location,omitempty"`
+	// Message is a native poll, information about the poll
+	Poll *Poll `json:"poll,omitempty"`
+	// Message is a venue, information about the venue
+	Venue *Venue `json:"venue,omitempty"`
+}
+
+// File is the File object, see https://core.telegram.org/bots/api#file
+//
+// This object represents a file ready to be downloaded. The file can be downloaded via the link
+// https://api.telegram.org/file/bot<token>/<file_path>. It is guaranteed that the link will be valid
+// for at least 1 hour. When the link expires, a new one can be requested by calling getFile.
+type File struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// File size in bytes. It can be bigger than 2^31 and some programming languages may have
+	// difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed
+	// 64-bit integer or double-precision float type are safe for storing this value.
+	FileSize int64 `json:"file_size,omitempty"`
+	// File path. Use https://api.telegram.org/file/bot<token>/<file_path> to get the file.
+	FilePath string `json:"file_path,omitempty"`
+}
+
+// ForceReply is the ForceReply object, see https://core.telegram.org/bots/api#forcereply
+//
+// Upon receiving a message with this object, Telegram clients will display a reply interface to the
+// user (act as if the user has selected the bot's message and tapped 'Reply'). This can be extremely
+// useful if you want to create user-friendly step-by-step interfaces without having to sacrifice
+// privacy mode.
+type ForceReply struct {
+	// Shows reply interface to the user, as if they manually selected the bot's message and tapped 'Reply'
+	ForceReply bool `json:"force_reply"`
+	// The placeholder to be shown in the input field when the reply is active; 1-64 characters
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+	// Use this parameter if you want to force reply from specific users only. Targets: 1) users that are
+	// @mentioned in the text of the Message object; 2) if the bot's message is a reply to a message in the
+	// same chat and forum topic, sender of the original message.
+	Selective bool `json:"selective,omitempty"`
+}
+
+// ForumTopic is the ForumTopic object, see https://core.telegram.org/bots/api#forumtopic
+//
+// This object represents a forum topic.
+type ForumTopic struct {
+	// Unique identifier of the forum topic
+	MessageThreadID int64 `json:"message_thread_id"`
+	// Name of the topic
+	Name string `json:"name"`
+	// Color of the topic icon in RGB format
+	IconColor int64 `json:"icon_color"`
+	// Unique identifier of the custom emoji shown as the topic icon
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// ForumTopicClosed is the ForumTopicClosed object, see
+// https://core.telegram.org/bots/api#forumtopicclosed
+//
+// This object represents a service message about a forum topic closed in the chat. Currently holds no
+// information.
+type ForumTopicClosed struct {
+}
+
+// ForumTopicCreated is the ForumTopicCreated object, see
+// https://core.telegram.org/bots/api#forumtopiccreated
+//
+// This object represents a service message about a new forum topic created in the chat.
+type ForumTopicCreated struct {
+	// Name of the topic
+	Name string `json:"name"`
+	// Color of the topic icon in RGB format
+	IconColor int64 `json:"icon_color"`
+	// Unique identifier of the custom emoji shown as the topic icon
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// ForumTopicEdited is the ForumTopicEdited object, see
+// https://core.telegram.org/bots/api#forumtopicedited
+//
+// This object represents a service message about an edited forum topic.
+type ForumTopicEdited struct {
+	// New name of the topic, if it was edited
+	Name string `json:"name,omitempty"`
+	// New identifier of the custom emoji shown as the topic icon, if it was edited; an empty string if the
+	// icon was removed
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// ForumTopicReopened is the ForumTopicReopened object, see
+// https://core.telegram.org/bots/api#forumtopicreopened
+//
+// This object represents a service message about a forum topic reopened in the chat. Currently holds
+// no information.
+type ForumTopicReopened struct {
+}
+
+// Game is the Game object, see https://core.telegram.org/bots/api#game
+//
+// This object represents a game. Use BotFather to create and edit games, their short names will act as
+// unique identifiers.
+type Game struct {
+	// Title of the game
+	Title string `json:"title"`
+	// Description of the game
+	Description string `json:"description"`
+	// Photo that will be displayed in the game message in chats.
+	Photo []PhotoSize `json:"photo"`
+	// Brief description of the game or high scores included in the game message. Can be automatically
+	// edited to include current high scores for the game when the bot calls setGameScore, or manually
+	// edited using editMessageText. 0-4096 characters.
+	Text string `json:"text,omitempty"`
+	// Special entities that appear in text, such as usernames, URLs, bot commands, etc.
+	TextEntities []MessageEntity `json:"text_entities,omitempty"`
+	// Animation that will be displayed in the game message in chats. Upload via BotFather
+	Animation *Animation `json:"animation,omitempty"`
+}
+
+// GameHighScore is the GameHighScore object, see https://core.telegram.org/bots/api#gamehighscore
+//
+// This object represents one row of the high scores table for a game.
+type GameHighScore struct {
+	// Position in high score table for the game
+	Position int64 `json:"position"`
+	// User
+	User User `json:"user"`
+	// Score
+	Score int64 `json:"score"`
+}
+
+// GeneralForumTopicHidden is the GeneralForumTopicHidden object, see
+// https://core.telegram.org/bots/api#generalforumtopichidden
+//
+// This object represents a service message about General forum topic hidden in the chat. Currently
+// holds no information.
+type GeneralForumTopicHidden struct {
+}
+
+// GeneralForumTopicUnhidden is the GeneralForumTopicUnhidden object, see
+// https://core.telegram.org/bots/api#generalforumtopicunhidden
+//
+// This object represents a service message about General forum topic unhidden in the chat. Currently
+// holds no information.
+type GeneralForumTopicUnhidden struct {
+}
+
+// Giveaway is the Giveaway object, see https://core.telegram.org/bots/api#giveaway
+//
+// This object represents a message about a scheduled giveaway.
+type Giveaway struct {
+	// The list of chats which the user must join to participate in the giveaway
+	Chats []Chat `json:"chats"`
+	// Point in time (Unix timestamp) when winners of the giveaway will be selected
+	WinnersSelectionDate int64 `json:"winners_selection_date"`
+	// The number of users which are supposed to be selected as winners of the giveaway
+	WinnerCount int64 `json:"winner_count"`
+	// True, if only users who join the chats after the giveaway started should be eligible to win
+	OnlyNewMembers bool `json:"only_new_members,omitempty"`
+	// True, if the list of giveaway winners will be visible to everyone
+	HasPublicWinners bool `json:"has_public_winners,omitempty"`
+	// Description of additional giveaway prize
+	PrizeDescription string `json:"prize_description,omitempty"`
+	// A list of two-letter ISO 3166-1 alpha-2 country codes indicating the countries from which eligible
+	// users for the giveaway must come. If empty, then all users can participate in the giveaway. Users
+	// with a phone number that was bought on Fragment can always participate in giveaways.
+	CountryCodes []string `json:"country_codes,omitempty"`
+	// The number of months the Telegram Premium subscription won from the giveaway will be active for
+	PremiumSubscriptionMonthCount int64 `json:"premium_subscription_month_count,omitempty"`
+}
+
+// GiveawayCompleted is the GiveawayCompleted object, see
+// https://core.telegram.org/bots/api#giveawaycompleted
+//
+// This object represents a service message about the completion of a giveaway without public winners.
+type GiveawayCompleted struct {
+	// Number of winners in the giveaway
+	WinnerCount int64 `json:"winner_count"`
+	// Number of undistributed prizes
+	UnclaimedPrizeCount int64 `json:"unclaimed_prize_count,omitempty"`
+	// Message with the giveaway that was completed, if it wasn't deleted
+	GiveawayMessage *Message `json:"giveaway_message,omitempty"`
+}
+
+// GiveawayCreated is the GiveawayCreated object, see
+// https://core.telegram.org/bots/api#giveawaycreated
+//
+// This object represents a service message about the creation of a scheduled giveaway. Currently holds
+// no information.
+type GiveawayCreated struct {
+}
+
+// GiveawayWinners is the GiveawayWinners object, see
+// https://core.telegram.org/bots/api#giveawaywinners
+//
+// This object represents a message about the completion of a giveaway with public winners.
+type GiveawayWinners struct {
+	// The chat that created the giveaway
+	Chat Chat `json:"chat"`
+	// Identifier of the message with the giveaway in the chat
+	GiveawayMessageID int64 `json:"giveaway_message_id"`
+	// Point in time (Unix timestamp) when winners of the giveaway were selected
+	WinnersSelectionDate int64 `json:"winners_selection_date"`
+	// Total number of winners in the giveaway
+	WinnerCount int64 `json:"winner_count"`
+	// List of up to 100 winners of the giveaway
+	Winners []User `json:"winners"`
+	// The number of other chats the user had to join in order to be eligible for the giveaway
+	AdditionalChatCount int64 `json:"additional_chat_count,omitempty"`
+	// The number of months the Telegram Premium subscription won from the giveaway will be active for
+	PremiumSubscriptionMonthCount int64 `json:"premium_subscription_month_count,omitempty"`
+	// Number of undistributed prizes
+	UnclaimedPrizeCount int64 `json:"unclaimed_prize_count,omitempty"`
+	// True, if only users who had joined the chats after the giveaway started were eligible to win
+	OnlyNewMembers bool `json:"only_new_members,omitempty"`
+	// True, if the giveaway was canceled because the payment for it was refunded
+	WasRefunded bool `json:"was_refunded,omitempty"`
+	// Description of additional giveaway prize
+	PrizeDescription string `json:"prize_description,omitempty"`
+}
+
+// InaccessibleMessage is the InaccessibleMessage object, see
+// https://core.telegram.org/bots/api#inaccessiblemessage
+//
+// This object describes a message that was deleted or is otherwise inaccessible to the bot.
+type InaccessibleMessage struct {
+	// Chat the message belonged to
+	Chat Chat `json:"chat"`
+	// Unique message identifier inside the chat
+	MessageID int64 `json:"message_id"`
+	// Always 0. The field can be used to differentiate regular and inaccessible messages.
+	Date int64 `json:"date"`
+}
+
+// InlineKeyboardButton is the InlineKeyboardButton object, see
+// https://core.telegram.org/bots/api#inlinekeyboardbutton
+//
+// This object represents one button of an inline keyboard. You must use exactly one of the optional
+// fields.
+type InlineKeyboardButton struct {
+	// Label text on the button
+	Text string `json:"text"`
+	// HTTP or tg:// URL to be opened when the button is pressed. Links tg://user?id=<user_id> can be used
+	// to mention a user by their identifier without using a username, if this is allowed by their privacy
+	// settings.
+	URL string `json:"url,omitempty"`
+	// Data to be sent in a callback query to the bot when button is pressed, 1-64 bytes
+	CallbackData string `json:"callback_data,omitempty"`
+	// Description of the Web App that will be launched when the user presses the button. The Web App will
+	// be able to send an arbitrary message on behalf of the user using the method answerWebAppQuery.
+	// Available only in private chats between a user and the bot.
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+	// An HTTPS URL used to automatically authorize the user. Can be used as a replacement for the Telegram
+	// Login Widget.
+	LoginURL *LoginUrl `json:"login_url,omitempty"`
+	// If set, pressing the button will prompt the user to select one of their chats, open that chat and
+	// insert the bot's username and the specified inline query in the input field. May be empty, in which
+	// case just the bot's username will be inserted.
+	SwitchInlineQuery string `json:"switch_inline_query,omitempty"`
+	// If set, pressing the button will insert the bot's username and the specified inline query in the
+	// current chat's input field. May be empty, in which case only the bot's username will be inserted.
+	SwitchInlineQueryCurrentChat string `json:"switch_inline_query_current_chat,omitempty"`
+	// If set, pressing the button will prompt the user to select one of their chats of the specified type,
+	// open that chat and insert the bot's username and the specified inline query in the input field
+	SwitchInlineQueryChosenChat *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`
+	// Description of the game that will be launched when the user presses the button. NOTE: This type of
+	// button must always be the first button in the first row.
+	CallbackGame *CallbackGame `json:"callback_game,omitempty"`
+	// Specify True, to send a Pay button. NOTE: This type of button must always be the first button in the
+	// first row and can only be used in invoice messages.
+	Pay bool `json:"pay,omitempty"`
+}
+
+// InlineKeyboardMarkup is the InlineKeyboardMarkup object, see
+// https://core.telegram.org/bots/api#inlinekeyboardmarkup
+//
+// This object represents an inline keyboard that appears right next to the message it belongs to.
+type InlineKeyboardMarkup struct {
+	// Array of button rows, each represented by an Array of InlineKeyboardButton objects
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+// InlineQuery is the InlineQuery object, see https://core.telegram.org/bots/api#inlinequery
+//
+// This object represents an incoming inline query. When the user sends an empty query, your bot could
+// return some default or trending results.
+type InlineQuery struct {
+	// Unique identifier for this query
+	ID string `json:"id"`
+	// Sender
+	From User `json:"from"`
+	// Text of the query (up to 256 characters)
+	Query string `json:"query"`
+	// Offset of the results to be returned, can be controlled by the bot
+	Offset string `json:"offset"`
+	// Type of the chat from which the inline query was sent. Can be either “sender” for a private chat
+	// with the inline query sender, “private”, “group”, “supergroup”, or “channel”. The
+	// chat type should be always known for requests sent from official clients and most third-party
+	// clients, unless the request was sent from a secret chat
+	ChatType string `json:"chat_type,omitempty"`
+	// Sender location, only for bots that request user location
+	Location *Location `json:"location,omitempty"`
+}
+
+// InlineQueryResult is the InlineQueryResult object, see
+// https://core.telegram.org/bots/api#inlinequeryresult
+//
+// This object represents one result of an inline query. Telegram clients currently support results of
+// the following 20 types:
+//
+// The fields of InlineQueryResultCachedAudio, InlineQueryResultCachedDocument,
+// InlineQueryResultCachedGif, InlineQueryResultCachedMpeg4Gif, InlineQueryResultCachedPhoto,
+// InlineQueryResultCachedSticker, InlineQueryResultCachedVideo, InlineQueryResultCachedVoice,
+// InlineQueryResultArticle, InlineQueryResultAudio, InlineQueryResultContact, InlineQueryResultGame,
+// InlineQueryResultDocument, InlineQueryResultGif, InlineQueryResultLocation,
+// InlineQueryResultMpeg4Gif, InlineQueryResultPhoto, InlineQueryResultVenue, InlineQueryResultVideo,
+// InlineQueryResultVoice are merged.
+type InlineQueryResult struct {
+	// Type of the result, one of InlineQueryResultCachedAudio, InlineQueryResultCachedDocument,
+	// InlineQueryResultCachedGif, InlineQueryResultCachedMpeg4Gif, InlineQueryResultCachedPhoto,
+	// InlineQueryResultCachedSticker, InlineQueryResultCachedVideo, InlineQueryResultCachedVoice,
+	// InlineQueryResultArticle, InlineQueryResultAudio, InlineQueryResultContact, InlineQueryResultGame,
+	// InlineQueryResultDocument, InlineQueryResultGif, InlineQueryResultLocation,
+	// InlineQueryResultMpeg4Gif, InlineQueryResultPhoto, InlineQueryResultVenue, InlineQueryResultVideo,
+	// InlineQueryResultVoice
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+	// A valid file identifier for the audio file
+	AudioFileID string `json:"audio_file_id,omitempty"`
+	// Caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the audio caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Content of the message to be sent instead of the audio
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	// Title for the result
+	Title string `json:"title,omitempty"`
+	// A valid file identifier for the file
+	DocumentFileID string `json:"document_file_id,omitempty"`
+	// Short description of the result
+	Description string `json:"description,omitempty"`
+	// A valid file identifier for the GIF file
+	GifFileID string `json:"gif_file_id,omitempty"`
+	// A valid file identifier for the MPEG4 file
+	Mpeg4FileID string `json:"mpeg4_file_id,omitempty"`
+	// A valid file identifier of the photo
+	PhotoFileID string `json:"photo_file_id,omitempty"`
+	// A valid file identifier of the sticker
+	StickerFileID string `json:"sticker_file_id,omitempty"`
+	// A valid file identifier for the video file
+	VideoFileID string `json:"video_file_id,omitempty"`
+	// A valid file identifier for the voice message
+	VoiceFileID string `json:"voice_file_id,omitempty"`
+	// URL of the result
+	URL string `json:"url,omitempty"`
+	// Pass True if you don't want the URL to be shown in the message
+	HideURL bool `json:"hide_url,omitempty"`
+	// Url of the thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// Thumbnail width
+	ThumbnailWidth int64 `json:"thumbnail_width,omitempty"`
+	// Thumbnail height
+	ThumbnailHeight int64 `json:"thumbnail_height,omitempty"`
+	// A valid URL for the audio file
+	AudioURL string `json:"audio_url,omitempty"`
+	// Performer
+	Performer string `json:"performer,omitempty"`
+	// Audio duration in seconds
+	AudioDuration int64 `json:"audio_duration,omitempty"`
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number,omitempty"`
+	// Contact's first name
+	FirstName string `json:"first_name,omitempty"`
+	// Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+	// Short name of the game
+	GameShortName string `json:"game_short_name,omitempty"`
+	// A valid URL for the file
+	DocumentURL string `json:"document_url,omitempty"`
+	// MIME type of the content of the file, either “application/pdf” or “application/zip”
+	MimeType string `json:"mime_type,omitempty"`
+	// A valid URL for the GIF file. File size must not exceed 1MB
+	GifURL string `json:"gif_url,omitempty"`
+	// Width of the GIF
+	GifWidth int64 `json:"gif_width,omitempty"`
+	// Height of the GIF
+	GifHeight int64 `json:"gif_height,omitempty"`
+	// Duration of the GIF in seconds
+	GifDuration int64 `json:"gif_duration,omitempty"`
+	// MIME type of the thumbnail, must be one of “image/jpeg”, “image/gif”, or “video/mp4”.
+	// Defaults to “image/jpeg”
+	ThumbnailMimeType string `json:"thumbnail_mime_type,omitempty"`
+	// Location latitude in degrees
+	Latitude float64 `json:"latitude,omitempty"`
+	// Location longitude in degrees
+	Longitude float64 `json:"longitude,omitempty"`
+	// The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	// Period in seconds for which the location can be updated, should be between 60 and 86400.
+	LivePeriod int64 `json:"live_period,omitempty"`
+	// For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360
+	// if specified.
+	Heading int64 `json:"heading,omitempty"`
+	// For live locations, a maximum distance for proximity alerts about approaching another chat member,
+	// in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int64 `json:"proximity_alert_radius,omitempty"`
+	// A valid URL for the MPEG4 file. File size must not exceed 1MB
+	Mpeg4URL string `json:"mpeg4_url,omitempty"`
+	// Video width
+	Mpeg4Width int64 `json:"mpeg4_width,omitempty"`
+	// Video height
+	Mpeg4Height int64 `json:"mpeg4_height,omitempty"`
+	// Video duration in seconds
+	Mpeg4Duration int64 `json:"mpeg4_duration,omitempty"`
+	// A valid URL of the photo. Photo must be in JPEG format. Photo size must not exceed 5MB
+	PhotoURL string `json:"photo_url,omitempty"`
+	// Width of the photo
+	PhotoWidth int64 `json:"photo_width,omitempty"`
+	// Height of the photo
+	PhotoHeight int64 `json:"photo_height,omitempty"`
+	// Address of the venue
+	Address string `json:"address,omitempty"`
+	// Foursquare identifier of the venue if known
+	FoursquareID string `json:"foursquare_id,omitempty"`
+	// Foursquare type of the venue, if known. (For example, “arts_entertainment/default”,
+	// “arts_entertainment/aquarium” or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+	// Google Places type of the venue. (See supported types.)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+	// A valid URL for the embedded video player or video file
+	VideoURL string `json:"video_url,omitempty"`
+	// Video width
+	VideoWidth int64 `json:"video_width,omitempty"`
+	// Video height
+	VideoHeight int64 `json:"video_height,omitempty"`
+	// Video duration in seconds
+	VideoDuration int64 `json:"video_duration,omitempty"`
+	// A valid URL for the voice recording
+	VoiceURL string `json:"voice_url,omitempty"`
+	// Recording duration in seconds
+	VoiceDuration int64 `json:"voice_duration,omitempty"`
+}
+
+const (
+	// InlineQueryResultCachedAudio is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcachedaudio
+	//
+	// Represents a link to an MP3 audio file stored on the Telegram servers. By default, this audio file
+	// will be sent by the user. Alternatively, you can use input_message_content to send a message with
+	// the specified content instead of the audio.
+	InlineQueryResultCachedAudio = "audio"
+	// InlineQueryResultCachedDocument is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcacheddocument
+	//
+	// Represents a link to a file stored on the Telegram servers. By default, this file will be sent by
+	// the user with an optional caption. Alternatively, you can use input_message_content to send a
+	// message with the specified content instead of the file.
+	InlineQueryResultCachedDocument = "document"
+	// InlineQueryResultCachedGif is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcachedgif
+	//
+	// Represents a link to an animated GIF file stored on the Telegram servers. By default, this animated
+	// GIF file will be sent by the user with an optional caption. Alternatively, you can use
+	// input_message_content to send a message with specified content instead of the animation.
+	InlineQueryResultCachedGif = "gif"
+	// InlineQueryResultCachedPhoto is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcachedphoto
+	//
+	// Represents a link to a photo stored on the Telegram servers. By default, this photo will be sent by
+	// the user with an optional caption. Alternatively, you can use input_message_content to send a
+	// message with the specified content instead of the photo.
+	InlineQueryResultCachedPhoto = "photo"
+	// InlineQueryResultCachedSticker is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcachedsticker
+	//
+	// Represents a link to a sticker stored on the Telegram servers. By default, this sticker will be sent
+	// by the user. Alternatively, you can use input_message_content to send a message with the specified
+	// content instead of the sticker.
+	InlineQueryResultCachedSticker = "sticker"
+	// InlineQueryResultCachedVideo is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcachedvideo
+	//
+	// Represents a link to a video file stored on the Telegram servers. By default, this video file will
+	// be sent by the user with an optional caption. Alternatively, you can use input_message_content to
+	// send a message with the specified content instead of the video.
+	InlineQueryResultCachedVideo = "video"
+	// InlineQueryResultCachedVoice is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcachedvoice
+	//
+	// Represents a link to a voice message stored on the Telegram servers. By default, this voice message
+	// will be sent by the user. Alternatively, you can use input_message_content to send a message with
+	// the specified content instead of the voice message.
+	InlineQueryResultCachedVoice = "voice"
+	// InlineQueryResultArticle is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultarticle
+	//
+	// Represents a link to an article or web page.
+	InlineQueryResultArticle = "article"
+	// InlineQueryResultAudio is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultaudio
+	//
+	// Represents a link to an MP3 audio file. By default, this audio file will be sent by the user.
+	// Alternatively, you can use input_message_content to send a message with the specified content
+	// instead of the audio.
+	InlineQueryResultAudio = "audio"
+	// InlineQueryResultContact is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultcontact
+	//
+	// Represents a contact with a phone number. By default, this contact will be sent by the user.
+	// Alternatively, you can use input_message_content to send a message with the specified content
+	// instead of the contact.
+	InlineQueryResultContact = "contact"
+	// InlineQueryResultGame is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultgame
+	//
+	// Represents a Game.
+	InlineQueryResultGame = "game"
+	// InlineQueryResultDocument is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultdocument
+	//
+	// Represents a link to a file. By default, this file will be sent by the user with an optional
+	// caption. Alternatively, you can use input_message_content to send a message with the specified
+	// content instead of the file. Currently, only .PDF and .ZIP files can be sent using this method.
+	InlineQueryResultDocument = "document"
+	// InlineQueryResultGif is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultgif
+	//
+	// Represents a link to an animated GIF file. By default, this animated GIF file will be sent by the
+	// user with optional caption. Alternatively, you can use input_message_content to send a message with
+	// the specified content instead of the animation.
+	InlineQueryResultGif = "gif"
+	// InlineQueryResultLocation is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultlocation
+	//
+	// Represents a location on a map. By default, the location will be sent by the user. Alternatively,
+	// you can use input_message_content to send a message with the specified content instead of the
+	// location.
+	InlineQueryResultLocation = "location"
+	// InlineQueryResultPhoto is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultphoto
+	//
+	// Represents a link to a photo. By default, this photo will be sent by the user with optional caption.
+	// Alternatively, you can use input_message_content to send a message with the specified content
+	// instead of the photo.
+	InlineQueryResultPhoto = "photo"
+	// InlineQueryResultVenue is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultvenue
+	//
+	// Represents a venue. By default, the venue will be sent by the user. Alternatively, you can use
+	// input_message_content to send a message with the specified content instead of the venue.
+	InlineQueryResultVenue = "venue"
+	// InlineQueryResultVideo is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultvideo
+	//
+	// Represents a link to a page containing an embedded video player or a video file. By default, this
+	// video file will be sent by the user with an optional caption. Alternatively, you can use
+	// input_message_content to send a message with the specified content instead of the video.
+	InlineQueryResultVideo = "video"
+	// InlineQueryResultVoice is the type of InlineQueryResult, see
+	// https://core.telegram.org/bots/api#inlinequeryresultvoice
+	//
+	// Represents a link to a voice recording in an .OGG container encoded with OPUS. By default, this
+	// voice recording will be sent by the user. Alternatively, you can use input_message_content to send a
+	// message with the specified content instead of the the voice message.
+	InlineQueryResultVoice = "voice"
+)
+
+// InlineQueryResultCachedMpeg4Gif is the InlineQueryResultCachedMpeg4Gif object, see
+// https://core.telegram.org/bots/api#inlinequeryresultcachedmpeg4gif
+//
+// Represents a link to a video animation (H.264/MPEG-4 AVC video without sound) stored on the Telegram
+// servers. By default, this animated MPEG-4 file will be sent by the user with an optional caption.
+// Alternatively, you can use input_message_content to send a message with the specified content
+// instead of the animation.
+type InlineQueryResultCachedMpeg4Gif struct {
+	// Type of the result, must be mpeg4_gif
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+	// A valid file identifier for the MPEG4 file
+	Mpeg4FileID string `json:"mpeg4_file_id"`
+	// Title for the result
+	Title string `json:"title,omitempty"`
+	// Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Content of the message to be sent instead of the video animation
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultMpeg4Gif is the InlineQueryResultMpeg4Gif object, see
+// https://core.telegram.org/bots/api#inlinequeryresultmpeg4gif
+//
+// Represents a link to a video animation (H.264/MPEG-4 AVC video without sound). By default, this
+// animated MPEG-4 file will be sent by the user with optional caption. Alternatively, you can use
+// input_message_content to send a message with the specified content instead of the animation.
+type InlineQueryResultMpeg4Gif struct {
+	// Type of the result, must be mpeg4_gif
+	Type string `json:"type"`
+	// Unique identifier for this result, 1-64 bytes
+	ID string `json:"id"`
+	// A valid URL for the MPEG4 file. File size must not exceed 1MB
+	Mpeg4URL string `json:"mpeg4_url"`
+	// Video width
+	Mpeg4Width int64 `json:"mpeg4_width,omitempty"`
+	// Video height
+	Mpeg4Height int64 `json:"mpeg4_height,omitempty"`
+	// Video duration in seconds
+	Mpeg4Duration int64 `json:"mpeg4_duration,omitempty"`
+	// URL of the static (JPEG or GIF) or animated (MPEG4) thumbnail for the result
+	ThumbnailURL string `json:"thumbnail_url"`
+	// MIME type of the thumbnail, must be one of “image/jpeg”, “image/gif”, or “video/mp4”.
+	// Defaults to “image/jpeg”
+	ThumbnailMimeType string `json:"thumbnail_mime_type,omitempty"`
+	// Title for the result
+	Title string `json:"title,omitempty"`
+	// Caption of the MPEG-4 file to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Inline keyboard attached to the message
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+	// Content of the message to be sent instead of the video animation
+	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+}
+
+// InlineQueryResultsButton is the InlineQueryResultsButton object, see
+// https://core.telegram.org/bots/api#inlinequeryresultsbutton
+//
+// This object represents a button to be shown above inline query results. You must use exactly one of
+// the optional fields.
+type InlineQueryResultsButton struct {
+	// Label text on the button
+	Text string `json:"text"`
+	// Description of the Web App that will be launched when the user presses the button. The Web App will
+	// be able to switch back to the inline mode using the method switchInlineQuery inside the Web App.
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+	// Deep-linking parameter for the /start message sent to the bot when a user presses the button. 1-64
+	// characters, only A-Z, a-z, 0-9, _ and - are allowed.
+	StartParameter string `json:"start_parameter,omitempty"`
+}
+
+// InputContactMessageContent is the InputContactMessageContent object, see
+// https://core.telegram.org/bots/api#inputcontactmessagecontent
+//
+// Represents the content of a contact message to be sent as the result of an inline query.
+type InputContactMessageContent struct {
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+	// Contact's first name
+	FirstName string `json:"first_name"`
+	// Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+}
+
+// InputFile is the InputFile object, see https://core.telegram.org/bots/api#inputfile
+//
+// This object represents the contents of a file to be uploaded. Must be posted using
+// multipart/form-data in the usual way that files are uploaded via the browser.
+type InputFile struct {
+}
+
+// InputInvoiceMessageContent is the InputInvoiceMessageContent object, see
+// https://core.telegram.org/bots/api#inputinvoicemessagecontent
+//
+// Represents the content of an invoice message to be sent as the result of an inline query.
+type InputInvoiceMessageContent struct {
+	// Product name, 1-32 characters
+	Title string `json:"title"`
+	// Product description, 1-255 characters
+	Description string `json:"description"`
+	// Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your
+	// internal processes.
+	Payload string `json:"payload"`
+	// Payment provider token, obtained via @BotFather
+	ProviderToken string `json:"provider_token"`
+	// Three-letter ISO 4217 currency code, see more on currencies
+	Currency string `json:"currency"`
+	// Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery
+	// cost, delivery tax, bonus, etc.)
+	Prices []LabeledPrice `json:"prices"`
+	// The maximum accepted amount for tips in the smallest units of the currency (integer, not
+	// float/double). For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145. See the exp
+	// parameter in currencies.json, it shows the number of digits past the decimal point for each currency
+	// (2 for the majority of currencies). Defaults to 0
+	MaxTipAmount int64 `json:"max_tip_amount,omitempty"`
+	// A JSON-serialized array of suggested amounts of tip in the smallest units of the currency (integer,
+	// not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must
+	// be positive, passed in a strictly increased order and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+	// A JSON-serialized object for data about the invoice, which will be shared with the payment provider.
+	// A detailed description of the required fields should be provided by the payment provider.
+	ProviderData string `json:"provider_data,omitempty"`
+	// URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a
+	// service.
+	PhotoURL string `json:"photo_url,omitempty"`
+	// Photo size in bytes
+	PhotoSize int64 `json:"photo_size,omitempty"`
+	// Photo width
+	PhotoWidth int64 `json:"photo_width,omitempty"`
+	// Photo height
+	PhotoHeight int64 `json:"photo_height,omitempty"`
+	// Pass True if you require the user's full name to complete the order
+	NeedName bool `json:"need_name,omitempty"`
+	// Pass True if you require the user's phone number to complete the order
+	NeedPhoneNumber bool `json:"need_phone_number,omitempty"`
+	// Pass True if you require the user's email address to complete the order
+	NeedEmail bool `json:"need_email,omitempty"`
+	// Pass True if you require the user's shipping address to complete the order
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+	// Pass True if the user's phone number should be sent to provider
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider,omitempty"`
+	// Pass True if the user's email address should be sent to provider
+	SendEmailToProvider bool `json:"send_email_to_provider,omitempty"`
+	// Pass True if the final price depends on the shipping method
+	IsFlexible bool `json:"is_flexible,omitempty"`
+}
+
+// InputLocationMessageContent is the InputLocationMessageContent object, see
+// https://core.telegram.org/bots/api#inputlocationmessagecontent
+//
+// Represents the content of a location message to be sent as the result of an inline query.
+type InputLocationMessageContent struct {
+	// Latitude of the location in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude of the location in degrees
+	Longitude float64 `json:"longitude"`
+	// The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	// Period in seconds for which the location can be updated, should be between 60 and 86400.
+	LivePeriod int64 `json:"live_period,omitempty"`
+	// For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360
+	// if specified.
+	Heading int64 `json:"heading,omitempty"`
+	// For live locations, a maximum distance for proximity alerts about approaching another chat member,
+	// in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int64 `json:"proximity_alert_radius,omitempty"`
+}
+
+// InputMedia is the InputMedia object, see https://core.telegram.org/bots/api#inputmedia
+//
+// This object represents the content of a media message to be sent. It should be one of
+//
+// The fields of InputMediaAnimation, InputMediaDocument, InputMediaAudio, InputMediaPhoto,
+// InputMediaVideo are merged.
+type InputMedia struct {
+	// Type of the result, one of InputMediaAnimation, InputMediaDocument, InputMediaAudio,
+	// InputMediaPhoto, InputMediaVideo
+	Type string `json:"type"`
+	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass
+	// an HTTP URL for Telegram to get a file from the Internet, or pass “attach://<file_attach_name>”
+	// to upload a new one using multipart/form-data under <file_attach_name> name.
+	Media string `json:"media"`
+	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
+	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's
+	// width and height should not exceed 320. Ignored if the file is not uploaded using
+	// multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can
+	// pass “attach://<file_attach_name>” if the thumbnail was uploaded using multipart/form-data under
+	// <file_attach_name>.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// Caption of the animation to be sent, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the animation caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// List of special entities that appear in the caption, which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Animation width
+	Width int64 `json:"width,omitempty"`
+	// Animation height
+	Height int64 `json:"height,omitempty"`
+	// Animation duration in seconds
+	Duration int64 `json:"duration,omitempty"`
+	// Pass True if the animation needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+	// Disables automatic server-side content type detection for files uploaded using multipart/form-data.
+	// Always True, if the document is sent as part of an album.
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+	// Performer of the audio
+	Performer string `json:"performer,omitempty"`
+	// Title of the audio
+	Title string `json:"title,omitempty"`
+	// Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+}
+
+const (
+	// InputMediaAnimation is the type of InputMedia, see
+	// https://core.telegram.org/bots/api#inputmediaanimation
+	//
+	// Represents an animation file (GIF or H.264/MPEG-4 AVC video without sound) to be sent.
+	InputMediaAnimation = "animation"
+	// InputMediaDocument is the type of InputMedia, see
+	// https://core.telegram.org/bots/api#inputmediadocument
+	//
+	// Represents a general file to be sent.
+	InputMediaDocument = "document"
+	// InputMediaAudio is the type of InputMedia, see https://core.telegram.org/bots/api#inputmediaaudio
+	//
+	// Represents an audio file to be treated as music to be sent.
+	InputMediaAudio = "audio"
+	// InputMediaPhoto is the type of InputMedia, see https://core.telegram.org/bots/api#inputmediaphoto
+	//
+	// Represents a photo to be sent.
+	InputMediaPhoto = "photo"
+	// InputMediaVideo is the type of InputMedia, see https://core.telegram.org/bots/api#inputmediavideo
+	//
+	// Represents a video to be sent.
+	InputMediaVideo = "video"
+)
+
+// InputMessageContent is the InputMessageContent object, see
+// https://core.telegram.org/bots/api#inputmessagecontent
+//
+// This object represents the content of a message to be sent as a result of an inline query. Telegram
+// clients currently support the following 5 types:
+//
+// The fields of InputTextMessageContent, InputLocationMessageContent, InputVenueMessageContent,
+// InputContactMessageContent, InputInvoiceMessageContent are merged.
+type InputMessageContent struct {
+	// Text of the message to be sent, 1-4096 characters
+	MessageText string `json:"message_text,omitempty"`
+	// Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// List of special entities that appear in message text, which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Latitude of the location in degrees
+	Latitude float64 `json:"latitude,omitempty"`
+	// Longitude of the location in degrees
+	Longitude float64 `json:"longitude,omitempty"`
+	// The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	// Period in seconds for which the location can be updated, should be between 60 and 86400.
+	LivePeriod int64 `json:"live_period,omitempty"`
+	// For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360
+	// if specified.
+	Heading int64 `json:"heading,omitempty"`
+	// For live locations, a maximum distance for proximity alerts about approaching another chat member,
+	// in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int64 `json:"proximity_alert_radius,omitempty"`
+	// Name of the venue
+	Title string `json:"title,omitempty"`
+	// Address of the venue
+	Address string `json:"address,omitempty"`
+	// Foursquare identifier of the venue, if known
+	FoursquareID string `json:"foursquare_id,omitempty"`
+	// Foursquare type of the venue, if known. (For example, “arts_entertainment/default”,
+	// “arts_entertainment/aquarium” or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+	// Google Places type of the venue. (See supported types.)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number,omitempty"`
+	// Contact's first name
+	FirstName string `json:"first_name,omitempty"`
+	// Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+	// Product description, 1-255 characters
+	Description string `json:"description,omitempty"`
+	// Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your
+	// internal processes.
+	Payload string `json:"payload,omitempty"`
+	// Payment provider token, obtained via @BotFather
+	ProviderToken string `json:"provider_token,omitempty"`
+	// Three-letter ISO 4217 currency code, see more on currencies
+	Currency string `json:"currency,omitempty"`
+	// Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery
+	// cost, delivery tax, bonus, etc.)
+	Prices []LabeledPrice `json:"prices,omitempty"`
+	// The maximum accepted amount for tips in the smallest units of the currency (integer, not
+	// float/double). For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145. See the exp
+	// parameter in currencies.json, it shows the number of digits past the decimal point for each currency
+	// (2 for the majority of currencies). Defaults to 0
+	MaxTipAmount int64 `json:"max_tip_amount,omitempty"`
+	// A JSON-serialized array of suggested amounts of tip in the smallest units of the currency (integer,
+	// not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must
+	// be positive, passed in a strictly increased order and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+	// A JSON-serialized object for data about the invoice, which will be shared with the payment provider.
+	// A detailed description of the required fields should be provided by the payment provider.
+	ProviderData string `json:"provider_data,omitempty"`
+	// URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a
+	// service.
+	PhotoURL string `json:"photo_url,omitempty"`
+	// Photo size in bytes
+	PhotoSize int64 `json:"photo_size,omitempty"`
+	// Photo width
+	PhotoWidth int64 `json:"photo_width,omitempty"`
+	// Photo height
+	PhotoHeight int64 `json:"photo_height,omitempty"`
+	// Pass True if you require the user's full name to complete the order
+	NeedName bool `json:"need_name,omitempty"`
+	// Pass True if you require the user's phone number to complete the order
+	NeedPhoneNumber bool `json:"need_phone_number,omitempty"`
+	// Pass True if you require the user's email address to complete the order
+	NeedEmail bool `json:"need_email,omitempty"`
+	// Pass True if you require the user's shipping address to complete the order
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+	// Pass True if the user's phone number should be sent to provider
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider,omitempty"`
+	// Pass True if the user's email address should be sent to provider
+	SendEmailToProvider bool `json:"send_email_to_provider,omitempty"`
+	// Pass True if the final price depends on the shipping method
+	IsFlexible bool `json:"is_flexible,omitempty"`
+}
+
+// InputSticker is the InputSticker object, see https://core.telegram.org/bots/api#inputsticker
+//
+// This object describes a sticker to be added to a sticker set.
+type InputSticker struct {
+	// The added sticker. Pass a file_id as a String to send a file that already exists on the Telegram
+	// servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, upload a new one
+	// using multipart/form-data, or pass “attach://<file_attach_name>” to upload a new one using
+	// multipart/form-data under <file_attach_name> name. Animated and video stickers can't be uploaded via
+	// HTTP URL.
+	Sticker string `json:"sticker"`
+	// Format of the added sticker, must be one of “static” for a .WEBP or .PNG image, “animated”
+	// for a .TGS animation, “video” for a WEBM video
+	Format string `json:"format"`
+	// List of 1-20 emoji associated with the sticker
+	EmojiList []string `json:"emoji_list"`
+	// Position where the mask should be placed on faces. For “mask” stickers only.
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+	// List of 0-20 search keywords for the sticker with total length of up to 64 characters. For
+	// “regular” and “custom_emoji” stickers only.
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// InputTextMessageContent is the InputTextMessageContent object, see
+// https://core.telegram.org/bots/api#inputtextmessagecontent
+//
+// Represents the content of a text message to be sent as the result of an inline query.
+type InputTextMessageContent struct {
+	// Text of the message to be sent, 1-4096 characters
+	MessageText string `json:"message_text"`
+	// Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// List of special entities that appear in message text, which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+}
+
+// InputVenueMessageContent is the InputVenueMessageContent object, see
+// https://core.telegram.org/bots/api#inputvenuemessagecontent
+//
+// Represents the content of a venue message to be sent as the result of an inline query.
+type InputVenueMessageContent struct {
+	// Latitude of the venue in degrees
+	Latitude float64 `json:"latitude"`
+	// Longitude of the venue in degrees
+	Longitude float64 `json:"longitude"`
+	// Name of the venue
+	Title string `json:"title"`
+	// Address of the venue
+	Address string `json:"address"`
+	// Foursquare identifier of the venue, if known
+	FoursquareID string `json:"foursquare_id,omitempty"`
+	// Foursquare type of the venue, if known. (For example, “arts_entertainment/default”,
+	// “arts_entertainment/aquarium” or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+	// Google Places type of the venue. (See supported types.)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+}
+
+// Invoice is the Invoice object, see https://core.telegram.org/bots/api#invoice
+//
+// This object contains basic information about an invoice.
+type Invoice struct {
+	// Product name
+	Title string `json:"title"`
+	// Product description
+	Description string `json:"description"`
+	// Unique bot deep-linking parameter that can be used to generate this invoice
+	StartParameter string `json:"start_parameter"`
+	// Three-letter ISO 4217 currency code
+	Currency string `json:"currency"`
+	// Total price in the smallest units of the currency (integer, not float/double). For example, for a
+	// price of US$ 1.45 pass amount = 145. See the exp parameter in currencies.json, it shows the number
+	// of digits past the decimal point for each currency (2 for the majority of currencies).
+	TotalAmount int64 `json:"total_amount"`
+}
+
+// KeyboardButton is the KeyboardButton object, see https://core.telegram.org/bots/api#keyboardbutton
+//
+// This object represents one button of the reply keyboard. For simple text buttons, String can be used
+// instead of this object to specify the button text. The optional fields web_app, request_users,
+// request_chat, request_contact, request_location, and request_poll are mutually exclusive.
+type KeyboardButton struct {
+	// Text of the button. If none of the optional fields are used, it will be sent as a message when the
+	// button is pressed
+	Text string `json:"text"`
+	// If specified, pressing the button will open a list of suitable users. Identifiers of selected users
+	// will be sent to the bot in a “users_shared” service message. Available in private chats only.
+	RequestUsers *KeyboardButtonRequestUsers `json:"request_users,omitempty"`
+	// If specified, pressing the button will open a list of suitable chats. Tapping on a chat will send
+	// its identifier to the bot in a “chat_shared” service message. Available in private chats only.
+	RequestChat *KeyboardButtonRequestChat `json:"request_chat,omitempty"`
+	// If True, the user's phone number will be sent as a contact when the button is pressed. Available in
+	// private chats only.
+	RequestContact bool `json:"request_contact,omitempty"`
+	// If True, the user's current location will be sent when the button is pressed. Available in private
+	// chats only.
+	RequestLocation bool `json:"request_location,omitempty"`
+	// If specified, the user will be asked to create a poll and send it to the bot when the button is
+	// pressed. Available in private chats only.
+	RequestPoll *KeyboardButtonPollType `json:"request_poll,omitempty"`
+	// If specified, the described Web App will be launched when the button is pressed. The Web App will be
+	// able to send a “web_app_data” service message. Available in private chats only.
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+}
+
+// KeyboardButtonPollType is the KeyboardButtonPollType object, see
+// https://core.telegram.org/bots/api#keyboardbuttonpolltype
+//
+// This object represents type of a poll, which is allowed to be created and sent when the
+// corresponding button is pressed.
+type KeyboardButtonPollType struct {
+	// If quiz is passed, the user will be allowed to create only polls in the quiz mode. If regular is
+	// passed, only regular polls will be allowed. Otherwise, the user will be allowed to create a poll of
+	// any type.
+	Type string `json:"type,omitempty"`
+}
+
+// KeyboardButtonRequestChat is the KeyboardButtonRequestChat object, see
+// https://core.telegram.org/bots/api#keyboardbuttonrequestchat
+//
+// This object defines the criteria used to request a suitable chat. Information about the selected
+// chat will be shared with the bot when the corresponding button is pressed. The bot will be granted
+// requested rights in the chat if appropriate.
+type KeyboardButtonRequestChat struct {
+	// Signed 32-bit identifier of the request, which will be received back in the ChatShared object. Must
+	// be unique within the message
+	RequestID int64 `json:"request_id"`
+	// Pass True to request a channel chat, pass False to request a group or a supergroup chat.
+	ChatIsChannel bool `json:"chat_is_channel"`
+	// Pass True to request a forum supergroup, pass False to request a non-forum chat. If not specified,
+	// no additional restrictions are applied.
+	ChatIsForum bool `json:"chat_is_forum,omitempty"`
+	// Pass True to request a supergroup or a channel with a username, pass False to request a chat without
+	// a username. If not specified, no additional restrictions are applied.
+	ChatHasUsername bool `json:"chat_has_username,omitempty"`
+	// Pass True to request a chat owned by the user. Otherwise, no additional restrictions are applied.
+	ChatIsCreated bool `json:"chat_is_created,omitempty"`
+	// A JSON-serialized object listing the required administrator rights of the user in the chat. The
+	// rights must be a superset of bot_administrator_rights. If not specified, no additional restrictions
+	// are applied.
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	// A JSON-serialized object listing the required administrator rights of the bot in the chat. The
+	// rights must be a subset of user_administrator_rights. If not specified, no additional restrictions
+	// are applied.
+	BotAdministratorRights *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+	// Pass True to request a chat with the bot as a member. Otherwise, no additional restrictions are
+	// applied.
+	BotIsMember bool `json:"bot_is_member,omitempty"`
+	// Pass True to request the chat's title
+	RequestTitle bool `json:"request_title,omitempty"`
+	// Pass True to request the chat's username
+	RequestUsername bool `json:"request_username,omitempty"`
+	// Pass True to request the chat's photo
+	RequestPhoto bool `json:"request_photo,omitempty"`
+}
+
+// KeyboardButtonRequestUsers is the KeyboardButtonRequestUsers object, see
+// https://core.telegram.org/bots/api#keyboardbuttonrequestusers
+//
+// This object defines the criteria used to request suitable users. Information about the selected
+// users will be shared with the bot when the corresponding button is pressed.
+type KeyboardButtonRequestUsers struct {
+	// Signed 32-bit identifier of the request that will be received back in the UsersShared object. Must
+	// be unique within the message
+	RequestID int64 `json:"request_id"`
+	// Pass True to request bots, pass False to request regular users. If not specified, no additional
+	// restrictions are applied.
+	UserIsBot bool `json:"user_is_bot,omitempty"`
+	// Pass True to request premium users, pass False to request non-premium users. If not specified, no
+	// additional restrictions are applied.
+	UserIsPremium bool `json:"user_is_premium,omitempty"`
+	// The maximum number of users to be selected; 1-10. Defaults to 1.
+	MaxQuantity int64 `json:"max_quantity,omitempty"`
+	// Pass True to request the users' first and last names
+	RequestName bool `json:"request_name,omitempty"`
+	// Pass True to request the users' usernames
+	RequestUsername bool `json:"request_username,omitempty"`
+	// Pass True to request the users' photos
+	RequestPhoto bool `json:"request_photo,omitempty"`
+}
+
+// LabeledPrice is the LabeledPrice object, see https://core.telegram.org/bots/api#labeledprice
+//
+// This object represents a portion of the price for goods or services.
+type LabeledPrice struct {
+	// Portion label
+	Label string `json:"label"`
+	// Price of the product in the smallest units of the currency (integer, not float/double). For example,
+	// for a price of US$ 1.45 pass amount = 145. See the exp parameter in currencies.json, it shows the
+	// number of digits past the decimal point for each currency (2 for the majority of currencies).
+	Amount int64 `json:"amount"`
+}
+
+// LinkPreviewOptions is the LinkPreviewOptions object, see
+// https://core.telegram.org/bots/api#linkpreviewoptions
+//
+// Describes the options used for link preview generation.
+type LinkPreviewOptions struct {
+	// True, if the link preview is disabled
+	IsDisabled bool `json:"is_disabled,omitempty"`
+	// URL to use for the link preview. If empty, then the first URL found in the message text will be used
+	URL string `json:"url,omitempty"`
+	// True, if the media in the link preview is supposed to be shrunk; ignored if the URL isn't explicitly
+	// specified or media size change isn't supported for the preview
+	PreferSmallMedia bool `json:"prefer_small_media,omitempty"`
+	// True, if the media in the link preview is supposed to be enlarged; ignored if the URL isn't
+	// explicitly specified or media size change isn't supported for the preview
+	PreferLargeMedia bool `json:"prefer_large_media,omitempty"`
+	// True, if the link preview must be shown above the message text; otherwise, the link preview will be
+	// shown below the message text
+	ShowAboveText bool `json:"show_above_text,omitempty"`
+}
+
+// Location is the Location object, see https://core.telegram.org/bots/api#location
+//
+// This object represents a point on the map.
+type Location struct {
+	// Latitude as defined by sender
+	Latitude float64 `json:"latitude"`
+	// Longitude as defined by sender
+	Longitude float64 `json:"longitude"`
+	// The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	// Time relative to the message sending date, during which the location can be updated; in seconds. For
+	// active live locations only.
+	LivePeriod int64 `json:"live_period,omitempty"`
+	// The direction in which user is moving, in degrees; 1-360. For active live locations only.
+	Heading int64 `json:"heading,omitempty"`
+	// The maximum distance for proximity alerts about approaching another chat member, in meters. For sent
+	// live locations only.
+	ProximityAlertRadius int64 `json:"proximity_alert_radius,omitempty"`
+}
+
+// LoginUrl is the LoginUrl object, see https://core.telegram.org/bots/api#loginurl
+//
+// This object represents a parameter of the inline keyboard button used to automatically authorize a
+// user. Serves as a great replacement for the Telegram Login Widget when the user is coming from
+// Telegram. All the user needs to do is tap/click a button and confirm that they want to log in.
+type LoginUrl struct {
+	// An HTTPS URL to be opened with user authorization data added to the query string when the button is
+	// pressed. If the user refuses to provide authorization data, the original URL without information
+	// about the user will be opened. The data added is the same as described in Receiving authorization
+	// data.
+	URL string `json:"url"`
+	// New text of the button in forwarded messages.
+	ForwardText string `json:"forward_text,omitempty"`
+	// Username of a bot, which will be used for user authorization. See Setting up a bot for more details.
+	// If not specified, the current bot's username will be assumed. The url's domain must be the same as
+	// the domain linked with the bot.
+	BotUsername string `json:"bot_username,omitempty"`
+	// Pass True to request the permission for your bot to send messages to the user.
+	RequestWriteAccess bool `json:"request_write_access,omitempty"`
+}
+
+// MaskPosition is the MaskPosition object, see https://core.telegram.org/bots/api#maskposition
+//
+// This object describes the position on faces where a mask should be placed by default.
+type MaskPosition struct {
+	// The part of the face relative to which the mask should be placed. One of “forehead”, “eyes”,
+	// “mouth”, or “chin”.
+	Point string `json:"point"`
+	// Shift by X-axis measured in widths of the mask scaled to the face size, from left to right. For
+	// example, choosing -1.0 will place mask just to the left of the default mask position.
+	XShift float64 `json:"x_shift"`
+	// Shift by Y-axis measured in heights of the mask scaled to the face size, from top to bottom. For
+	// example, 1.0 will place the mask just below the default mask position.
+	YShift float64 `json:"y_shift"`
+	// Mask scaling coefficient. For example, 2.0 means double size.
+	Scale float64 `json:"scale"`
+}
+
+// MaybeInaccessibleMessage is the MaybeInaccessibleMessage object, see
+// https://core.telegram.org/bots/api#maybeinaccessiblemessage
+//
+// This object describes a message that can be inaccessible to the bot. It can be one of
+//
+// The fields of Message, InaccessibleMessage are merged.
+type MaybeInaccessibleMessage struct {
+	// Unique message identifier inside this chat
+	MessageID int64 `json:"message_id"`
+	// Unique identifier of a message thread to which the message belongs; for supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Sender of the message; empty for messages sent to channels. For backward compatibility, the field
+	// contains a fake sender user in non-channel chats, if the message was sent on behalf of a chat.
+	From *User `json:"from,omitempty"`
+	// Sender of the message, sent on behalf of a chat. For example, the channel itself for channel posts,
+	// the supergroup itself for messages from anonymous group administrators, the linked channel for
+	// messages automatically forwarded to the discussion group.
+	SenderChat *Chat `json:"sender_chat,omitempty"`
+	// If the sender of the message boosted the chat, the number of boosts added by the user
+	SenderBoostCount int64 `json:"sender_boost_count,omitempty"`
+	// The bot that actually sent the message on behalf of the business account. Available only for
+	// outgoing messages sent on behalf of the connected business account.
+	SenderBusinessBot *User `json:"sender_business_bot,omitempty"`
+	// Date the message was sent in Unix time. It is always a positive number, representing a valid date.
+	Date int64 `json:"date"`
+	// Unique identifier of the business connection from which the message was received. If non-empty, the
+	// message belongs to a chat of the corresponding business account that is independent from any
+	// potential bot chat which might share the same identifier.
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Chat the message belongs to
+	Chat Chat `json:"chat"`
+	// Information about the original message for forwarded messages
+	ForwardOrigin *MessageOrigin `json:"forward_origin,omitempty"`
+	// True, if the message is sent to a forum topic
+	IsTopicMessage bool `json:"is_topic_message,omitempty"`
+	// True, if the message is a channel post that was automatically forwarded to the connected discussion
+	// group
+	IsAutomaticForward bool `json:"is_automatic_forward,omitempty"`
+	// For replies in the same chat and message thread, the original message. Note that the Message object
+	// in this field will not contain further reply_to_message fields even if it itself is a reply.
+	ReplyToMessage *Message `json:"reply_to_message,omitempty"`
+	// Information about the message that is being replied to, which may come from another chat or forum
+	// topic
+	ExternalReply *ExternalReplyInfo `json:"external_reply,omitempty"`
+	// For replies that quote part of the original message, the quoted part of the message
+	Quote *TextQuote `json:"quote,omitempty"`
+	// For replies to a story, the original story
+	ReplyToStory *Story `json:"reply_to_story,omitempty"`
+	// Bot through which the message was sent
+	ViaBot *User `json:"via_bot,omitempty"`
+	// Date the message was last edited in Unix time
+	EditDate int64 `json:"edit_date,omitempty"`
+	// True, if the message can't be forwarded
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+	// True, if the message was sent by an implicit action, for example, as an away or a greeting business
+	// message, or as a scheduled message
+	IsFromOffline bool `json:"is_from_offline,omitempty"`
+	// The unique identifier of a media message group this message belongs to
+	MediaGroupID string `json:"media_group_id,omitempty"`
+	// Signature of the post author for messages in channels, or the custom title of an anonymous group
+	// administrator
+	AuthorSignature string `json:"author_signature,omitempty"`
+	// For text messages, the actual UTF-8 text of the message
+	Text string `json:"text,omitempty"`
+	// For text messages, special entities like usernames, URLs, bot commands, etc. that appear in the text
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Options used for link preview generation for the message, if it is a text message and link preview
+	// options were changed
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Message is an animation, information about the animation. For backward compatibility, when this
+	// field is set, the document field will also be set
+	Animation *Animation `json:"animation,omitempty"`
+	// Message is an audio file, information about the file
+	Audio *Audio `json:"audio,omitempty"`
+	// Message is a general file, information about the file
+	Document *Document `json:"document,omitempty"`
+	// Message is a photo, available sizes of the photo
+	Photo []PhotoSize `json:"photo,omitempty"`
+	// Message is a sticker, information about the sticker
+	Sticker *Sticker `json:"sticker,omitempty"`
+	// Message is a forwarded story
+	Story *Story `json:"story,omitempty"`
+	// Message is a video, information about the video
+	Video *Video `json:"video,omitempty"`
+	// Message is a video note, information about the video message
+	VideoNote *VideoNote `json:"video_note,omitempty"`
+	// Message is a voice message, information about the file
+	Voice *Voice `json:"voice,omitempty"`
+	// Caption for the animation, audio, document, photo, video or voice
+	Caption string `json:"caption,omitempty"`
+	// For messages with a caption, special entities like usernames, URLs, bot commands, etc. that appear
+	// in the caption
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// True, if the message media is covered by a spoiler animation
+	HasMediaSpoiler bool `json:"has_media_spoiler,omitempty"`
+	// Message is a shared contact, information about the contact
+	Contact *Contact `json:"contact,omitempty"`
+	// Message is a dice with random value
+	Dice *Dice `json:"dice,omitempty"`
+	// Message is a game, information about the game.
+	Game *Game `json:"game,omitempty"`
+	// Message is a native poll, information about the poll
+	Poll *Poll `json:"poll,omitempty"`
+	// Message is a venue, information about the venue. For backward compatibility, when this field is set,
+	// the location field will also be set
+	Venue *Venue `json:"venue,omitempty"`
+	// Message is a shared location, information about the location
+	Location *Location `json:"location,omitempty"`
+	// New members that were added to the group or supergroup and information about them (the bot itself
+	// may be one of these members)
+	NewChatMembers []User `json:"new_chat_members,omitempty"`
+	// A member was removed from the group, information about them (this member may be the bot itself)
+	LeftChatMember *User `json:"left_chat_member,omitempty"`
+	// A chat title was changed to this value
+	NewChatTitle string `json:"new_chat_title,omitempty"`
+	// A chat photo was change to this value
+	NewChatPhoto []PhotoSize `json:"new_chat_photo,omitempty"`
+	// Service message: the chat photo was deleted
+	DeleteChatPhoto bool `json:"delete_chat_photo,omitempty"`
+	// Service message: the group has been created
+	GroupChatCreated bool `json:"group_chat_created,omitempty"`
+	// Service message: the supergroup has been created. This field can't be received in a message coming
+	// through updates, because bot can't be a member of a supergroup when it is created. It can only be
+	// found in reply_to_message if someone replies to a very first message in a directly created
+	// supergroup.
+	SupergroupChatCreated bool `json:"supergroup_chat_created,omitempty"`
+	// Service message: the channel has been created. This field can't be received in a message coming
+	// through updates, because bot can't be a member of a channel when it is created. It can only be found
+	// in reply_to_message if someone replies to a very first message in a channel.
+	ChannelChatCreated bool `json:"channel_chat_created,omitempty"`
+	// Service message: auto-delete timer settings changed in the chat
+	MessageAutoDeleteTimerChanged *MessageAutoDeleteTimerChanged `json:"message_auto_delete_timer_changed,omitempty"`
+	// The group has been migrated to a supergroup with the specified identifier.
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+	// The supergroup has been migrated from a group with the specified identifier.
+	MigrateFromChatID int64 `json:"migrate_from_chat_id,omitempty"`
+	// Specified message was pinned. Note that the Message object in this field will not contain further
+	// reply_to_message fields even if it itself is a reply.
+	PinnedMessage *MaybeInaccessibleMessage `json:"pinned_message,omitempty"`
+	// Message is an invoice for a payment, information about the invoice.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Message is a service message about a successful payment, information about the payment.
+	SuccessfulPayment *SuccessfulPayment `json:"successful_payment,omitempty"`
+	// Service message: users were shared with the bot
+	UsersShared *UsersShared `json:"users_shared,omitempty"`
+	// Service message: a chat was shared with the bot
+	ChatShared *ChatShared `json:"chat_shared,omitempty"`
+	// The domain name of the website on which the user has logged in.
+	ConnectedWebsite string `json:"connected_website,omitempty"`
+	// Service message: the user allowed the bot to write messages after adding it to the attachment or
+	// side menu, launching a Web App from a link, or accepting an explicit request from a Web App sent by
+	// the method requestWriteAccess
+	WriteAccessAllowed *WriteAccessAllowed `json:"write_access_allowed,omitempty"`
+	// Telegram Passport data
+	PassportData *PassportData `json:"passport_data,omitempty"`
+	// Service message. A user in the chat triggered another user's proximity alert while sharing Live
+	// Location.
+	ProximityAlertTriggered *ProximityAlertTriggered `json:"proximity_alert_triggered,omitempty"`
+	// Service message: user boosted the chat
+	BoostAdded *ChatBoostAdded `json:"boost_added,omitempty"`
+	// Service message: forum topic created
+	ForumTopicCreated *ForumTopicCreated `json:"forum_topic_created,omitempty"`
+	// Service message: forum topic edited
+	ForumTopicEdited *ForumTopicEdited `json:"forum_topic_edited,omitempty"`
+	// Service message: forum topic closed
+	ForumTopicClosed *ForumTopicClosed `json:"forum_topic_closed,omitempty"`
+	// Service message: forum topic reopened
+	ForumTopicReopened *ForumTopicReopened `json:"forum_topic_reopened,omitempty"`
+	// Service message: the 'General' forum topic hidden
+	GeneralForumTopicHidden *GeneralForumTopicHidden `json:"general_forum_topic_hidden,omitempty"`
+	// Service message: the 'General' forum topic unhidden
+	GeneralForumTopicUnhidden *GeneralForumTopicUnhidden `json:"general_forum_topic_unhidden,omitempty"`
+	// Service message: a scheduled giveaway was created
+	GiveawayCreated *GiveawayCreated `json:"giveaway_created,omitempty"`
+	// The message is a scheduled giveaway message
+	Giveaway *Giveaway `json:"giveaway,omitempty"`
+	// A giveaway with public winners was completed
+	GiveawayWinners *GiveawayWinners `json:"giveaway_winners,omitempty"`
+	// Service message: a giveaway without public winners was completed
+	GiveawayCompleted *GiveawayCompleted `json:"giveaway_completed,omitempty"`
+	// Service message: video chat scheduled
+	VideoChatScheduled *VideoChatScheduled `json:"video_chat_scheduled,omitempty"`
+	// Service message: video chat started
+	VideoChatStarted *VideoChatStarted `json:"video_chat_started,omitempty"`
+	// Service message: video chat ended
+	VideoChatEnded *VideoChatEnded `json:"video_chat_ended,omitempty"`
+	// Service message: new participants invited to a video chat
+	VideoChatParticipantsInvited *VideoChatParticipantsInvited `json:"video_chat_participants_invited,omitempty"`
+	// Service message: data sent by a Web App
+	WebAppData *WebAppData `json:"web_app_data,omitempty"`
+	// Inline keyboard attached to the message. login_url buttons are represented as ordinary url buttons.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// MenuButton is the MenuButton object, see https://core.telegram.org/bots/api#menubutton
+//
+// This object describes the bot's menu button in a private chat. It should be one of
+//
+// If a menu button other than MenuButtonDefault is set for a private chat, then it is applied in the
+// chat. Otherwise the default menu button is applied. By default, the menu button opens the list of
+// bot commands.
+//
+// The fields of MenuButtonCommands, MenuButtonWebApp, MenuButtonDefault are merged.
+type MenuButton struct {
+	// Type of the button, one of MenuButtonCommands, MenuButtonWebApp, MenuButtonDefault
+	Type string `json:"type"`
+	// Text on the button
+	Text string `json:"text,omitempty"`
+	// Description of the Web App that will be launched when the user presses the button. The Web App will
+	// be able to send an arbitrary message on behalf of the user using the method answerWebAppQuery.
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
+}
+
+const (
+	// MenuButtonCommands is the type of MenuButton, see
+	// https://core.telegram.org/bots/api#menubuttoncommands
+	//
+	// Represents a menu button, which opens the bot's list of commands.
+	MenuButtonCommands = "commands"
+	// MenuButtonWebApp is the type of MenuButton, see https://core.telegram.org/bots/api#menubuttonwebapp
+	//
+	// Represents a menu button, which launches a Web App.
+	MenuButtonWebApp = "web_app"
+	// MenuButtonDefault is the type of MenuButton, see
+	// https://core.telegram.org/bots/api#menubuttondefault
+	//
+	// Describes that no specific value for the menu button was set.
+	MenuButtonDefault = "default"
+)
+
+// Message is the Message object, see https://core.telegram.org/bots/api#message
+//
+// This object represents a message.
+type Message struct {
+	// Unique message identifier inside this chat
+	MessageID int64 `json:"message_id"`
+	// Unique identifier of a message thread to which the message belongs; for supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Sender of the message; empty for messages sent to channels. For backward compatibility, the field
+	// contains a fake sender user in non-channel chats, if the message was sent on behalf of a chat.
+	From *User `json:"from,omitempty"`
+	// Sender of the message, sent on behalf of a chat. For example, the channel itself for channel posts,
+	// the supergroup itself for messages from anonymous group administrators, the linked channel for
+	// messages automatically forwarded to the discussion group.
+	SenderChat *Chat `json:"sender_chat,omitempty"`
+	// If the sender of the message boosted the chat, the number of boosts added by the user
+	SenderBoostCount int64 `json:"sender_boost_count,omitempty"`
+	// The bot that actually sent the message on behalf of the business account. Available only for
+	// outgoing messages sent on behalf of the connected business account.
+	SenderBusinessBot *User `json:"sender_business_bot,omitempty"`
+	// Date the message was sent in Unix time. It is always a positive number, representing a valid date.
+	Date int64 `json:"date"`
+	// Unique identifier of the business connection from which the message was received. If non-empty, the
+	// message belongs to a chat of the corresponding business account that is independent from any
+	// potential bot chat which might share the same identifier.
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Chat the message belongs to
+	Chat Chat `json:"chat"`
+	// Information about the original message for forwarded messages
+	ForwardOrigin *MessageOrigin `json:"forward_origin,omitempty"`
+	// True, if the message is sent to a forum topic
+	IsTopicMessage bool `json:"is_topic_message,omitempty"`
+	// True, if the message is a channel post that was automatically forwarded to the connected discussion
+	// group
+	IsAutomaticForward bool `json:"is_automatic_forward,omitempty"`
+	// For replies in the same chat and message thread, the original message. Note that the Message object
+	// in this field will not contain further reply_to_message fields even if it itself is a reply.
+	ReplyToMessage *Message `json:"reply_to_message,omitempty"`
+	// Information about the message that is being replied to, which may come from another chat or forum
+	// topic
+	ExternalReply *ExternalReplyInfo `json:"external_reply,omitempty"`
+	// For replies that quote part of the original message, the quoted part of the message
+	Quote *TextQuote `json:"quote,omitempty"`
+	// For replies to a story, the original story
+	ReplyToStory *Story `json:"reply_to_story,omitempty"`
+	// Bot through which the message was sent
+	ViaBot *User `json:"via_bot,omitempty"`
+	// Date the message was last edited in Unix time
+	EditDate int64 `json:"edit_date,omitempty"`
+	// True, if the message can't be forwarded
+	HasProtectedContent bool `json:"has_protected_content,omitempty"`
+	// True, if the message was sent by an implicit action, for example, as an away or a greeting business
+	// message, or as a scheduled message
+	IsFromOffline bool `json:"is_from_offline,omitempty"`
+	// The unique identifier of a media message group this message belongs to
+	MediaGroupID string `json:"media_group_id,omitempty"`
+	// Signature of the post author for messages in channels, or the custom title of an anonymous group
+	// administrator
+	AuthorSignature string `json:"author_signature,omitempty"`
+	// For text messages, the actual UTF-8 text of the message
+	Text string `json:"text,omitempty"`
+	// For text messages, special entities like usernames, URLs, bot commands, etc. that appear in the text
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Options used for link preview generation for the message, if it is a text message and link preview
+	// options were changed
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Message is an animation, information about the animation. For backward compatibility, when this
+	// field is set, the document field will also be set
+	Animation *Animation `json:"animation,omitempty"`
+	// Message is an audio file, information about the file
+	Audio *Audio `json:"audio,omitempty"`
+	// Message is a general file, information about the file
+	Document *Document `json:"document,omitempty"`
+	// Message is a photo, available sizes of the photo
+	Photo []PhotoSize `json:"photo,omitempty"`
+	// Message is a sticker, information about the sticker
+	Sticker *Sticker `json:"sticker,omitempty"`
+	// Message is a forwarded story
+	Story *Story `json:"story,omitempty"`
+	// Message is a video, information about the video
+	Video *Video `json:"video,omitempty"`
+	// Message is a video note, information about the video message
+	VideoNote *VideoNote `json:"video_note,omitempty"`
+	// Message is a voice message, information about the file
+	Voice *Voice `json:"voice,omitempty"`
+	// Caption for the animation, audio, document, photo, video or voice
+	Caption string `json:"caption,omitempty"`
+	// For messages with a caption, special entities like usernames, URLs, bot commands, etc. that appear
+	// in the caption
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// True, if the message media is covered by a spoiler animation
+	HasMediaSpoiler bool `json:"has_media_spoiler,omitempty"`
+	// Message is a shared contact, information about the contact
+	Contact *Contact `json:"contact,omitempty"`
+	// Message is a dice with random value
+	Dice *Dice `json:"dice,omitempty"`
+	// Message is a game, information about the game.
+	Game *Game `json:"game,omitempty"`
+	// Message is a native poll, information about the poll
+	Poll *Poll `json:"poll,omitempty"`
+	// Message is a venue, information about the venue. For backward compatibility, when this field is set,
+	// the location field will also be set
+	Venue *Venue `json:"venue,omitempty"`
+	// Message is a shared location, information about the location
+	Location *Location `json:"location,omitempty"`
+	// New members that were added to the group or supergroup and information about them (the bot itself
+	// may be one of these members)
+	NewChatMembers []User `json:"new_chat_members,omitempty"`
+	// A member was removed from the group, information about them (this member may be the bot itself)
+	LeftChatMember *User `json:"left_chat_member,omitempty"`
+	// A chat title was changed to this value
+	NewChatTitle string `json:"new_chat_title,omitempty"`
+	// A chat photo was change to this value
+	NewChatPhoto []PhotoSize `json:"new_chat_photo,omitempty"`
+	// Service message: the chat photo was deleted
+	DeleteChatPhoto bool `json:"delete_chat_photo,omitempty"`
+	// Service message: the group has been created
+	GroupChatCreated bool `json:"group_chat_created,omitempty"`
+	// Service message: the supergroup has been created. This field can't be received in a message coming
+	// through updates, because bot can't be a member of a supergroup when it is created. It can only be
+	// found in reply_to_message if someone replies to a very first message in a directly created
+	// supergroup.
+	SupergroupChatCreated bool `json:"supergroup_chat_created,omitempty"`
+	// Service message: the channel has been created. This field can't be received in a message coming
+	// through updates, because bot can't be a member of a channel when it is created. It can only be found
+	// in reply_to_message if someone replies to a very first message in a channel.
+	ChannelChatCreated bool `json:"channel_chat_created,omitempty"`
+	// Service message: auto-delete timer settings changed in the chat
+	MessageAutoDeleteTimerChanged *MessageAutoDeleteTimerChanged `json:"message_auto_delete_timer_changed,omitempty"`
+	// The group has been migrated to a supergroup with the specified identifier.
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+	// The supergroup has been migrated from a group with the specified identifier.
+	MigrateFromChatID int64 `json:"migrate_from_chat_id,omitempty"`
+	// Specified message was pinned. Note that the Message object in this field will not contain further
+	// reply_to_message fields even if it itself is a reply.
+	PinnedMessage *MaybeInaccessibleMessage `json:"pinned_message,omitempty"`
+	// Message is an invoice for a payment, information about the invoice.
+	Invoice *Invoice `json:"invoice,omitempty"`
+	// Message is a service message about a successful payment, information about the payment.
+	SuccessfulPayment *SuccessfulPayment `json:"successful_payment,omitempty"`
+	// Service message: users were shared with the bot
+	UsersShared *UsersShared `json:"users_shared,omitempty"`
+	// Service message: a chat was shared with the bot
+	ChatShared *ChatShared `json:"chat_shared,omitempty"`
+	// The domain name of the website on which the user has logged in.
+	ConnectedWebsite string `json:"connected_website,omitempty"`
+	// Service message: the user allowed the bot to write messages after adding it to the attachment or
+	// side menu, launching a Web App from a link, or accepting an explicit request from a Web App sent by
+	// the method requestWriteAccess
+	WriteAccessAllowed *WriteAccessAllowed `json:"write_access_allowed,omitempty"`
+	// Telegram Passport data
+	PassportData *PassportData `json:"passport_data,omitempty"`
+	// Service message. A user in the chat triggered another user's proximity alert while sharing Live
+	// Location.
+	ProximityAlertTriggered *ProximityAlertTriggered `json:"proximity_alert_triggered,omitempty"`
+	// Service message: user boosted the chat
+	BoostAdded *ChatBoostAdded `json:"boost_added,omitempty"`
+	// Service message: forum topic created
+	ForumTopicCreated *ForumTopicCreated `json:"forum_topic_created,omitempty"`
+	// Service message: forum topic edited
+	ForumTopicEdited *ForumTopicEdited `json:"forum_topic_edited,omitempty"`
+	// Service message: forum topic closed
+	ForumTopicClosed *ForumTopicClosed `json:"forum_topic_closed,omitempty"`
+	// Service message: forum topic reopened
+	ForumTopicReopened *ForumTopicReopened `json:"forum_topic_reopened,omitempty"`
+	// Service message: the 'General' forum topic hidden
+	GeneralForumTopicHidden *GeneralForumTopicHidden `json:"general_forum_topic_hidden,omitempty"`
+	// Service message: the 'General' forum topic unhidden
+	GeneralForumTopicUnhidden *GeneralForumTopicUnhidden `json:"general_forum_topic_unhidden,omitempty"`
+	// Service message: a scheduled giveaway was created
+	GiveawayCreated *GiveawayCreated `json:"giveaway_created,omitempty"`
+	// The message is a scheduled giveaway message
+	Giveaway *Giveaway `json:"giveaway,omitempty"`
+	// A giveaway with public winners was completed
+	GiveawayWinners *GiveawayWinners `json:"giveaway_winners,omitempty"`
+	// Service message: a giveaway without public winners was completed
+	GiveawayCompleted *GiveawayCompleted `json:"giveaway_completed,omitempty"`
+	// Service message: video chat scheduled
+	VideoChatScheduled *VideoChatScheduled `json:"video_chat_scheduled,omitempty"`
+	// Service message: video chat started
+	VideoChatStarted *VideoChatStarted `json:"video_chat_started,omitempty"`
+	// Service message: video chat ended
+	VideoChatEnded *VideoChatEnded `json:"video_chat_ended,omitempty"`
+	// Service message: new participants invited to a video chat
+	VideoChatParticipantsInvited *VideoChatParticipantsInvited `json:"video_chat_participants_invited,omitempty"`
+	// Service message: data sent by a Web App
+	WebAppData *WebAppData `json:"web_app_data,omitempty"`
+	// Inline keyboard attached to the message. login_url buttons are represented as ordinary url buttons.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// MessageAutoDeleteTimerChanged is the MessageAutoDeleteTimerChanged object, see
+// https://core.telegram.org/bots/api#messageautodeletetimerchanged
+//
+// This object represents a service message about a change in auto-delete timer settings.
+type MessageAutoDeleteTimerChanged struct {
+	// New auto-delete time for messages in the chat; in seconds
+	MessageAutoDeleteTime int64 `json:"message_auto_delete_time"`
+}
+
+// MessageEntity is the MessageEntity object, see https://core.telegram.org/bots/api#messageentity
+//
+// This object represents one special entity in a text message. For example, hashtags, usernames, URLs,
+// etc.
+type MessageEntity struct {
+	// Type of the entity. Currently, can be “mention” (@username), “hashtag” (#hashtag),
+	// “cashtag” ($USD), “bot_command” (/start@jobs_bot), “url” (https://telegram.org),
+	// “email” (do-not-reply@telegram.org), “phone_number” (+1-212-555-0123), “bold” (bold
+	// text), “italic” (italic text), “underline” (underlined text), “strikethrough”
+	// (strikethrough text), “spoiler” (spoiler message), “blockquote” (block quotation),
+	// “code” (monowidth string), “pre” (monowidth block), “text_link” (for clickable text
+	// URLs), “text_mention” (for users without usernames), “custom_emoji” (for inline custom emoji
+	// stickers)
+	Type string `json:"type"`
+	// Offset in UTF-16 code units to the start of the entity
+	Offset int64 `json:"offset"`
+	// Length of the entity in UTF-16 code units
+	Length int64 `json:"length"`
+	// For “text_link” only, URL that will be opened after user taps on the text
+	URL string `json:"url,omitempty"`
+	// For “text_mention” only, the mentioned user
+	User *User `json:"user,omitempty"`
+	// For “pre” only, the programming language of the entity text
+	Language string `json:"language,omitempty"`
+	// For “custom_emoji” only, unique identifier of the custom emoji. Use getCustomEmojiStickers to
+	// get full information about the sticker
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// MessageId is the MessageId object, see https://core.telegram.org/bots/api#messageid
+//
+// This object represents a unique message identifier.
+type MessageId struct {
+	// Unique message identifier
+	MessageID int64 `json:"message_id"`
+}
+
+// MessageOrigin is the MessageOrigin object, see https://core.telegram.org/bots/api#messageorigin
+//
+// This object describes the origin of a message. It can be one of
+//
+// The fields of MessageOriginUser, MessageOriginHiddenUser, MessageOriginChat, MessageOriginChannel
+// are merged.
+type MessageOrigin struct {
+	// Type of the message origin, one of MessageOriginUser, MessageOriginHiddenUser, MessageOriginChat,
+	// MessageOriginChannel
+	Type string `json:"type"`
+	// Date the message was sent originally in Unix time
+	Date int64 `json:"date"`
+	// User that sent the message originally
+	SenderUser *User `json:"sender_user,omitempty"`
+	// Name of the user that sent the message originally
+	SenderUserName string `json:"sender_user_name,omitempty"`
+	// Chat that sent the message originally
+	SenderChat *Chat `json:"sender_chat,omitempty"`
+	// For messages originally sent by an anonymous chat administrator, original message author signature
+	AuthorSignature string `json:"author_signature,omitempty"`
+	// Channel chat to which the message was originally sent
+	Chat *Chat `json:"chat,omitempty"`
+	// Unique message identifier inside the chat
+	MessageID int64 `json:"message_id,omitempty"`
+}
+
+const (
+	// MessageOriginUser is the type of MessageOrigin, see
+	// https://core.telegram.org/bots/api#messageoriginuser
+	//
+	// The message was originally sent by a known user.
+	MessageOriginUser = "user"
+	// MessageOriginHiddenUser is the type of MessageOrigin, see
+	// https://core.telegram.org/bots/api#messageoriginhiddenuser
+	//
+	// The message was originally sent by an unknown user.
+	MessageOriginHiddenUser = "hidden_user"
+	// MessageOriginChat is the type of MessageOrigin, see
+	// https://core.telegram.org/bots/api#messageoriginchat
+	//
+	// The message was originally sent on behalf of a chat to a group chat.
+	MessageOriginChat = "chat"
+	// MessageOriginChannel is the type of MessageOrigin, see
+	// https://core.telegram.org/bots/api#messageoriginchannel
+	//
+	// The message was originally sent to a channel chat.
+	MessageOriginChannel = "channel"
+)
+
+// MessageReactionCountUpdated is the MessageReactionCountUpdated object, see
+// https://core.telegram.org/bots/api#messagereactioncountupdated
+//
+// This object represents reaction changes on a message with anonymous reactions.
+type MessageReactionCountUpdated struct {
+	// The chat containing the message
+	Chat Chat `json:"chat"`
+	// Unique message identifier inside the chat
+	MessageID int64 `json:"message_id"`
+	// Date of the change in Unix time
+	Date int64 `json:"date"`
+	// List of reactions that are present on the message
+	Reactions []ReactionCount `json:"reactions"`
+}
+
+// MessageReactionUpdated is the MessageReactionUpdated object, see
+// https://core.telegram.org/bots/api#messagereactionupdated
+//
+// This object represents a change of a reaction on a message performed by a user.
+type MessageReactionUpdated struct {
+	// The chat containing the message the user reacted to
+	Chat Chat `json:"chat"`
+	// Unique identifier of the message inside the chat
+	MessageID int64 `json:"message_id"`
+	// The user that changed the reaction, if the user isn't anonymous
+	User *User `json:"user,omitempty"`
+	// The chat on behalf of which the reaction was changed, if the user is anonymous
+	ActorChat *Chat `json:"actor_chat,omitempty"`
+	// Date of the change in Unix time
+	Date int64 `json:"date"`
+	// Previous list of reaction types that were set by the user
+	OldReaction []ReactionType `json:"old_reaction"`
+	// New list of reaction types that have been set by the user
+	NewReaction []ReactionType `json:"new_reaction"`
+}
+
+// OrderInfo is the OrderInfo object, see https://core.telegram.org/bots/api#orderinfo
+//
+// This object represents information about an order.
+type OrderInfo struct {
+	// User name
+	Name string `json:"name,omitempty"`
+	// User's phone number
+	PhoneNumber string `json:"phone_number,omitempty"`
+	// User email
+	Email string `json:"email,omitempty"`
+	// User shipping address
+	ShippingAddress *ShippingAddress `json:"shipping_address,omitempty"`
+}
+
+// PassportData is the PassportData object, see https://core.telegram.org/bots/api#passportdata
+//
+// Describes Telegram Passport data shared with the bot by the user.
+type PassportData struct {
+	// Array with information about documents and other Telegram Passport elements that was shared with the
+	// bot
+	Data []EncryptedPassportElement `json:"data"`
+	// Encrypted credentials required to decrypt the data
+	Credentials EncryptedCredentials `json:"credentials"`
+}
+
+// PassportElementError is the PassportElementError object, see
+// https://core.telegram.org/bots/api#passportelementerror
+//
+// This object represents an error in the Telegram Passport element which was submitted that should be
+// resolved by the user. It should be one of:
+//
+// The fields of PassportElementErrorDataField, PassportElementErrorFrontSide,
+// PassportElementErrorReverseSide, PassportElementErrorSelfie, PassportElementErrorFile,
+// PassportElementErrorFiles, PassportElementErrorTranslationFile,
+// PassportElementErrorTranslationFiles, PassportElementErrorUnspecified are merged.
+type PassportElementError struct {
+	// Error source, one of PassportElementErrorDataField, PassportElementErrorFrontSide,
+	// PassportElementErrorReverseSide, PassportElementErrorSelfie, PassportElementErrorFile,
+	// PassportElementErrorFiles, PassportElementErrorTranslationFile,
+	// PassportElementErrorTranslationFiles, PassportElementErrorUnspecified
+	Source string `json:"source"`
+	// The section of the user's Telegram Passport which has the error, one of “personal_details”,
+	// “passport”, “driver_license”, “identity_card”, “internal_passport”, “address”
+	Type string `json:"type"`
+	// Name of the data field which has the error
+	FieldName string `json:"field_name,omitempty"`
+	// Base64-encoded data hash
+	DataHash string `json:"data_hash,omitempty"`
+	// Error message
+	Message string `json:"message"`
+	// Base64-encoded hash of the file with the front side of the document
+	FileHash string `json:"file_hash,omitempty"`
+	// List of base64-encoded file hashes
+	FileHashes []string `json:"file_hashes,omitempty"`
+	// Base64-encoded element hash
+	ElementHash string `json:"element_hash,omitempty"`
+}
+
+const (
+	// PassportElementErrorDataField is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrordatafield
+	//
+	// Represents an issue in one of the data fields that was provided by the user. The error is considered
+	// resolved when the field's value changes.
+	PassportElementErrorDataField = "data"
+	// PassportElementErrorFrontSide is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrorfrontside
+	//
+	// Represents an issue with the front side of a document. The error is considered resolved when the
+	// file with the front side of the document changes.
+	PassportElementErrorFrontSide = "front_side"
+	// PassportElementErrorReverseSide is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrorreverseside
+	//
+	// Represents an issue with the reverse side of a document. The error is considered resolved when the
+	// file with reverse side of the document changes.
+	PassportElementErrorReverseSide = "reverse_side"
+	// PassportElementErrorSelfie is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrorselfie
+	//
+	// Represents an issue with the selfie with a document. The error is considered resolved when the file
+	// with the selfie changes.
+	PassportElementErrorSelfie = "selfie"
+	// PassportElementErrorFile is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrorfile
+	//
+	// Represents an issue with a document scan. The error is considered resolved when the file with the
+	// document scan changes.
+	PassportElementErrorFile = "file"
+	// PassportElementErrorFiles is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrorfiles
+	//
+	// Represents an issue with a list of scans. The error is considered resolved when the list of files
+	// containing the scans changes.
+	PassportElementErrorFiles = "files"
+	// PassportElementErrorTranslationFile is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrortranslationfile
+	//
+	// Represents an issue with one of the files that constitute the translation of a document. The error
+	// is considered resolved when the file changes.
+	PassportElementErrorTranslationFile = "translation_file"
+	// PassportElementErrorTranslationFiles is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrortranslationfiles
+	//
+	// Represents an issue with the translated version of a document. The error is considered resolved when
+	// a file with the document translation change.
+	PassportElementErrorTranslationFiles = "translation_files"
+	// PassportElementErrorUnspecified is the source of PassportElementError, see
+	// https://core.telegram.org/bots/api#passportelementerrorunspecified
+	//
+	// Represents an issue in an unspecified place. The error is considered resolved when new data is
+	// added.
+	PassportElementErrorUnspecified = "unspecified"
+)
+
+// PassportFile is the PassportFile object, see https://core.telegram.org/bots/api#passportfile
+//
+// This object represents a file uploaded to Telegram Passport. Currently all Telegram Passport files
+// are in JPEG format when decrypted and don't exceed 10MB.
+type PassportFile struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// File size in bytes
+	FileSize int64 `json:"file_size"`
+	// Unix time when the file was uploaded
+	FileDate int64 `json:"file_date"`
+}
+
+// PhotoSize is the PhotoSize object, see https://core.telegram.org/bots/api#photosize
+//
+// This object represents one size of a photo or a file / sticker thumbnail.
+type PhotoSize struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Photo width
+	Width int64 `json:"width"`
+	// Photo height
+	Height int64 `json:"height"`
+	// File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// Poll is the Poll object, see https://core.telegram.org/bots/api#poll
+//
+// This object contains information about a poll.
+type Poll struct {
+	// Unique poll identifier
+	ID string `json:"id"`
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+	// List of poll options
+	Options []PollOption `json:"options"`
+	// Total number of users that voted in the poll
+	TotalVoterCount int64 `json:"total_voter_count"`
+	// True, if the poll is closed
+	IsClosed bool `json:"is_closed"`
+	// True, if the poll is anonymous
+	IsAnonymous bool `json:"is_anonymous"`
+	// Poll type, currently can be “regular” or “quiz”
+	Type string `json:"type"`
+	// True, if the poll allows multiple answers
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers"`
+	// 0-based identifier of the correct answer option. Available only for polls in the quiz mode, which
+	// are closed, or was sent (not forwarded) by the bot or to the private chat with the bot.
+	CorrectOptionID int64 `json:"correct_option_id,omitempty"`
+	// Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style
+	// poll, 0-200 characters
+	Explanation string `json:"explanation,omitempty"`
+	// Special entities like usernames, URLs, bot commands, etc. that appear in the explanation
+	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
+	// Amount of time in seconds the poll will be active after creation
+	OpenPeriod int64 `json:"open_period,omitempty"`
+	// Point in time (Unix timestamp) when the poll will be automatically closed
+	CloseDate int64 `json:"close_date,omitempty"`
+}
+
+// PollAnswer is the PollAnswer object, see https://core.telegram.org/bots/api#pollanswer
+//
+// This object represents an answer of a user in a non-anonymous poll.
+type PollAnswer struct {
+	// Unique poll identifier
+	PollID string `json:"poll_id"`
+	// The chat that changed the answer to the poll, if the voter is anonymous
+	VoterChat *Chat `json:"voter_chat,omitempty"`
+	// The user that changed the answer to the poll, if the voter isn't anonymous
+	User *User `json:"user,omitempty"`
+	// 0-based identifiers of chosen answer options. May be empty if the vote was retracted.
+	OptionIds []int64 `json:"option_ids"`
+}
+
+// PollOption is the PollOption object, see https://core.telegram.org/bots/api#polloption
+//
+// This object contains information about one answer option in a poll.
+type PollOption struct {
+	// Option text, 1-100 characters
+	Text string `json:"text"`
+	// Number of users that voted for this option
+	VoterCount int64 `json:"voter_count"`
+}
+
+// PreCheckoutQuery is the PreCheckoutQuery object, see
+// https://core.telegram.org/bots/api#precheckoutquery
+//
+// This object contains information about an incoming pre-checkout query.
+type PreCheckoutQuery struct {
+	// Unique query identifier
+	ID string `json:"id"`
+	// User who sent the query
+	From User `json:"from"`
+	// Three-letter ISO 4217 currency code
+	Currency string `json:"currency"`
+	// Total price in the smallest units of the currency (integer, not float/double). For example, for a
+	// price of US$ 1.45 pass amount = 145. See the exp parameter in currencies.json, it shows the number
+	// of digits past the decimal point for each currency (2 for the majority of currencies).
+	TotalAmount int64 `json:"total_amount"`
+	// Bot specified invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+	// Identifier of the shipping option chosen by the user
+	ShippingOptionID string `json:"shipping_option_id,omitempty"`
+	// Order information provided by the user
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+}
+
+// ProximityAlertTriggered is the ProximityAlertTriggered object, see
+// https://core.telegram.org/bots/api#proximityalerttriggered
+//
+// This object represents the content of a service message, sent whenever a user in the chat triggers a
+// proximity alert set by another user.
+type ProximityAlertTriggered struct {
+	// User that triggered the alert
+	Traveler User `json:"traveler"`
+	// User that set the alert
+	Watcher User `json:"watcher"`
+	// The distance between the users
+	Distance int64 `json:"distance"`
+}
+
+// ReactionCount is the ReactionCount object, see https://core.telegram.org/bots/api#reactioncount
+//
+// Represents a reaction added to a message along with the number of times it was added.
+type ReactionCount struct {
+	// Type of the reaction
+	Type ReactionType `json:"type"`
+	// Number of times the reaction was added
+	TotalCount int64 `json:"total_count"`
+}
+
+// ReactionType is the ReactionType object, see https://core.telegram.org/bots/api#reactiontype
+//
+// This object describes the type of a reaction. Currently, it can be one of
+//
+// The fields of ReactionTypeEmoji, ReactionTypeCustomEmoji are merged.
+type ReactionType struct {
+	// Type of the reaction, one of ReactionTypeEmoji, ReactionTypeCustomEmoji
+	Type string `json:"type"`
+	// Reaction emoji. Currently, it can be one of "👍", "👎", "❤", "🔥", "🥰", "👏", "😁",
+	// "🤔", "🤯", "😱", "🤬", "😢", "🎉", "🤩", "🤮", "💩", "🙏", "👌", "🕊",
+	// "🤡", "🥱", "🥴", "😍", "🐳", "❤‍🔥", "🌚", "🌭", "💯", "🤣", "⚡", "🍌",
+	// "🏆", "💔", "🤨", "😐", "🍓", "🍾", "💋", "🖕", "😈", "😴", "😭", "🤓",
+	// "👻", "👨‍💻", "👀", "🎃", "🙈", "😇", "😨", "🤝", "✍", "🤗", "🫡",
+	// "🎅", "🎄", "☃", "💅", "🤪", "🗿", "🆒", "💘", "🙉", "🦄", "😘", "💊",
+	// "🙊", "😎", "👾", "🤷‍♂", "🤷", "🤷‍♀", "😡"
+	Emoji string `json:"emoji,omitempty"`
+	// Custom emoji identifier
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+const (
+	// ReactionTypeEmoji is the type of ReactionType, see
+	// https://core.telegram.org/bots/api#reactiontypeemoji
+	//
+	// The reaction is based on an emoji.
+	ReactionTypeEmoji = "emoji"
+	// ReactionTypeCustomEmoji is the type of ReactionType, see
+	// https://core.telegram.org/bots/api#reactiontypecustomemoji
+	//
+	// The reaction is based on a custom emoji.
+	ReactionTypeCustomEmoji = "custom_emoji"
+)
+
+// ReplyKeyboardMarkup is the ReplyKeyboardMarkup object, see
+// https://core.telegram.org/bots/api#replykeyboardmarkup
+//
+// This object represents a custom keyboard with reply options (see Introduction to bots for details
+// and examples).
+type ReplyKeyboardMarkup struct {
+	// Array of button rows, each represented by an Array of KeyboardButton objects
+	Keyboard [][]KeyboardButton `json:"keyboard"`
+	// Requests clients to always show the keyboard when the regular keyboard is hidden. Defaults to false,
+	// in which case the custom keyboard can be hidden and opened with a keyboard icon.
+	IsPersistent bool `json:"is_persistent,omitempty"`
+	// Requests clients to resize the keyboard vertically for optimal fit (e.g., make the keyboard smaller
+	// if there are just two rows of buttons). Defaults to false, in which case the custom keyboard is
+	// always of the same height as the app's standard keyboard.
+	ResizeKeyboard bool `json:"resize_keyboard,omitempty"`
+	// Requests clients to hide the keyboard as soon as it's been used. The keyboard will still be
+	// available, but clients will automatically display the usual letter-keyboard in the chat - the user
+	// can press a special button in the input field to see the custom keyboard again. Defaults to false.
+	OneTimeKeyboard bool `json:"one_time_keyboard,omitempty"`
+	// The placeholder to be shown in the input field when the keyboard is active; 1-64 characters
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+	// Use this parameter if you want to show the keyboard to specific users only. Targets: 1) users that
+	// are @mentioned in the text of the Message object; 2) if the bot's message is a reply to a message in
+	// the same chat and forum topic, sender of the original message.
+	Selective bool `json:"selective,omitempty"`
+}
+
+// ReplyKeyboardRemove is the ReplyKeyboardRemove object, see
+// https://core.telegram.org/bots/api#replykeyboardremove
+//
+// Upon receiving a message with this object, Telegram clients will remove the current custom keyboard
+// and display the default letter-keyboard. By default, custom keyboards are displayed until a new
+// keyboard is sent by a bot. An exception is made for one-time keyboards that are hidden immediately
+// after the user presses a button (see ReplyKeyboardMarkup).
+type ReplyKeyboardRemove struct {
+	// Requests clients to remove the custom keyboard (user will not be able to summon this keyboard; if
+	// you want to hide the keyboard from sight but keep it accessible, use one_time_keyboard in
+	// ReplyKeyboardMarkup)
+	RemoveKeyboard bool `json:"remove_keyboard"`
+	// Use this parameter if you want to remove the keyboard for specific users only. Targets: 1) users
+	// that are @mentioned in the text of the Message object; 2) if the bot's message is a reply to a
+	// message in the same chat and forum topic, sender of the original message.
+	Selective bool `json:"selective,omitempty"`
+}
+
+// ReplyParameters is the ReplyParameters object, see
+// https://core.telegram.org/bots/api#replyparameters
+//
+// Describes reply parameters for the message that is being sent.
+type ReplyParameters struct {
+	// Identifier of the message that will be replied to in the current chat, or in the chat chat_id if it
+	// is specified
+	MessageID int64 `json:"message_id"`
+	// If the message to be replied to is from a different chat, unique identifier for the chat or username
+	// of the channel (in the format @channelusername). Not supported for messages sent on behalf of a
+	// business account.
+	ChatID string `json:"chat_id,omitempty"`
+	// Pass True if the message should be sent even if the specified message to be replied to is not found.
+	// Always False for replies in another chat or forum topic. Always True for messages sent on behalf of
+	// a business account.
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+	// Quoted part of the message to be replied to; 0-1024 characters after entities parsing. The quote
+	// must be an exact substring of the message to be replied to, including bold, italic, underline,
+	// strikethrough, spoiler, and custom_emoji entities. The message will fail to send if the quote isn't
+	// found in the original message.
+	Quote string `json:"quote,omitempty"`
+	// Mode for parsing entities in the quote. See formatting options for more details.
+	QuoteParseMode string `json:"quote_parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the quote. It can be specified instead of
+	// quote_parse_mode.
+	QuoteEntities []MessageEntity `json:"quote_entities,omitempty"`
+	// Position of the quote in the original message in UTF-16 code units
+	QuotePosition int64 `json:"quote_position,omitempty"`
+}
+
+// ResponseParameters is the ResponseParameters object, see
+// https://core.telegram.org/bots/api#responseparameters
+//
+// Describes why a request was unsuccessful.
+type ResponseParameters struct {
+	// The group has been migrated to a supergroup with the specified identifier. This number may have more
+	// than 32 significant bits and some programming languages may have difficulty/silent defects in
+	// interpreting it. But it has at most 52 significant bits, so a signed 64-bit integer or
+	// double-precision float type are safe for storing this identifier.
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+	// In case of exceeding flood control, the number of seconds left to wait before the request can be
+	// repeated
+	RetryAfter int64 `json:"retry_after,omitempty"`
+}
+
+// SentWebAppMessage is the SentWebAppMessage object, see
+// https://core.telegram.org/bots/api#sentwebappmessage
+//
+// Describes an inline message sent by a Web App on behalf of a user.
+type SentWebAppMessage struct {
+	// Identifier of the sent inline message. Available only if there is an inline keyboard attached to the
+	// message.
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// SharedUser is the SharedUser object, see https://core.telegram.org/bots/api#shareduser
+//
+// This object contains information about a user that was shared with the bot using a
+// KeyboardButtonRequestUsers button.
+type SharedUser struct {
+	// Identifier of the shared user. This number may have more than 32 significant bits and some
+	// programming languages may have difficulty/silent defects in interpreting it. But it has at most 52
+	// significant bits, so 64-bit integers or double-precision float types are safe for storing these
+	// identifiers. The bot may not have access to the user and could be unable to use this identifier,
+	// unless the user is already known to the bot by some other means.
+	UserID int64 `json:"user_id"`
+	// First name of the user, if the name was requested by the bot
+	FirstName string `json:"first_name,omitempty"`
+	// Last name of the user, if the name was requested by the bot
+	LastName string `json:"last_name,omitempty"`
+	// Username of the user, if the username was requested by the bot
+	Username string `json:"username,omitempty"`
+	// Available sizes of the chat photo, if the photo was requested by the bot
+	Photo []PhotoSize `json:"photo,omitempty"`
+}
+
+// ShippingAddress is the ShippingAddress object, see
+// https://core.telegram.org/bots/api#shippingaddress
+//
+// This object represents a shipping address.
+type ShippingAddress struct {
+	// Two-letter ISO 3166-1 alpha-2 country code
+	CountryCode string `json:"country_code"`
+	// State, if applicable
+	State string `json:"state"`
+	// City
+	City string `json:"city"`
+	// First line for the address
+	StreetLine1 string `json:"street_line1"`
+	// Second line for the address
+	StreetLine2 string `json:"street_line2"`
+	// Address post code
+	PostCode string `json:"post_code"`
+}
+
+// ShippingOption is the ShippingOption object, see https://core.telegram.org/bots/api#shippingoption
+//
+// This object represents one shipping option.
+type ShippingOption struct {
+	// Shipping option identifier
+	ID string `json:"id"`
+	// Option title
+	Title string `json:"title"`
+	// List of price portions
+	Prices []LabeledPrice `json:"prices"`
+}
+
+// ShippingQuery is the ShippingQuery object, see https://core.telegram.org/bots/api#shippingquery
+//
+// This object contains information about an incoming shipping query.
+type ShippingQuery struct {
+	// Unique query identifier
+	ID string `json:"id"`
+	// User who sent the query
+	From User `json:"from"`
+	// Bot specified invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+	// User specified shipping address
+	ShippingAddress ShippingAddress `json:"shipping_address"`
+}
+
+// Sticker is the Sticker object, see https://core.telegram.org/bots/api#sticker
+//
+// This object represents a sticker.
+type Sticker struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Type of the sticker, currently one of “regular”, “mask”, “custom_emoji”. The type of the
+	// sticker is independent from its format, which is determined by the fields is_animated and is_video.
+	Type string `json:"type"`
+	// Sticker width
+	Width int64 `json:"width"`
+	// Sticker height
+	Height int64 `json:"height"`
+	// True, if the sticker is animated
+	IsAnimated bool `json:"is_animated"`
+	// True, if the sticker is a video sticker
+	IsVideo bool `json:"is_video"`
+	// Sticker thumbnail in the .WEBP or .JPG format
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+	// Emoji associated with the sticker
+	Emoji string `json:"emoji,omitempty"`
+	// Name of the sticker set to which the sticker belongs
+	SetName string `json:"set_name,omitempty"`
+	// For premium regular stickers, premium animation for the sticker
+	PremiumAnimation *File `json:"premium_animation,omitempty"`
+	// For mask stickers, the position where the mask should be placed
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+	// For custom emoji stickers, unique identifier of the custom emoji
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+	// True, if the sticker must be repainted to a text color in messages, the color of the Telegram
+	// Premium badge in emoji status, white color on chat photos, or another appropriate color in other
+	// places
+	NeedsRepainting bool `json:"needs_repainting,omitempty"`
+	// File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// StickerSet is the StickerSet object, see https://core.telegram.org/bots/api#stickerset
+//
+// This object represents a sticker set.
+type StickerSet struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// Sticker set title
+	Title string `json:"title"`
+	// Type of stickers in the set, currently one of “regular”, “mask”, “custom_emoji”
+	StickerType string `json:"sticker_type"`
+	// List of all set stickers
+	Stickers []Sticker `json:"stickers"`
+	// Sticker set thumbnail in the .WEBP, .TGS, or .WEBM format
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+}
+
+// Story is the Story object, see https://core.telegram.org/bots/api#story
+//
+// This object represents a story.
+type Story struct {
+	// Chat that posted the story
+	Chat Chat `json:"chat"`
+	// Unique identifier for the story in the chat
+	ID int64 `json:"id"`
+}
+
+// SuccessfulPayment is the SuccessfulPayment object, see
+// https://core.telegram.org/bots/api#successfulpayment
+//
+// This object contains basic information about a successful payment.
+type SuccessfulPayment struct {
+	// Three-letter ISO 4217 currency code
+	Currency string `json:"currency"`
+	// Total price in the smallest units of the currency (integer, not float/double). For example, for a
+	// price of US$ 1.45 pass amount = 145. See the exp parameter in currencies.json, it shows the number
+	// of digits past the decimal point for each currency (2 for the majority of currencies).
+	TotalAmount int64 `json:"total_amount"`
+	// Bot specified invoice payload
+	InvoicePayload string `json:"invoice_payload"`
+	// Identifier of the shipping option chosen by the user
+	ShippingOptionID string `json:"shipping_option_id,omitempty"`
+	// Order information provided by the user
+	OrderInfo *OrderInfo `json:"order_info,omitempty"`
+	// Telegram payment identifier
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+	// Provider payment identifier
+	ProviderPaymentChargeID string `json:"provider_payment_charge_id"`
+}
+
+// SwitchInlineQueryChosenChat is the SwitchInlineQueryChosenChat object, see
+// https://core.telegram.org/bots/api#switchinlinequerychosenchat
+//
+// This object represents an inline button that switches the current user to inline mode in a chosen
+// chat, with an optional default inline query.
+type SwitchInlineQueryChosenChat struct {
+	// The default inline query to be inserted in the input field. If left empty, only the bot's username
+	// will be inserted
+	Query string `json:"query,omitempty"`
+	// True, if private chats with users can be chosen
+	AllowUserChats bool `json:"allow_user_chats,omitempty"`
+	// True, if private chats with bots can be chosen
+	AllowBotChats bool `json:"allow_bot_chats,omitempty"`
+	// True, if group and supergroup chats can be chosen
+	AllowGroupChats bool `json:"allow_group_chats,omitempty"`
+	// True, if channel chats can be chosen
+	AllowChannelChats bool `json:"allow_channel_chats,omitempty"`
+}
+
+// TextQuote is the TextQuote object, see https://core.telegram.org/bots/api#textquote
+//
+// This object contains information about the quoted part of a message that is replied to by the given
+// message.
+type TextQuote struct {
+	// Text of the quoted part of a message that is replied to by the given message
+	Text string `json:"text"`
+	// Special entities that appear in the quote. Currently, only bold, italic, underline, strikethrough,
+	// spoiler, and custom_emoji entities are kept in quotes.
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Approximate quote position in the original message in UTF-16 code units as specified by the sender
+	Position int64 `json:"position"`
+	// True, if the quote was chosen manually by the message sender. Otherwise, the quote was added
+	// automatically by the server.
+	IsManual bool `json:"is_manual,omitempty"`
+}
+
+// Update is the Update object, see https://core.telegram.org/bots/api#update
+//
+// This object represents an incoming update. At most one of the optional parameters can be present in
+// any given update.
+type Update struct {
+	// The update's unique identifier. Update identifiers start from a certain positive number and increase
+	// sequentially. This identifier becomes especially handy if you're using webhooks, since it allows you
+	// to ignore repeated updates or to restore the correct update sequence, should they get out of order.
+	// If there are no new updates for at least a week, then identifier of the next update will be chosen
+	// randomly instead of sequentially.
+	UpdateID int64 `json:"update_id"`
+	// New incoming message of any kind - text, photo, sticker, etc.
+	Message *Message `json:"message,omitempty"`
+	// New version of a message that is known to the bot and was edited. This update may at times be
+	// triggered by changes to message fields that are either unavailable or not actively used by your bot.
+	EditedMessage *Message `json:"edited_message,omitempty"`
+	// New incoming channel post of any kind - text, photo, sticker, etc.
+	ChannelPost *Message `json:"channel_post,omitempty"`
+	// New version of a channel post that is known to the bot and was edited. This update may at times be
+	// triggered by changes to message fields that are either unavailable or not actively used by your bot.
+	EditedChannelPost *Message `json:"edited_channel_post,omitempty"`
+	// The bot was connected to or disconnected from a business account, or a user edited an existing
+	// connection with the bot
+	BusinessConnection *BusinessConnection `json:"business_connection,omitempty"`
+	// New non-service message from a connected business account
+	BusinessMessage *Message `json:"business_message,omitempty"`
+	// New version of a message from a connected business account
+	EditedBusinessMessage *Message `json:"edited_business_message,omitempty"`
+	// Messages were deleted from a connected business account
+	DeletedBusinessMessages *BusinessMessagesDeleted `json:"deleted_business_messages,omitempty"`
+	// A reaction to a message was changed by a user. The bot must be an administrator in the chat and must
+	// explicitly specify "message_reaction" in the list of allowed_updates to receive these updates. The
+	// update isn't received for reactions set by bots.
+	MessageReaction *MessageReactionUpdated `json:"message_reaction,omitempty"`
+	// Reactions to a message with anonymous reactions were changed. The bot must be an administrator in
+	// the chat and must explicitly specify "message_reaction_count" in the list of allowed_updates to
+	// receive these updates. The updates are grouped and can be sent with delay up to a few minutes.
+	MessageReactionCount *MessageReactionCountUpdated `json:"message_reaction_count,omitempty"`
+	// New incoming inline query
+	InlineQuery *InlineQuery `json:"inline_query,omitempty"`
+	// The result of an inline query that was chosen by a user and sent to their chat partner. Please see
+	// our documentation on the feedback collecting for details on how to enable these updates for your
+	// bot.
+	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result,omitempty"`
+	// New incoming callback query
+	CallbackQuery *CallbackQuery `json:"callback_query,omitempty"`
+	// New incoming shipping query. Only for invoices with flexible price
+	ShippingQuery *ShippingQuery `json:"shipping_query,omitempty"`
+	// New incoming pre-checkout query. Contains full information about checkout
+	PreCheckoutQuery *PreCheckoutQuery `json:"pre_checkout_query,omitempty"`
+	// New poll state. Bots receive only updates about manually stopped polls and polls, which are sent by
+	// the bot
+	Poll *Poll `json:"poll,omitempty"`
+	// A user changed their answer in a non-anonymous poll. Bots receive new votes only in polls that were
+	// sent by the bot itself.
+	PollAnswer *PollAnswer `json:"poll_answer,omitempty"`
+	// The bot's chat member status was updated in a chat. For private chats, this update is received only
+	// when the bot is blocked or unblocked by the user.
+	MyChatMember *ChatMemberUpdated `json:"my_chat_member,omitempty"`
+	// A chat member's status was updated in a chat. The bot must be an administrator in the chat and must
+	// explicitly specify "chat_member" in the list of allowed_updates to receive these updates.
+	ChatMember *ChatMemberUpdated `json:"chat_member,omitempty"`
+	// A request to join the chat has been sent. The bot must have the can_invite_users administrator right
+	// in the chat to receive these updates.
+	ChatJoinRequest *ChatJoinRequest `json:"chat_join_request,omitempty"`
+	// A chat boost was added or changed. The bot must be an administrator in the chat to receive these
+	// updates.
+	ChatBoost *ChatBoostUpdated `json:"chat_boost,omitempty"`
+	// A boost was removed from a chat. The bot must be an administrator in the chat to receive these
+	// updates.
+	RemovedChatBoost *ChatBoostRemoved `json:"removed_chat_boost,omitempty"`
+}
+
+// User is the User object, see https://core.telegram.org/bots/api#user
+//
+// This object represents a Telegram user or bot.
+type User struct {
+	// Unique identifier for this user or bot. This number may have more than 32 significant bits and some
+	// programming languages may have difficulty/silent defects in interpreting it. But it has at most 52
+	// significant bits, so a 64-bit integer or double-precision float type are safe for storing this
+	// identifier.
+	ID int64 `json:"id"`
+	// True, if this user is a bot
+	IsBot bool `json:"is_bot"`
+	// User's or bot's first name
+	FirstName string `json:"first_name"`
+	// User's or bot's last name
+	LastName string `json:"last_name,omitempty"`
+	// User's or bot's username
+	Username string `json:"username,omitempty"`
+	// IETF language tag of the user's language
+	LanguageCode string `json:"language_code,omitempty"`
+	// True, if this user is a Telegram Premium user
+	IsPremium bool `json:"is_premium,omitempty"`
+	// True, if this user added the bot to the attachment menu
+	AddedToAttachmentMenu bool `json:"added_to_attachment_menu,omitempty"`
+	// True, if the bot can be invited to groups. Returned only in getMe.
+	CanJoinGroups bool `json:"can_join_groups,omitempty"`
+	// True, if privacy mode is disabled for the bot. Returned only in getMe.
+	CanReadAllGroupMessages bool `json:"can_read_all_group_messages,omitempty"`
+	// True, if the bot supports inline queries. Returned only in getMe.
+	SupportsInlineQueries bool `json:"supports_inline_queries,omitempty"`
+	// True, if the bot can be connected to a Telegram Business account to receive its messages. Returned
+	// only in getMe.
+	CanConnectToBusiness bool `json:"can_connect_to_business,omitempty"`
+}
+
+// UserChatBoosts is the UserChatBoosts object, see https://core.telegram.org/bots/api#userchatboosts
+//
+// This object represents a list of boosts added to a chat by a user.
+type UserChatBoosts struct {
+	// The list of boosts added to the chat by the user
+	Boosts []ChatBoost `json:"boosts"`
+}
+
+// UserProfilePhotos is the UserProfilePhotos object, see
+// https://core.telegram.org/bots/api#userprofilephotos
+//
+// This object represent a user's profile pictures.
+type UserProfilePhotos struct {
+	// Total number of profile pictures the target user has
+	TotalCount int64 `json:"total_count"`
+	// Requested profile pictures (in up to 4 sizes each)
+	Photos [][]PhotoSize `json:"photos"`
+}
+
+// UsersShared is the UsersShared object, see https://core.telegram.org/bots/api#usersshared
+//
+// This object contains information about the users whose identifiers were shared with the bot using a
+// KeyboardButtonRequestUsers button.
+type UsersShared struct {
+	// Identifier of the request
+	RequestID int64 `json:"request_id"`
+	// Information about users shared with the bot.
+	Users []SharedUser `json:"users"`
+}
+
+// Venue is the Venue object, see https://core.telegram.org/bots/api#venue
+//
+// This object represents a venue.
+type Venue struct {
+	// Venue location. Can't be a live location
+	Location Location `json:"location"`
+	// Name of the venue
+	Title string `json:"title"`
+	// Address of the venue
+	Address string `json:"address"`
+	// Foursquare identifier of the venue
+	FoursquareID string `json:"foursquare_id,omitempty"`
+	// Foursquare type of the venue. (For example, “arts_entertainment/default”,
+	// “arts_entertainment/aquarium” or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+	// Google Places type of the venue. (See supported types.)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+}
+
+// Video is the Video object, see https://core.telegram.org/bots/api#video
+//
+// This object represents a video file.
+type Video struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Video width as defined by sender
+	Width int64 `json:"width"`
+	// Video height as defined by sender
+	Height int64 `json:"height"`
+	// Duration of the video in seconds as defined by sender
+	Duration int64 `json:"duration"`
+	// Video thumbnail
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+	// Original filename as defined by sender
+	FileName string `json:"file_name,omitempty"`
+	// MIME type of the file as defined by sender
+	MimeType string `json:"mime_type,omitempty"`
+	// File size in bytes. It can be bigger than 2^31 and some programming languages may have
+	// difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed
+	// 64-bit integer or double-precision float type are safe for storing this value.
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// VideoChatEnded is the VideoChatEnded object, see https://core.telegram.org/bots/api#videochatended
+//
+// This object represents a service message about a video chat ended in the chat.
+type VideoChatEnded struct {
+	// Video chat duration in seconds
+	Duration int64 `json:"duration"`
+}
+
+// VideoChatParticipantsInvited is the VideoChatParticipantsInvited object, see
+// https://core.telegram.org/bots/api#videochatparticipantsinvited
+//
+// This object represents a service message about new members invited to a video chat.
+type VideoChatParticipantsInvited struct {
+	// New members that were invited to the video chat
+	Users []User `json:"users"`
+}
+
+// VideoChatScheduled is the VideoChatScheduled object, see
+// https://core.telegram.org/bots/api#videochatscheduled
+//
+// This object represents a service message about a video chat scheduled in the chat.
+type VideoChatScheduled struct {
+	// Point in time (Unix timestamp) when the video chat is supposed to be started by a chat administrator
+	StartDate int64 `json:"start_date"`
+}
+
+// VideoChatStarted is the VideoChatStarted object, see
+// https://core.telegram.org/bots/api#videochatstarted
+//
+// This object represents a service message about a video chat started in the chat. Currently holds no
+// information.
+type VideoChatStarted struct {
+}
+
+// VideoNote is the VideoNote object, see https://core.telegram.org/bots/api#videonote
+//
+// This object represents a video message (available in Telegram apps as of v.4.0).
+type VideoNote struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Video width and height (diameter of the video message) as defined by sender
+	Length int64 `json:"length"`
+	// Duration of the video in seconds as defined by sender
+	Duration int64 `json:"duration"`
+	// Video thumbnail
+	Thumbnail *PhotoSize `json:"thumbnail,omitempty"`
+	// File size in bytes
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// Voice is the Voice object, see https://core.telegram.org/bots/api#voice
+//
+// This object represents a voice note.
+type Voice struct {
+	// Identifier for this file, which can be used to download or reuse the file
+	FileID string `json:"file_id"`
+	// Unique identifier for this file, which is supposed to be the same over time and for different bots.
+	// Can't be used to download or reuse the file.
+	FileUniqueID string `json:"file_unique_id"`
+	// Duration of the audio in seconds as defined by sender
+	Duration int64 `json:"duration"`
+	// MIME type of the file as defined by sender
+	MimeType string `json:"mime_type,omitempty"`
+	// File size in bytes. It can be bigger than 2^31 and some programming languages may have
+	// difficulty/silent defects in interpreting it. But it has at most 52 significant bits, so a signed
+	// 64-bit integer or double-precision float type are safe for storing this value.
+	FileSize int64 `json:"file_size,omitempty"`
+}
+
+// WebAppData is the WebAppData object, see https://core.telegram.org/bots/api#webappdata
+//
+// Describes data sent from a Web App to the bot.
+type WebAppData struct {
+	// The data. Be aware that a bad client can send arbitrary data in this field.
+	Data string `json:"data"`
+	// Text of the web_app keyboard button from which the Web App was opened. Be aware that a bad client
+	// can send arbitrary data in this field.
+	ButtonText string `json:"button_text"`
+}
+
+// WebAppInfo is the WebAppInfo object, see https://core.telegram.org/bots/api#webappinfo
+//
+// Describes a Web App.
+type WebAppInfo struct {
+	// An HTTPS URL of a Web App to be opened with additional data as specified in Initializing Web Apps
+	URL string `json:"url"`
+}
+
+// WebhookInfo is the WebhookInfo object, see https://core.telegram.org/bots/api#webhookinfo
+//
+// Describes the current status of a webhook.
+type WebhookInfo struct {
+	// Webhook URL, may be empty if webhook is not set up
+	URL string `json:"url"`
+	// True, if a custom certificate was provided for webhook certificate checks
+	HasCustomCertificate bool `json:"has_custom_certificate"`
+	// Number of updates awaiting delivery
+	PendingUpdateCount int64 `json:"pending_update_count"`
+	// Currently used webhook IP address
+	IPAddress string `json:"ip_address,omitempty"`
+	// Unix time for the most recent error that happened when trying to deliver an update via webhook
+	LastErrorDate int64 `json:"last_error_date,omitempty"`
+	// Error message in human-readable format for the most recent error that happened when trying to
+	// deliver an update via webhook
+	LastErrorMessage string `json:"last_error_message,omitempty"`
+	// Unix time of the most recent error that happened when trying to synchronize available updates with
+	// Telegram datacenters
+	LastSynchronizationErrorDate int64 `json:"last_synchronization_error_date,omitempty"`
+	// The maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery
+	MaxConnections int64 `json:"max_connections,omitempty"`
+	// A list of update types the bot is subscribed to. Defaults to all update types except chat_member
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+// WriteAccessAllowed is the WriteAccessAllowed object, see
+// https://core.telegram.org/bots/api#writeaccessallowed
+//
+// This object represents a service message about a user allowing a bot to write messages after adding
+// it to the attachment menu, launching a Web App from a link, or accepting an explicit request from a
+// Web App sent by the method requestWriteAccess.
+type WriteAccessAllowed struct {
+	// True, if the access was granted after the user accepted an explicit request from a Web App sent by
+	// the method requestWriteAccess
+	FromRequest bool `json:"from_request,omitempty"`
+	// Name of the Web App, if the access was granted when the Web App was launched from a link
+	WebAppName string `json:"web_app_name,omitempty"`
+	// True, if the access was granted when the bot was added to the attachment or side menu
+	FromAttachmentMenu bool `json:"from_attachment_menu,omitempty"`
+}
+
+// AddStickerToSetParams are the parameters of addStickerToSet
+type AddStickerToSetParams struct {
+	// User identifier of sticker set owner
+	UserID int64 `json:"user_id"`
+	// Sticker set name
+	Name string `json:"name"`
+	// A JSON-serialized object with information about the added sticker. If exactly the same sticker had
+	// already been added to the set, then the set isn't changed.
+	Sticker InputSticker `json:"sticker"`
+}
+
+// AddStickerToSet calls addStickerToSet, see https://core.telegram.org/bots/api#addstickertoset
+//
+// Use this method to add a new sticker to a set created by the bot. Emoji sticker sets can have up to
+// 200 stickers. Other sticker sets can have up to 120 stickers. Returns True on success.
+func AddStickerToSet(c Client, params AddStickerToSetParams) (bool, error) {
+	return call[bool](c, "addStickerToSet", params)
+}
+
+// AnswerCallbackQueryParams are the parameters of answerCallbackQuery
+type AnswerCallbackQueryParams struct {
+	// Unique identifier for the query to be answered
+	CallbackQueryID string `json:"callback_query_id"`
+	// Text of the notification. If not specified, nothing will be shown to the user, 0-200 characters
+	Text string `json:"text,omitempty"`
+	// If True, an alert will be shown by the client instead of a notification at the top of the chat
+	// screen. Defaults to false.
+	ShowAlert bool `json:"show_alert,omitempty"`
+	// URL that will be opened by the user's client. If you have created a Game and accepted the conditions
+	// via @BotFather, specify the URL that opens your game - note that this will only work if the query
+	// comes from a callback_game button. Otherwise, you may use links like t.me/your_bot?start=XXXX that
+	// open your bot with a parameter.
+	URL string `json:"url,omitempty"`
+	// The maximum amount of time in seconds that the result of the callback query may be cached
+	// client-side. Telegram apps will support caching starting in version 3.14. Defaults to 0.
+	CacheTime int64 `json:"cache_time,omitempty"`
+}
+
+// AnswerCallbackQuery calls answerCallbackQuery, see
+// https://core.telegram.org/bots/api#answercallbackquery
+//
+// Use this method to send answers to callback queries sent from inline keyboards. The answer will be
+// displayed to the user as a notification at the top of the chat screen or as an alert. On success,
+// True is returned.
+func AnswerCallbackQuery(c Client, params AnswerCallbackQueryParams) (bool, error) {
+	return call[bool](c, "answerCallbackQuery", params)
+}
+
+// AnswerInlineQueryParams are the parameters of answerInlineQuery
+type AnswerInlineQueryParams struct {
+	// Unique identifier for the answered query
+	InlineQueryID string `json:"inline_query_id"`
+	// A JSON-serialized array of results for the inline query
+	Results []InlineQueryResult `json:"results"`
+	// The maximum amount of time in seconds that the result of the inline query may be cached on the
+	// server. Defaults to 300.
+	CacheTime int64 `json:"cache_time,omitempty"`
+	// Pass True if results may be cached on the server side only for the user that sent the query. By
+	// default, results may be returned to any user who sends the same query.
+	IsPersonal bool `json:"is_personal,omitempty"`
+	// Pass the offset that a client should send in the next query with the same text to receive more
+	// results. Pass an empty string if there are no more results or if you don't support pagination.
+	// Offset length can't exceed 64 bytes.
+	NextOffset string `json:"next_offset,omitempty"`
+	// A JSON-serialized object describing a button to be shown above inline query results
+	Button *InlineQueryResultsButton `json:"button,omitempty"`
+}
+
+// AnswerInlineQuery calls answerInlineQuery, see https://core.telegram.org/bots/api#answerinlinequery
+//
+// Use this method to send answers to an inline query. On success, True is returned.
+//
+// No more than 50 results per query are allowed.
+func AnswerInlineQuery(c Client, params AnswerInlineQueryParams) (bool, error) {
+	return call[bool](c, "answerInlineQuery", params)
+}
+
+// AnswerPreCheckoutQueryParams are the parameters of answerPreCheckoutQuery
+type AnswerPreCheckoutQueryParams struct {
+	// Unique identifier for the query to be answered
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	// Specify True if everything is alright (goods are available, etc.) and the bot is ready to proceed
+	// with the order. Use False if there are any problems.
+	Ok bool `json:"ok"`
+	// Required if ok is False. Error message in human readable form that explains the reason for failure
+	// to proceed with the checkout (e.g. "Sorry, somebody just bought the last of our amazing black
+	// T-shirts while you were busy filling out your payment details. Please choose a different color or
+	// garment!"). Telegram will display this message to the user.
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// AnswerPreCheckoutQuery calls answerPreCheckoutQuery, see
+// https://core.telegram.org/bots/api#answerprecheckoutquery
+//
+// Once the user has confirmed their payment and shipping details, the Bot API sends the final
+// confirmation in the form of an Update with the field pre_checkout_query. Use this method to respond
+// to such pre-checkout queries. On success, True is returned. Note: The Bot API must receive an answer
+// within 10 seconds after the pre-checkout query was sent.
+func AnswerPreCheckoutQuery(c Client, params AnswerPreCheckoutQueryParams) (bool, error) {
+	return call[bool](c, "answerPreCheckoutQuery", params)
+}
+
+// AnswerShippingQueryParams are the parameters of answerShippingQuery
+type AnswerShippingQueryParams struct {
+	// Unique identifier for the query to be answered
+	ShippingQueryID string `json:"shipping_query_id"`
+	// Pass True if delivery to the specified address is possible and False if there are any problems (for
+	// example, if delivery to the specified address is not possible)
+	Ok bool `json:"ok"`
+	// Required if ok is True. A JSON-serialized array of available shipping options.
+	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"`
+	// Required if ok is False. Error message in human readable form that explains why it is impossible to
+	// complete the order (e.g. "Sorry, delivery to your desired address is unavailable'). Telegram will
+	// display this message to the user.
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// AnswerShippingQuery calls answerShippingQuery, see
+// https://core.telegram.org/bots/api#answershippingquery
+//
+// If you sent an invoice requesting a shipping address and the parameter is_flexible was specified,
+// the Bot API will send an Update with a shipping_query field to the bot. Use this method to reply to
+// shipping queries. On success, True is returned.
+func AnswerShippingQuery(c Client, params AnswerShippingQueryParams) (bool, error) {
+	return call[bool](c, "answerShippingQuery", params)
+}
+
+// AnswerWebAppQueryParams are the parameters of answerWebAppQuery
+type AnswerWebAppQueryParams struct {
+	// Unique identifier for the query to be answered
+	WebAppQueryID string `json:"web_app_query_id"`
+	// A JSON-serialized object describing the message to be sent
+	Result InlineQueryResult `json:"result"`
+}
+
+// AnswerWebAppQuery calls answerWebAppQuery, see https://core.telegram.org/bots/api#answerwebappquery
+//
+// Use this method to set the result of an interaction with a Web App and send a corresponding message
+// on behalf of the user to the chat from which the query originated. On success, a SentWebAppMessage
+// object is returned.
+func AnswerWebAppQuery(c Client, params AnswerWebAppQueryParams) (*SentWebAppMessage, error) {
+	return call[*SentWebAppMessage](c, "answerWebAppQuery", params)
+}
+
+// ApproveChatJoinRequestParams are the parameters of approveChatJoinRequest
+type ApproveChatJoinRequestParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+// ApproveChatJoinRequest calls approveChatJoinRequest, see
+// https://core.telegram.org/bots/api#approvechatjoinrequest
+//
+// Use this method to approve a chat join request. The bot must be an administrator in the chat for
+// this to work and must have the can_invite_users administrator right. Returns True on success.
+func ApproveChatJoinRequest(c Client, params ApproveChatJoinRequestParams) (bool, error) {
+	return call[bool](c, "approveChatJoinRequest", params)
+}
+
+// BanChatMemberParams are the parameters of banChatMember
+type BanChatMemberParams struct {
+	// Unique identifier for the target group or username of the target supergroup or channel (in the
+	// format @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+	// Date when the user will be unbanned; Unix time. If user is banned for more than 366 days or less
+	// than 30 seconds from the current time they are considered to be banned forever. Applied for
+	// supergroups and channels only.
+	UntilDate int64 `json:"until_date,omitempty"`
+	// Pass True to delete all messages from the chat for the user that is being removed. If False, the
+	// user will be able to see messages in the group that were sent before the user was removed. Always
+	// True for supergroups and channels.
+	RevokeMessages bool `json:"revoke_messages,omitempty"`
+}
+
+// BanChatMember calls banChatMember, see https://core.telegram.org/bots/api#banchatmember
+//
+// Use this method to ban a user in a group, a supergroup or a channel. In the case of supergroups and
+// channels, the user will not be able to return to the chat on their own using invite links, etc.,
+// unless unbanned first. The bot must be an administrator in the chat for this to work and must have
+// the appropriate administrator rights. Returns True on success.
+func BanChatMember(c Client, params BanChatMemberParams) (bool, error) {
+	return call[bool](c, "banChatMember", params)
+}
+
+// BanChatSenderChatParams are the parameters of banChatSenderChat
+type BanChatSenderChatParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target sender chat
+	SenderChatID int64 `json:"sender_chat_id"`
+}
+
+// BanChatSenderChat calls banChatSenderChat, see https://core.telegram.org/bots/api#banchatsenderchat
+//
+// Use this method to ban a channel chat in a supergroup or a channel. Until the chat is unbanned, the
+// owner of the banned chat won't be able to send messages on behalf of any of their channels. The bot
+// must be an administrator in the supergroup or channel for this to work and must have the appropriate
+// administrator rights. Returns True on success.
+func BanChatSenderChat(c Client, params BanChatSenderChatParams) (bool, error) {
+	return call[bool](c, "banChatSenderChat", params)
+}
+
+// Close calls close, see https://core.telegram.org/bots/api#close
+//
+// Use this method to close the bot instance before moving it from one local server to another. You
+// need to delete the webhook before calling this method to ensure that the bot isn't launched again
+// after server restart. The method will return error 429 in the first 10 minutes after the bot is
+// launched. Returns True on success. Requires no parameters.
+func Close(c Client) (bool, error) {
+	return call[bool](c, "close", nil)
+}
+
+// CloseForumTopicParams are the parameters of closeForumTopic
+type CloseForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadID int64 `json:"message_thread_id"`
+}
+
+// CloseForumTopic calls closeForumTopic, see https://core.telegram.org/bots/api#closeforumtopic
+//
+// Use this method to close an open topic in a forum supergroup chat. The bot must be an administrator
+// in the chat for this to work and must have the can_manage_topics administrator rights, unless it is
+// the creator of the topic. Returns True on success.
+func CloseForumTopic(c Client, params CloseForumTopicParams) (bool, error) {
+	return call[bool](c, "closeForumTopic", params)
+}
+
+// CloseGeneralForumTopicParams are the parameters of closeGeneralForumTopic
+type CloseGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+}
+
+// CloseGeneralForumTopic calls closeGeneralForumTopic, see
+// https://core.telegram.org/bots/api#closegeneralforumtopic
+//
+// Use this method to close an open 'General' topic in a forum supergroup chat. The bot must be an
+// administrator in the chat for this to work and must have the can_manage_topics administrator rights.
+// Returns True on success.
+func CloseGeneralForumTopic(c Client, params CloseGeneralForumTopicParams) (bool, error) {
+	return call[bool](c, "closeGeneralForumTopic", params)
+}
+
+// CopyMessageParams are the parameters of copyMessage
+type CopyMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original message was sent (or channel username in the
+	// format @channelusername)
+	FromChatID string `json:"from_chat_id"`
+	// Message identifier in the chat specified in from_chat_id
+	MessageID int64 `json:"message_id"`
+	// New caption for media, 0-1024 characters after entities parsing. If not specified, the original
+	// caption is kept
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the new caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the new caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user.
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// CopyMessage calls copyMessage, see https://core.telegram.org/bots/api#copymessage
+//
+// Use this method to copy messages of any kind. Service messages, giveaway messages, giveaway winners
+// messages, and invoice messages can't be copied. A quiz poll can be copied only if the value of the
+// field correct_option_id is known to the bot. The method is analogous to the method forwardMessage,
+// but the copied message doesn't have a link to the original message. Returns the MessageId of the
+// sent message on success.
+func CopyMessage(c Client, params CopyMessageParams) (*MessageId, error) {
+	return call[*MessageId](c, "copyMessage", params)
+}
+
+// CopyMessagesParams are the parameters of copyMessages
+type CopyMessagesParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original messages were sent (or channel username in the
+	// format @channelusername)
+	FromChatID string `json:"from_chat_id"`
+	// A JSON-serialized list of 1-100 identifiers of messages in the chat from_chat_id to copy. The
+	// identifiers must be specified in a strictly increasing order.
+	MessageIds []int64 `json:"message_ids"`
+	// Sends the messages silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent messages from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Pass True to copy the messages without their captions
+	RemoveCaption bool `json:"remove_caption,omitempty"`
+}
+
+// CopyMessages calls copyMessages, see https://core.telegram.org/bots/api#copymessages
+//
+// Use this method to copy messages of any kind. If some of the specified messages can't be found or
+// copied, they are skipped. Service messages, giveaway messages, giveaway winners messages, and
+// invoice messages can't be copied. A quiz poll can be copied only if the value of the field
+// correct_option_id is known to the bot. The method is analogous to the method forwardMessages, but
+// the copied messages don't have a link to the original message. Album grouping is kept for copied
+// messages. On success, an array of MessageId of the sent messages is returned.
+func CopyMessages(c Client, params CopyMessagesParams) ([]MessageId, error) {
+	return call[[]MessageId](c, "copyMessages", params)
+}
+
+// CreateChatInviteLinkParams are the parameters of createChatInviteLink
+type CreateChatInviteLinkParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+	// Point in time (Unix timestamp) when the link will expire
+	ExpireDate int64 `json:"expire_date,omitempty"`
+	// The maximum number of users that can be members of the chat simultaneously after joining the chat
+	// via this invite link; 1-99999
+	MemberLimit int64 `json:"member_limit,omitempty"`
+	// True, if users joining the chat via the link need to be approved by chat administrators. If True,
+	// member_limit can't be specified
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+// CreateChatInviteLink calls createChatInviteLink, see
+// https://core.telegram.org/bots/api#createchatinvitelink
+//
+// Use this method to create an additional invite link for a chat. The bot must be an administrator in
+// the chat for this to work and must have the appropriate administrator rights. The link can be
+// revoked using the method revokeChatInviteLink. Returns the new invite link as ChatInviteLink object.
+func CreateChatInviteLink(c Client, params CreateChatInviteLinkParams) (*ChatInviteLink, error) {
+	return call[*ChatInviteLink](c, "createChatInviteLink", params)
+}
+
+// CreateForumTopicParams are the parameters of createForumTopic
+type CreateForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Topic name, 1-128 characters
+	Name string `json:"name"`
+	// Color of the topic icon in RGB format. Currently, must be one of 7322096 (0x6FB9F0), 16766590
+	// (0xFFD67E), 13338331 (0xCB86DB), 9367192 (0x8EEE98), 16749490 (0xFF93B2), or 16478047 (0xFB6F5F)
+	IconColor int64 `json:"icon_color,omitempty"`
+	// Unique identifier of the custom emoji shown as the topic icon. Use getForumTopicIconStickers to get
+	// all allowed custom emoji identifiers.
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// CreateForumTopic calls createForumTopic, see https://core.telegram.org/bots/api#createforumtopic
+//
+// Use this method to create a topic in a forum supergroup chat. The bot must be an administrator in
+// the chat for this to work and must have the can_manage_topics administrator rights. Returns
+// information about the created topic as a ForumTopic object.
+func CreateForumTopic(c Client, params CreateForumTopicParams) (*ForumTopic, error) {
+	return call[*ForumTopic](c, "createForumTopic", params)
+}
+
+// CreateInvoiceLinkParams are the parameters of createInvoiceLink
+type CreateInvoiceLinkParams struct {
+	// Product name, 1-32 characters
+	Title string `json:"title"`
+	// Product description, 1-255 characters
+	Description string `json:"description"`
+	// Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your
+	// internal processes.
+	Payload string `json:"payload"`
+	// Payment provider token, obtained via BotFather
+	ProviderToken string `json:"provider_token"`
+	// Three-letter ISO 4217 currency code, see more on currencies
+	Currency string `json:"currency"`
+	// Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery
+	// cost, delivery tax, bonus, etc.)
+	Prices []LabeledPrice `json:"prices"`
+	// The maximum accepted amount for tips in the smallest units of the currency (integer, not
+	// float/double). For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145. See the exp
+	// parameter in currencies.json, it shows the number of digits past the decimal point for each currency
+	// (2 for the majority of currencies). Defaults to 0
+	MaxTipAmount int64 `json:"max_tip_amount,omitempty"`
+	// A JSON-serialized array of suggested amounts of tips in the smallest units of the currency (integer,
+	// not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must
+	// be positive, passed in a strictly increased order and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+	// JSON-serialized data about the invoice, which will be shared with the payment provider. A detailed
+	// description of required fields should be provided by the payment provider.
+	ProviderData string `json:"provider_data,omitempty"`
+	// URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a
+	// service.
+	PhotoURL string `json:"photo_url,omitempty"`
+	// Photo size in bytes
+	PhotoSize int64 `json:"photo_size,omitempty"`
+	// Photo width
+	PhotoWidth int64 `json:"photo_width,omitempty"`
+	// Photo height
+	PhotoHeight int64 `json:"photo_height,omitempty"`
+	// Pass True if you require the user's full name to complete the order
+	NeedName bool `json:"need_name,omitempty"`
+	// Pass True if you require the user's phone number to complete the order
+	NeedPhoneNumber bool `json:"need_phone_number,omitempty"`
+	// Pass True if you require the user's email address to complete the order
+	NeedEmail bool `json:"need_email,omitempty"`
+	// Pass True if you require the user's shipping address to complete the order
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+	// Pass True if the user's phone number should be sent to the provider
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider,omitempty"`
+	// Pass True if the user's email address should be sent to the provider
+	SendEmailToProvider bool `json:"send_email_to_provider,omitempty"`
+	// Pass True if the final price depends on the shipping method
+	IsFlexible bool `json:"is_flexible,omitempty"`
+}
+
+// CreateInvoiceLink calls createInvoiceLink, see https://core.telegram.org/bots/api#createinvoicelink
+//
+// Use this method to create a link for an invoice. Returns the created invoice link as String on
+// success.
+func CreateInvoiceLink(c Client, params CreateInvoiceLinkParams) (string, error) {
+	return call[string](c, "createInvoiceLink", params)
+}
+
+// CreateNewStickerSetParams are the parameters of createNewStickerSet
+type CreateNewStickerSetParams struct {
+	// User identifier of created sticker set owner
+	UserID int64 `json:"user_id"`
+	// Short name of sticker set, to be used in t.me/addstickers/ URLs (e.g., animals). Can contain only
+	// English letters, digits and underscores. Must begin with a letter, can't contain consecutive
+	// underscores and must end in "_by_<bot_username>". <bot_username> is case insensitive. 1-64
+	// characters.
+	Name string `json:"name"`
+	// Sticker set title, 1-64 characters
+	Title string `json:"title"`
+	// A JSON-serialized list of 1-50 initial stickers to be added to the sticker set
+	Stickers []InputSticker `json:"stickers"`
+	// Type of stickers in the set, pass “regular”, “mask”, or “custom_emoji”. By default, a
+	// regular sticker set is created.
+	StickerType string `json:"sticker_type,omitempty"`
+	// Pass True if stickers in the sticker set must be repainted to the color of text when used in
+	// messages, the accent color if used as emoji status, white on chat photos, or another appropriate
+	// color based on context; for custom emoji sticker sets only
+	NeedsRepainting bool `json:"needs_repainting,omitempty"`
+}
+
+// CreateNewStickerSet calls createNewStickerSet, see
+// https://core.telegram.org/bots/api#createnewstickerset
+//
+// Use this method to create a new sticker set owned by a user. The bot will be able to edit the
+// sticker set thus created. Returns True on success.
+func CreateNewStickerSet(c Client, params CreateNewStickerSetParams) (bool, error) {
+	return call[bool](c, "createNewStickerSet", params)
+}
+
+// DeclineChatJoinRequestParams are the parameters of declineChatJoinRequest
+type DeclineChatJoinRequestParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+// DeclineChatJoinRequest calls declineChatJoinRequest, see
+// https://core.telegram.org/bots/api#declinechatjoinrequest
+//
+// Use this method to decline a chat join request. The bot must be an administrator in the chat for
+// this to work and must have the can_invite_users administrator right. Returns True on success.
+func DeclineChatJoinRequest(c Client, params DeclineChatJoinRequestParams) (bool, error) {
+	return call[bool](c, "declineChatJoinRequest", params)
+}
+
+// DeleteChatPhotoParams are the parameters of deleteChatPhoto
+type DeleteChatPhotoParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+}
+
+// DeleteChatPhoto calls deleteChatPhoto, see https://core.telegram.org/bots/api#deletechatphoto
+//
+// Use this method to delete a chat photo. Photos can't be changed for private chats. The bot must be
+// an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func DeleteChatPhoto(c Client, params DeleteChatPhotoParams) (bool, error) {
+	return call[bool](c, "deleteChatPhoto", params)
+}
+
+// DeleteChatStickerSetParams are the parameters of deleteChatStickerSet
+type DeleteChatStickerSetParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+}
+
+// DeleteChatStickerSet calls deleteChatStickerSet, see
+// https://core.telegram.org/bots/api#deletechatstickerset
+//
+// Use this method to delete a group sticker set from a supergroup. The bot must be an administrator in
+// the chat for this to work and must have the appropriate administrator rights. Use the field
+// can_set_sticker_set optionally returned in getChat requests to check if the bot can use this method.
+// Returns True on success.
+func DeleteChatStickerSet(c Client, params DeleteChatStickerSetParams) (bool, error) {
+	return call[bool](c, "deleteChatStickerSet", params)
+}
+
+// DeleteForumTopicParams are the parameters of deleteForumTopic
+type DeleteForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadID int64 `json:"message_thread_id"`
+}
+
+// DeleteForumTopic calls deleteForumTopic, see https://core.telegram.org/bots/api#deleteforumtopic
+//
+// Use this method to delete a forum topic along with all its messages in a forum supergroup chat. The
+// bot must be an administrator in the chat for this to work and must have the can_delete_messages
+// administrator rights. Returns True on success.
+func DeleteForumTopic(c Client, params DeleteForumTopicParams) (bool, error) {
+	return call[bool](c, "deleteForumTopic", params)
+}
+
+// DeleteMessageParams are the parameters of deleteMessage
+type DeleteMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Identifier of the message to delete
+	MessageID int64 `json:"message_id"`
+}
+
+// DeleteMessage calls deleteMessage, see https://core.telegram.org/bots/api#deletemessage
+//
+// Use this method to delete a message, including service messages, with the following limitations: a
+// message can only be deleted if it was sent less than 48 hours ago; service messages about a
+// supergroup, channel, or forum topic creation can't be deleted; a dice message in a private chat can
+// only be deleted if it was sent more than 24 hours ago; bots can delete outgoing messages in private
+// chats, groups, and supergroups; bots can delete incoming messages in private chats; bots granted
+// can_post_messages permissions can delete outgoing messages in channels; if the bot is an
+// administrator of a group, it can delete any message there; if the bot has can_delete_messages
+// permission in a supergroup or a channel, it can delete any message there. Returns True on success.
+func DeleteMessage(c Client, params DeleteMessageParams) (bool, error) {
+	return call[bool](c, "deleteMessage", params)
+}
+
+// DeleteMessagesParams are the parameters of deleteMessages
+type DeleteMessagesParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// A JSON-serialized list of 1-100 identifiers of messages to delete. See deleteMessage for limitations
+	// on which messages can be deleted
+	MessageIds []int64 `json:"message_ids"`
+}
+
+// DeleteMessages calls deleteMessages, see https://core.telegram.org/bots/api#deletemessages
+//
+// Use this method to delete multiple messages simultaneously. If some of the specified messages can't
+// be found, they are skipped. Returns True on success.
+func DeleteMessages(c Client, params DeleteMessagesParams) (bool, error) {
+	return call[bool](c, "deleteMessages", params)
+}
+
+// DeleteMyCommandsParams are the parameters of deleteMyCommands
+type DeleteMyCommandsParams struct {
+	// A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to
+	// BotCommandScopeDefault.
+	Scope *BotCommandScope `json:"scope,omitempty"`
+	// A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given
+	// scope, for whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// DeleteMyCommands calls deleteMyCommands, see https://core.telegram.org/bots/api#deletemycommands
+//
+// Use this method to delete the list of the bot's commands for the given scope and user language.
+// After deletion, higher level commands will be shown to affected users. Returns True on success.
+func DeleteMyCommands(c Client, params DeleteMyCommandsParams) (bool, error) {
+	return call[bool](c, "deleteMyCommands", params)
+}
+
+// DeleteStickerFromSetParams are the parameters of deleteStickerFromSet
+type DeleteStickerFromSetParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+}
+
+// DeleteStickerFromSet calls deleteStickerFromSet, see
+// https://core.telegram.org/bots/api#deletestickerfromset
+//
+// Use this method to delete a sticker from a set created by the bot. Returns True on success.
+func DeleteStickerFromSet(c Client, params DeleteStickerFromSetParams) (bool, error) {
+	return call[bool](c, "deleteStickerFromSet", params)
+}
+
+// DeleteStickerSetParams are the parameters of deleteStickerSet
+type DeleteStickerSetParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+}
+
+// DeleteStickerSet calls deleteStickerSet, see https://core.telegram.org/bots/api#deletestickerset
+//
+// Use this method to delete a sticker set that was created by the bot. Returns True on success.
+func DeleteStickerSet(c Client, params DeleteStickerSetParams) (bool, error) {
+	return call[bool](c, "deleteStickerSet", params)
+}
+
+// DeleteWebhookParams are the parameters of deleteWebhook
+type DeleteWebhookParams struct {
+	// Pass True to drop all pending updates
+	DropPendingUpdates bool `json:"drop_pending_updates,omitempty"`
+}
+
+// DeleteWebhook calls deleteWebhook, see https://core.telegram.org/bots/api#deletewebhook
+//
+// Use this method to remove webhook integration if you decide to switch back to getUpdates. Returns
+// True on success.
+func DeleteWebhook(c Client, params DeleteWebhookParams) (bool, error) {
+	return call[bool](c, "deleteWebhook", params)
+}
+
+// EditChatInviteLinkParams are the parameters of editChatInviteLink
+type EditChatInviteLinkParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// The invite link to edit
+	InviteLink string `json:"invite_link"`
+	// Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+	// Point in time (Unix timestamp) when the link will expire
+	ExpireDate int64 `json:"expire_date,omitempty"`
+	// The maximum number of users that can be members of the chat simultaneously after joining the chat
+	// via this invite link; 1-99999
+	MemberLimit int64 `json:"member_limit,omitempty"`
+	// True, if users joining the chat via the link need to be approved by chat administrators. If True,
+	// member_limit can't be specified
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+// EditChatInviteLink calls editChatInviteLink, see
+// https://core.telegram.org/bots/api#editchatinvitelink
+//
+// Use this method to edit a non-primary invite link created by the bot. The bot must be an
+// administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns the edited invite link as a ChatInviteLink object.
+func EditChatInviteLink(c Client, params EditChatInviteLinkParams) (*ChatInviteLink, error) {
+	return call[*ChatInviteLink](c, "editChatInviteLink", params)
+}
+
+// EditForumTopicParams are the parameters of editForumTopic
+type EditForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadID int64 `json:"message_thread_id"`
+	// New topic name, 0-128 characters. If not specified or empty, the current name of the topic will be
+	// kept
+	Name string `json:"name,omitempty"`
+	// New unique identifier of the custom emoji shown as the topic icon. Use getForumTopicIconStickers to
+	// get all allowed custom emoji identifiers. Pass an empty string to remove the icon. If not specified,
+	// the current icon will be kept
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// EditForumTopic calls editForumTopic, see https://core.telegram.org/bots/api#editforumtopic
+//
+// Use this method to edit name and icon of a topic in a forum supergroup chat. The bot must be an
+// administrator in the chat for this to work and must have can_manage_topics administrator rights,
+// unless it is the creator of the topic. Returns True on success.
+func EditForumTopic(c Client, params EditForumTopicParams) (bool, error) {
+	return call[bool](c, "editForumTopic", params)
+}
+
+// EditGeneralForumTopicParams are the parameters of editGeneralForumTopic
+type EditGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// New topic name, 1-128 characters
+	Name string `json:"name"`
+}
+
+// EditGeneralForumTopic calls editGeneralForumTopic, see
+// https://core.telegram.org/bots/api#editgeneralforumtopic
+//
+// Use this method to edit the name of the 'General' topic in a forum supergroup chat. The bot must be
+// an administrator in the chat for this to work and must have can_manage_topics administrator rights.
+// Returns True on success.
+func EditGeneralForumTopic(c Client, params EditGeneralForumTopicParams) (bool, error) {
+	return call[bool](c, "editGeneralForumTopic", params)
+}
+
+// EditMessageCaptionParams are the parameters of editMessageCaption
+type EditMessageCaptionParams struct {
+	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of
+	// the target channel (in the format @channelusername)
+	ChatID string `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// New caption of the message, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the message caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageCaption calls editMessageCaption, see
+// https://core.telegram.org/bots/api#editmessagecaption
+//
+// Use this method to edit captions of messages. On success, if the edited message is not an inline
+// message, the edited Message is returned, otherwise True is returned.
+func EditMessageCaption(c Client, params EditMessageCaptionParams) (*Message, error) {
+	return callOrTrue[Message](c, "editMessageCaption", params)
+}
+
+// EditMessageLiveLocationParams are the parameters of editMessageLiveLocation
+type EditMessageLiveLocationParams struct {
+	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of
+	// the target channel (in the format @channelusername)
+	ChatID string `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// Latitude of new location
+	Latitude float64 `json:"latitude"`
+	// Longitude of new location
+	Longitude float64 `json:"longitude"`
+	// The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	// Direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	Heading int64 `json:"heading,omitempty"`
+	// The maximum distance for proximity alerts about approaching another chat member, in meters. Must be
+	// between 1 and 100000 if specified.
+	ProximityAlertRadius int64 `json:"proximity_alert_radius,omitempty"`
+	// A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageLiveLocation calls editMessageLiveLocation, see
+// https://core.telegram.org/bots/api#editmessagelivelocation
+//
+// Use this method to edit live location messages. A location can be edited until its live_period
+// expires or editing is explicitly disabled by a call to stopMessageLiveLocation. On success, if the
+// edited message is not an inline message, the edited Message is returned, otherwise True is returned.
+func EditMessageLiveLocation(c Client, params EditMessageLiveLocationParams) (*Message, error) {
+	return callOrTrue[Message](c, "editMessageLiveLocation", params)
+}
+
+// EditMessageMediaParams are the parameters of editMessageMedia
+type EditMessageMediaParams struct {
+	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of
+	// the target channel (in the format @channelusername)
+	ChatID string `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// A JSON-serialized object for a new media content of the message
+	Media InputMedia `json:"media"`
+	// A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageMedia calls editMessageMedia, see https://core.telegram.org/bots/api#editmessagemedia
+//
+// Use this method to edit animation, audio, document, photo, or video messages. If a message is part
+// of a message album, then it can be edited only to an audio for audio albums, only to a document for
+// document albums and to a photo or a video otherwise. When an inline message is edited, a new file
+// can't be uploaded; use a previously uploaded file via its file_id or specify a URL. On success, if
+// the edited message is not an inline message, the edited Message is returned, otherwise True is
+// returned.
+func EditMessageMedia(c Client, params EditMessageMediaParams) (*Message, error) {
+	return callOrTrue[Message](c, "editMessageMedia", params)
+}
+
+// EditMessageReplyMarkupParams are the parameters of editMessageReplyMarkup
+type EditMessageReplyMarkupParams struct {
+	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of
+	// the target channel (in the format @channelusername)
+	ChatID string `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageReplyMarkup calls editMessageReplyMarkup, see
+// https://core.telegram.org/bots/api#editmessagereplymarkup
+//
+// Use this method to edit only the reply markup of messages. On success, if the edited message is not
+// an inline message, the edited Message is returned, otherwise True is returned.
+func EditMessageReplyMarkup(c Client, params EditMessageReplyMarkupParams) (*Message, error) {
+	return callOrTrue[Message](c, "editMessageReplyMarkup", params)
+}
+
+// EditMessageTextParams are the parameters of editMessageText
+type EditMessageTextParams struct {
+	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of
+	// the target channel (in the format @channelusername)
+	ChatID string `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the message to edit
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// New text of the message, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+	// Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in message text, which can be specified
+	// instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// EditMessageText calls editMessageText, see https://core.telegram.org/bots/api#editmessagetext
+//
+// Use this method to edit text and game messages. On success, if the edited message is not an inline
+// message, the edited Message is returned, otherwise True is returned.
+func EditMessageText(c Client, params EditMessageTextParams) (*Message, error) {
+	return callOrTrue[Message](c, "editMessageText", params)
+}
+
+// ExportChatInviteLinkParams are the parameters of exportChatInviteLink
+type ExportChatInviteLinkParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+}
+
+// ExportChatInviteLink calls exportChatInviteLink, see
+// https://core.telegram.org/bots/api#exportchatinvitelink
+//
+// Use this method to generate a new primary invite link for a chat; any previously generated primary
+// link is revoked. The bot must be an administrator in the chat for this to work and must have the
+// appropriate administrator rights. Returns the new invite link as String on success.
+func ExportChatInviteLink(c Client, params ExportChatInviteLinkParams) (string, error) {
+	return call[string](c, "exportChatInviteLink", params)
+}
+
+// ForwardMessageParams are the parameters of forwardMessage
+type ForwardMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original message was sent (or channel username in the
+	// format @channelusername)
+	FromChatID string `json:"from_chat_id"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the forwarded message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Message identifier in the chat specified in from_chat_id
+	MessageID int64 `json:"message_id"`
+}
+
+// ForwardMessage calls forwardMessage, see https://core.telegram.org/bots/api#forwardmessage
+//
+// Use this method to forward messages of any kind. Service messages and messages with protected
+// content can't be forwarded. On success, the sent Message is returned.
+func ForwardMessage(c Client, params ForwardMessageParams) (*Message, error) {
+	return call[*Message](c, "forwardMessage", params)
+}
+
+// ForwardMessagesParams are the parameters of forwardMessages
+type ForwardMessagesParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Unique identifier for the chat where the original messages were sent (or channel username in the
+	// format @channelusername)
+	FromChatID string `json:"from_chat_id"`
+	// A JSON-serialized list of 1-100 identifiers of messages in the chat from_chat_id to forward. The
+	// identifiers must be specified in a strictly increasing order.
+	MessageIds []int64 `json:"message_ids"`
+	// Sends the messages silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the forwarded messages from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+}
+
+// ForwardMessages calls forwardMessages, see https://core.telegram.org/bots/api#forwardmessages
+//
+// Use this method to forward multiple messages of any kind. If some of the specified messages can't be
+// found or forwarded, they are skipped. Service messages and messages with protected content can't be
+// forwarded. Album grouping is kept for forwarded messages. On success, an array of MessageId of the
+// sent messages is returned.
+func ForwardMessages(c Client, params ForwardMessagesParams) ([]MessageId, error) {
+	return call[[]MessageId](c, "forwardMessages", params)
+}
+
+// GetBusinessConnectionParams are the parameters of getBusinessConnection
+type GetBusinessConnectionParams struct {
+	// Unique identifier of the business connection
+	BusinessConnectionID string `json:"business_connection_id"`
+}
+
+// GetBusinessConnection calls getBusinessConnection, see
+// https://core.telegram.org/bots/api#getbusinessconnection
+//
+// Use this method to get information about the connection of the bot with a business account. Returns
+// a BusinessConnection object on success.
+func GetBusinessConnection(c Client, params GetBusinessConnectionParams) (*BusinessConnection, error) {
+	return call[*BusinessConnection](c, "getBusinessConnection", params)
+}
+
+// GetChatParams are the parameters of getChat
+type GetChatParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+}
+
+// GetChat calls getChat, see https://core.telegram.org/bots/api#getchat
+//
+// Use this method to get up to date information about the chat. Returns a Chat object on success.
+func GetChat(c Client, params GetChatParams) (*Chat, error) {
+	return call[*Chat](c, "getChat", params)
+}
+
+// GetChatAdministratorsParams are the parameters of getChatAdministrators
+type GetChatAdministratorsParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+}
+
+// GetChatAdministrators calls getChatAdministrators, see
+// https://core.telegram.org/bots/api#getchatadministrators
+//
+// Use this method to get a list of administrators in a chat, which aren't bots. Returns an Array of
+// ChatMember objects.
+func GetChatAdministrators(c Client, params GetChatAdministratorsParams) ([]ChatMember, error) {
+	return call[[]ChatMember](c, "getChatAdministrators", params)
+}
+
+// GetChatMemberParams are the parameters of getChatMember
+type GetChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+// GetChatMember calls getChatMember, see https://core.telegram.org/bots/api#getchatmember
+//
+// Use this method to get information about a member of a chat. The method is only guaranteed to work
+// for other users if the bot is an administrator in the chat. Returns a ChatMember object on success.
+func GetChatMember(c Client, params GetChatMemberParams) (*ChatMember, error) {
+	return call[*ChatMember](c, "getChatMember", params)
+}
+
+// GetChatMemberCountParams are the parameters of getChatMemberCount
+type GetChatMemberCountParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+}
+
+// GetChatMemberCount calls getChatMemberCount, see
+// https://core.telegram.org/bots/api#getchatmembercount
+//
+// Use this method to get the number of members in a chat. Returns Int on success.
+func GetChatMemberCount(c Client, params GetChatMemberCountParams) (int64, error) {
+	return call[int64](c, "getChatMemberCount", params)
+}
+
+// GetChatMenuButtonParams are the parameters of getChatMenuButton
+type GetChatMenuButtonParams struct {
+	// Unique identifier for the target private chat. If not specified, default bot's menu button will be
+	// returned
+	ChatID int64 `json:"chat_id,omitempty"`
+}
+
+// GetChatMenuButton calls getChatMenuButton, see https://core.telegram.org/bots/api#getchatmenubutton
+//
+// Use this method to get the current value of the bot's menu button in a private chat, or the default
+// menu button. Returns MenuButton on success.
+func GetChatMenuButton(c Client, params GetChatMenuButtonParams) (*MenuButton, error) {
+	return call[*MenuButton](c, "getChatMenuButton", params)
+}
+
+// GetCustomEmojiStickersParams are the parameters of getCustomEmojiStickers
+type GetCustomEmojiStickersParams struct {
+	// A JSON-serialized list of custom emoji identifiers. At most 200 custom emoji identifiers can be
+	// specified.
+	CustomEmojiIds []string `json:"custom_emoji_ids"`
+}
+
+// GetCustomEmojiStickers calls getCustomEmojiStickers, see
+// https://core.telegram.org/bots/api#getcustomemojistickers
+//
+// Use this method to get information about custom emoji stickers by their identifiers. Returns an
+// Array of Sticker objects.
+func GetCustomEmojiStickers(c Client, params GetCustomEmojiStickersParams) ([]Sticker, error) {
+	return call[[]Sticker](c, "getCustomEmojiStickers", params)
+}
+
+// GetFileParams are the parameters of getFile
+type GetFileParams struct {
+	// File identifier to get information about
+	FileID string `json:"file_id"`
+}
+
+// GetFile calls getFile, see https://core.telegram.org/bots/api#getfile
+//
+// Use this method to get basic information about a file and prepare it for downloading. For the
+// moment, bots can download files of up to 20MB in size. On success, a File object is returned. The
+// file can then be downloaded via the link https://api.telegram.org/file/bot<token>/<file_path>, where
+// <file_path> is taken from the response. It is guaranteed that the link will be valid for at least 1
+// hour. When the link expires, a new one can be requested by calling getFile again.
+func GetFile(c Client, params GetFileParams) (*File, error) {
+	return call[*File](c, "getFile", params)
+}
+
+// GetForumTopicIconStickers calls getForumTopicIconStickers, see
+// https://core.telegram.org/bots/api#getforumtopiciconstickers
+//
+// Use this method to get custom emoji stickers, which can be used as a forum topic icon by any user.
+// Requires no parameters. Returns an Array of Sticker objects.
+func GetForumTopicIconStickers(c Client) ([]Sticker, error) {
+	return call[[]Sticker](c, "getForumTopicIconStickers", nil)
+}
+
+// GetGameHighScoresParams are the parameters of getGameHighScores
+type GetGameHighScoresParams struct {
+	// Target user id
+	UserID int64 `json:"user_id"`
+	// Required if inline_message_id is not specified. Unique identifier for the target chat
+	ChatID int64 `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the sent message
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// GetGameHighScores calls getGameHighScores, see https://core.telegram.org/bots/api#getgamehighscores
+//
+// Use this method to get data for high score tables. Will return the score of the specified user and
+// several of their neighbors in a game. On success, returns an Array of GameHighScore objects.
+func GetGameHighScores(c Client, params GetGameHighScoresParams) ([]GameHighScore, error) {
+	return call[[]GameHighScore](c, "getGameHighScores", params)
+}
+
+// GetMe calls getMe, see https://core.telegram.org/bots/api#getme
+//
+// A simple method for testing your bot's authentication token. Requires no parameters. Returns basic
+// information about the bot in form of a User object.
+func GetMe(c Client) (*User, error) {
+	return call[*User](c, "getMe", nil)
+}
+
+// GetMyCommandsParams are the parameters of getMyCommands
+type GetMyCommandsParams struct {
+	// A JSON-serialized object, describing scope of users. Defaults to BotCommandScopeDefault.
+	Scope *BotCommandScope `json:"scope,omitempty"`
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyCommands calls getMyCommands, see https://core.telegram.org/bots/api#getmycommands
+//
+// Use this method to get the current list of the bot's commands for the given scope and user language.
+// Returns an Array of BotCommand objects. If commands aren't set, an empty list is returned.
+func GetMyCommands(c Client, params GetMyCommandsParams) ([]BotCommand, error) {
+	return call[[]BotCommand](c, "getMyCommands", params)
+}
+
+// GetMyDefaultAdministratorRightsParams are the parameters of getMyDefaultAdministratorRights
+type GetMyDefaultAdministratorRightsParams struct {
+	// Pass True to get default administrator rights of the bot in channels. Otherwise, default
+	// administrator rights of the bot for groups and supergroups will be returned.
+	ForChannels bool `json:"for_channels,omitempty"`
+}
+
+// GetMyDefaultAdministratorRights calls getMyDefaultAdministratorRights, see
+// https://core.telegram.org/bots/api#getmydefaultadministratorrights
+//
+// Use this method to get the current default administrator rights of the bot. Returns
+// ChatAdministratorRights on success.
+func GetMyDefaultAdministratorRights(c Client, params GetMyDefaultAdministratorRightsParams) (*ChatAdministratorRights, error) {
+	return call[*ChatAdministratorRights](c, "getMyDefaultAdministratorRights", params)
+}
+
+// GetMyDescriptionParams are the parameters of getMyDescription
+type GetMyDescriptionParams struct {
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyDescription calls getMyDescription, see https://core.telegram.org/bots/api#getmydescription
+//
+// Use this method to get the current bot description for the given user language. Returns
+// BotDescription on success.
+func GetMyDescription(c Client, params GetMyDescriptionParams) (*BotDescription, error) {
+	return call[*BotDescription](c, "getMyDescription", params)
+}
+
+// GetMyNameParams are the parameters of getMyName
+type GetMyNameParams struct {
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyName calls getMyName, see https://core.telegram.org/bots/api#getmyname
+//
+// Use this method to get the current bot name for the given user language. Returns BotName on success.
+func GetMyName(c Client, params GetMyNameParams) (*BotName, error) {
+	return call[*BotName](c, "getMyName", params)
+}
+
+// GetMyShortDescriptionParams are the parameters of getMyShortDescription
+type GetMyShortDescriptionParams struct {
+	// A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// GetMyShortDescription calls getMyShortDescription, see
+// https://core.telegram.org/bots/api#getmyshortdescription
+//
+// Use this method to get the current bot short description for the given user language. Returns
+// BotShortDescription on success.
+func GetMyShortDescription(c Client, params GetMyShortDescriptionParams) (*BotShortDescription, error) {
+	return call[*BotShortDescription](c, "getMyShortDescription", params)
+}
+
+// GetStickerSetParams are the parameters of getStickerSet
+type GetStickerSetParams struct {
+	// Name of the sticker set
+	Name string `json:"name"`
+}
+
+// GetStickerSet calls getStickerSet, see https://core.telegram.org/bots/api#getstickerset
+//
+// Use this method to get a sticker set. On success, a StickerSet object is returned.
+func GetStickerSet(c Client, params GetStickerSetParams) (*StickerSet, error) {
+	return call[*StickerSet](c, "getStickerSet", params)
+}
+
+// GetUpdatesParams are the parameters of getUpdates
+type GetUpdatesParams struct {
+	// Identifier of the first update to be returned. Must be greater by one than the highest among the
+	// identifiers of previously received updates. By default, updates starting with the earliest
+	// unconfirmed update are returned. An update is considered confirmed as soon as getUpdates is called
+	// with an offset higher than its update_id. The negative offset can be specified to retrieve updates
+	// starting from -offset update from the end of the updates queue. All previous updates will be
+	// forgotten.
+	Offset int64 `json:"offset,omitempty"`
+	// Limits the number of updates to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+	Limit int64 `json:"limit,omitempty"`
+	// Timeout in seconds for long polling. Defaults to 0, i.e. usual short polling. Should be positive,
+	// short polling should be used for testing purposes only.
+	Timeout int64 `json:"timeout,omitempty"`
+	// A JSON-serialized list of the update types you want your bot to receive. For example, specify
+	// ["message", "edited_channel_post", "callback_query"] to only receive updates of these types. See
+	// Update for a complete list of available update types. Specify an empty list to receive all update
+	// types except chat_member, message_reaction, and message_reaction_count (default). If not specified,
+	// the previous setting will be used.
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+// GetUpdates calls getUpdates, see https://core.telegram.org/bots/api#getupdates
+//
+// Use this method to receive incoming updates using long polling (wiki). Returns an Array of Update
+// objects.
+func GetUpdates(c Client, params GetUpdatesParams) ([]Update, error) {
+	return call[[]Update](c, "getUpdates", params)
+}
+
+// GetUserChatBoostsParams are the parameters of getUserChatBoosts
+type GetUserChatBoostsParams struct {
+	// Unique identifier for the chat or username of the channel (in the format @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+}
+
+// GetUserChatBoosts calls getUserChatBoosts, see https://core.telegram.org/bots/api#getuserchatboosts
+//
+// Use this method to get the list of boosts added to a chat by a user. Requires administrator rights
+// in the chat. Returns a UserChatBoosts object.
+func GetUserChatBoosts(c Client, params GetUserChatBoostsParams) (*UserChatBoosts, error) {
+	return call[*UserChatBoosts](c, "getUserChatBoosts", params)
+}
+
+// GetUserProfilePhotosParams are the parameters of getUserProfilePhotos
+type GetUserProfilePhotosParams struct {
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+	// Sequential number of the first photo to be returned. By default, all photos are returned.
+	Offset int64 `json:"offset,omitempty"`
+	// Limits the number of photos to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+	Limit int64 `json:"limit,omitempty"`
+}
+
+// GetUserProfilePhotos calls getUserProfilePhotos, see
+// https://core.telegram.org/bots/api#getuserprofilephotos
+//
+// Use this method to get a list of profile pictures for a user. Returns a UserProfilePhotos object.
+func GetUserProfilePhotos(c Client, params GetUserProfilePhotosParams) (*UserProfilePhotos, error) {
+	return call[*UserProfilePhotos](c, "getUserProfilePhotos", params)
+}
+
+// GetWebhookInfo calls getWebhookInfo, see https://core.telegram.org/bots/api#getwebhookinfo
+//
+// Use this method to get current webhook status. Requires no parameters. On success, returns a
+// WebhookInfo object. If the bot is using getUpdates, will return an object with the url field empty.
+func GetWebhookInfo(c Client) (*WebhookInfo, error) {
+	return call[*WebhookInfo](c, "getWebhookInfo", nil)
+}
+
+// HideGeneralForumTopicParams are the parameters of hideGeneralForumTopic
+type HideGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+}
+
+// HideGeneralForumTopic calls hideGeneralForumTopic, see
+// https://core.telegram.org/bots/api#hidegeneralforumtopic
+//
+// Use this method to hide the 'General' topic in a forum supergroup chat. The bot must be an
+// administrator in the chat for this to work and must have the can_manage_topics administrator rights.
+// The topic will be automatically closed if it was open. Returns True on success.
+func HideGeneralForumTopic(c Client, params HideGeneralForumTopicParams) (bool, error) {
+	return call[bool](c, "hideGeneralForumTopic", params)
+}
+
+// LeaveChatParams are the parameters of leaveChat
+type LeaveChatParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+}
+
+// LeaveChat calls leaveChat, see https://core.telegram.org/bots/api#leavechat
+//
+// Use this method for your bot to leave a group, supergroup or channel. Returns True on success.
+func LeaveChat(c Client, params LeaveChatParams) (bool, error) {
+	return call[bool](c, "leaveChat", params)
+}
+
+// LogOut calls logOut, see https://core.telegram.org/bots/api#logout
+//
+// Use this method to log out from the cloud Bot API server before launching the bot locally. You must
+// log out the bot before running it locally, otherwise there is no guarantee that the bot will receive
+// updates. After a successful call, you can immediately log in on a local server, but will not be able
+// to log in back to the cloud Bot API server for 10 minutes. Returns True on success. Requires no
+// parameters.
+func LogOut(c Client) (bool, error) {
+	return call[bool](c, "logOut", nil)
+}
+
+// PinChatMessageParams are the parameters of pinChatMessage
+type PinChatMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Identifier of a message to pin
+	MessageID int64 `json:"message_id"`
+	// Pass True if it is not necessary to send a notification to all chat members about the new pinned
+	// message. Notifications are always disabled in channels and private chats.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+}
+
+// PinChatMessage calls pinChatMessage, see https://core.telegram.org/bots/api#pinchatmessage
+//
+// Use this method to add a message to the list of pinned messages in a chat. If the chat is not a
+// private chat, the bot must be an administrator in the chat for this to work and must have the
+// 'can_pin_messages' administrator right in a supergroup or 'can_edit_messages' administrator right in
+// a channel. Returns True on success.
+func PinChatMessage(c Client, params PinChatMessageParams) (bool, error) {
+	return call[bool](c, "pinChatMessage", params)
+}
+
+// PromoteChatMemberParams are the parameters of promoteChatMember
+type PromoteChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+	// Pass True if the administrator's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous,omitempty"`
+	// Pass True if the administrator can access the chat event log, get boost list, see hidden supergroup
+	// and channel members, report spam messages and ignore slow mode. Implied by any other administrator
+	// privilege.
+	CanManageChat bool `json:"can_manage_chat,omitempty"`
+	// Pass True if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages,omitempty"`
+	// Pass True if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats,omitempty"`
+	// Pass True if the administrator can restrict, ban or unban chat members, or access supergroup
+	// statistics
+	CanRestrictMembers bool `json:"can_restrict_members,omitempty"`
+	// Pass True if the administrator can add new administrators with a subset of their own privileges or
+	// demote administrators that they have promoted, directly or indirectly (promoted by administrators
+	// that were appointed by him)
+	CanPromoteMembers bool `json:"can_promote_members,omitempty"`
+	// Pass True if the administrator can change chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+	// Pass True if the administrator can invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+	// Pass True if the administrator can post stories to the chat
+	CanPostStories bool `json:"can_post_stories,omitempty"`
+	// Pass True if the administrator can edit stories posted by other users
+	CanEditStories bool `json:"can_edit_stories,omitempty"`
+	// Pass True if the administrator can delete stories posted by other users
+	CanDeleteStories bool `json:"can_delete_stories,omitempty"`
+	// Pass True if the administrator can post messages in the channel, or access channel statistics; for
+	// channels only
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+	// Pass True if the administrator can edit messages of other users and can pin messages; for channels
+	// only
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+	// Pass True if the administrator can pin messages, supergroups only
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+	// Pass True if the user is allowed to create, rename, close, and reopen forum topics, supergroups only
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+// PromoteChatMember calls promoteChatMember, see https://core.telegram.org/bots/api#promotechatmember
+//
+// Use this method to promote or demote a user in a supergroup or a channel. The bot must be an
+// administrator in the chat for this to work and must have the appropriate administrator rights. Pass
+// False for all boolean parameters to demote a user. Returns True on success.
+func PromoteChatMember(c Client, params PromoteChatMemberParams) (bool, error) {
+	return call[bool](c, "promoteChatMember", params)
+}
+
+// ReopenForumTopicParams are the parameters of reopenForumTopic
+type ReopenForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadID int64 `json:"message_thread_id"`
+}
+
+// ReopenForumTopic calls reopenForumTopic, see https://core.telegram.org/bots/api#reopenforumtopic
+//
+// Use this method to reopen a closed topic in a forum supergroup chat. The bot must be an
+// administrator in the chat for this to work and must have the can_manage_topics administrator rights,
+// unless it is the creator of the topic. Returns True on success.
+func ReopenForumTopic(c Client, params ReopenForumTopicParams) (bool, error) {
+	return call[bool](c, "reopenForumTopic", params)
+}
+
+// ReopenGeneralForumTopicParams are the parameters of reopenGeneralForumTopic
+type ReopenGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+}
+
+// ReopenGeneralForumTopic calls reopenGeneralForumTopic, see
+// https://core.telegram.org/bots/api#reopengeneralforumtopic
+//
+// Use this method to reopen a closed 'General' topic in a forum supergroup chat. The bot must be an
+// administrator in the chat for this to work and must have the can_manage_topics administrator rights.
+// The topic will be automatically unhidden if it was hidden. Returns True on success.
+func ReopenGeneralForumTopic(c Client, params ReopenGeneralForumTopicParams) (bool, error) {
+	return call[bool](c, "reopenGeneralForumTopic", params)
+}
+
+// ReplaceStickerInSetParams are the parameters of replaceStickerInSet
+type ReplaceStickerInSetParams struct {
+	// User identifier of the sticker set owner
+	UserID int64 `json:"user_id"`
+	// Sticker set name
+	Name string `json:"name"`
+	// File identifier of the replaced sticker
+	OldSticker string `json:"old_sticker"`
+	// A JSON-serialized object with information about the added sticker. If exactly the same sticker had
+	// already been added to the set, then the set remains unchanged.
+	Sticker InputSticker `json:"sticker"`
+}
+
+// ReplaceStickerInSet calls replaceStickerInSet, see
+// https://core.telegram.org/bots/api#replacestickerinset
+//
+// Use this method to replace an existing sticker in a sticker set with a new one. The method is
+// equivalent to calling deleteStickerFromSet, then addStickerToSet, then setStickerPositionInSet.
+// Returns True on success.
+func ReplaceStickerInSet(c Client, params ReplaceStickerInSetParams) (bool, error) {
+	return call[bool](c, "replaceStickerInSet", params)
+}
+
+// RestrictChatMemberParams are the parameters of restrictChatMember
+type RestrictChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+	// A JSON-serialized object for new user permissions
+	Permissions ChatPermissions `json:"permissions"`
+	// Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages and
+	// can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios,
+	// can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes
+	// permissions; the can_send_polls permission will imply the can_send_messages permission.
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+	// Date when restrictions will be lifted for the user; Unix time. If user is restricted for more than
+	// 366 days or less than 30 seconds from the current time, they are considered to be restricted forever
+	UntilDate int64 `json:"until_date,omitempty"`
+}
+
+// RestrictChatMember calls restrictChatMember, see
+// https://core.telegram.org/bots/api#restrictchatmember
+//
+// Use this method to restrict a user in a supergroup. The bot must be an administrator in the
+// supergroup for this to work and must have the appropriate administrator rights. Pass True for all
+// permissions to lift restrictions from a user. Returns True on success.
+func RestrictChatMember(c Client, params RestrictChatMemberParams) (bool, error) {
+	return call[bool](c, "restrictChatMember", params)
+}
+
+// RevokeChatInviteLinkParams are the parameters of revokeChatInviteLink
+type RevokeChatInviteLinkParams struct {
+	// Unique identifier of the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// The invite link to revoke
+	InviteLink string `json:"invite_link"`
+}
+
+// RevokeChatInviteLink calls revokeChatInviteLink, see
+// https://core.telegram.org/bots/api#revokechatinvitelink
+//
+// Use this method to revoke an invite link created by the bot. If the primary link is revoked, a new
+// link is automatically generated. The bot must be an administrator in the chat for this to work and
+// must have the appropriate administrator rights. Returns the revoked invite link as ChatInviteLink
+// object.
+func RevokeChatInviteLink(c Client, params RevokeChatInviteLinkParams) (*ChatInviteLink, error) {
+	return call[*ChatInviteLink](c, "revokeChatInviteLink", params)
+}
+
+// SendAnimationParams are the parameters of sendAnimation
+type SendAnimationParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Animation to send. Pass a file_id as String to send an animation that exists on the Telegram servers
+	// (recommended), pass an HTTP URL as a String for Telegram to get an animation from the Internet, or
+	// upload a new animation using multipart/form-data.
+	Animation string `json:"animation"`
+	// Duration of sent animation in seconds
+	Duration int64 `json:"duration,omitempty"`
+	// Animation width
+	Width int64 `json:"width,omitempty"`
+	// Animation height
+	Height int64 `json:"height,omitempty"`
+	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
+	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's
+	// width and height should not exceed 320. Ignored if the file is not uploaded using
+	// multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can
+	// pass “attach://<file_attach_name>” if the thumbnail was uploaded using multipart/form-data under
+	// <file_attach_name>.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// Animation caption (may also be used when resending animation by file_id), 0-1024 characters after
+	// entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the animation caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Pass True if the animation needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendAnimation calls sendAnimation, see https://core.telegram.org/bots/api#sendanimation
+//
+// Use this method to send animation files (GIF or H.264/MPEG-4 AVC video without sound). On success,
+// the sent Message is returned. Bots can currently send animation files of up to 50 MB in size, this
+// limit may be changed in the future.
+func SendAnimation(c Client, params SendAnimationParams) (*Message, error) {
+	return call[*Message](c, "sendAnimation", params)
+}
+
+// SendAudioParams are the parameters of sendAudio
+type SendAudioParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Audio file to send. Pass a file_id as String to send an audio file that exists on the Telegram
+	// servers (recommended), pass an HTTP URL as a String for Telegram to get an audio file from the
+	// Internet, or upload a new one using multipart/form-data.
+	Audio string `json:"audio"`
+	// Audio caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the audio caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Duration of the audio in seconds
+	Duration int64 `json:"duration,omitempty"`
+	// Performer
+	Performer string `json:"performer,omitempty"`
+	// Track name
+	Title string `json:"title,omitempty"`
+	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
+	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's
+	// width and height should not exceed 320. Ignored if the file is not uploaded using
+	// multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can
+	// pass “attach://<file_attach_name>” if the thumbnail was uploaded using multipart/form-data under
+	// <file_attach_name>.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendAudio calls sendAudio, see https://core.telegram.org/bots/api#sendaudio
+//
+// Use this method to send audio files, if you want Telegram clients to display them in the music
+// player. Your audio must be in the .MP3 or .M4A format. On success, the sent Message is returned.
+// Bots can currently send audio files of up to 50 MB in size, this limit may be changed in the future.
+//
+// For sending voice messages, use the sendVoice method instead.
+func SendAudio(c Client, params SendAudioParams) (*Message, error) {
+	return call[*Message](c, "sendAudio", params)
+}
+
+// SendChatActionParams are the parameters of sendChatAction
+type SendChatActionParams struct {
+	// Unique identifier of the business connection on behalf of which the action will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread; for supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Type of action to broadcast. Choose one, depending on what the user is about to receive: typing for
+	// text messages, upload_photo for photos, record_video or upload_video for videos, record_voice or
+	// upload_voice for voice notes, upload_document for general files, choose_sticker for stickers,
+	// find_location for location data, record_video_note or upload_video_note for video notes.
+	Action string `json:"action"`
+}
+
+// SendChatAction calls sendChatAction, see https://core.telegram.org/bots/api#sendchataction
+//
+// Use this method when you need to tell the user that something is happening on the bot's side. The
+// status is set for 5 seconds or less (when a message arrives from your bot, Telegram clients clear
+// its typing status). Returns True on success.
+func SendChatAction(c Client, params SendChatActionParams) (bool, error) {
+	return call[bool](c, "sendChatAction", params)
+}
+
+// SendContactParams are the parameters of sendContact
+type SendContactParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+	// Contact's first name
+	FirstName string `json:"first_name"`
+	// Contact's last name
+	LastName string `json:"last_name,omitempty"`
+	// Additional data about the contact in the form of a vCard, 0-2048 bytes
+	Vcard string `json:"vcard,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendContact calls sendContact, see https://core.telegram.org/bots/api#sendcontact
+//
+// Use this method to send phone contacts. On success, the sent Message is returned.
+func SendContact(c Client, params SendContactParams) (*Message, error) {
+	return call[*Message](c, "sendContact", params)
+}
+
+// SendDiceParams are the parameters of sendDice
+type SendDiceParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Emoji on which the dice throw animation is based. Currently, must be one of “🎲”, “🎯”,
+	// “🏀”, “⚽”, “🎳”, or “🎰”. Dice can have values 1-6 for “🎲”,
+	// “🎯” and “🎳”, values 1-5 for “🏀” and “⚽”, and values 1-64 for “🎰”.
+	// Defaults to “🎲”
+	Emoji string `json:"emoji,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendDice calls sendDice, see https://core.telegram.org/bots/api#senddice
+//
+// Use this method to send an animated emoji that will display a random value. On success, the sent
+// Message is returned.
+func SendDice(c Client, params SendDiceParams) (*Message, error) {
+	return call[*Message](c, "sendDice", params)
+}
+
+// SendDocumentParams are the parameters of sendDocument
+type SendDocumentParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// File to send. Pass a file_id as String to send a file that exists on the Telegram servers
+	// (recommended), pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload
+	// a new one using multipart/form-data.
+	Document string `json:"document"`
+	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
+	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's
+	// width and height should not exceed 320. Ignored if the file is not uploaded using
+	// multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can
+	// pass “attach://<file_attach_name>” if the thumbnail was uploaded using multipart/form-data under
+	// <file_attach_name>.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// Document caption (may also be used when resending documents by file_id), 0-1024 characters after
+	// entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the document caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Disables automatic server-side content type detection for files uploaded using multipart/form-data
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendDocument calls sendDocument, see https://core.telegram.org/bots/api#senddocument
+//
+// Use this method to send general files. On success, the sent Message is returned. Bots can currently
+// send files of any type of up to 50 MB in size, this limit may be changed in the future.
+func SendDocument(c Client, params SendDocumentParams) (*Message, error) {
+	return call[*Message](c, "sendDocument", params)
+}
+
+// SendGameParams are the parameters of sendGame
+type SendGameParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat
+	ChatID int64 `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Short name of the game, serves as the unique identifier for the game. Set up your games via
+	// @BotFather.
+	GameShortName string `json:"game_short_name"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// A JSON-serialized object for an inline keyboard. If empty, one 'Play game_title' button will be
+	// shown. If not empty, the first button must launch the game. Not supported for messages sent on
+	// behalf of a business account.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendGame calls sendGame, see https://core.telegram.org/bots/api#sendgame
+//
+// Use this method to send a game. On success, the sent Message is returned.
+func SendGame(c Client, params SendGameParams) (*Message, error) {
+	return call[*Message](c, "sendGame", params)
+}
+
+// SendInvoiceParams are the parameters of sendInvoice
+type SendInvoiceParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Product name, 1-32 characters
+	Title string `json:"title"`
+	// Product description, 1-255 characters
+	Description string `json:"description"`
+	// Bot-defined invoice payload, 1-128 bytes. This will not be displayed to the user, use for your
+	// internal processes.
+	Payload string `json:"payload"`
+	// Payment provider token, obtained via @BotFather
+	ProviderToken string `json:"provider_token"`
+	// Three-letter ISO 4217 currency code, see more on currencies
+	Currency string `json:"currency"`
+	// Price breakdown, a JSON-serialized list of components (e.g. product price, tax, discount, delivery
+	// cost, delivery tax, bonus, etc.)
+	Prices []LabeledPrice `json:"prices"`
+	// The maximum accepted amount for tips in the smallest units of the currency (integer, not
+	// float/double). For example, for a maximum tip of US$ 1.45 pass max_tip_amount = 145. See the exp
+	// parameter in currencies.json, it shows the number of digits past the decimal point for each currency
+	// (2 for the majority of currencies). Defaults to 0
+	MaxTipAmount int64 `json:"max_tip_amount,omitempty"`
+	// A JSON-serialized array of suggested amounts of tips in the smallest units of the currency (integer,
+	// not float/double). At most 4 suggested tip amounts can be specified. The suggested tip amounts must
+	// be positive, passed in a strictly increased order and must not exceed max_tip_amount.
+	SuggestedTipAmounts []int64 `json:"suggested_tip_amounts,omitempty"`
+	// Unique deep-linking parameter. If left empty, forwarded copies of the sent message will have a Pay
+	// button, allowing multiple users to pay directly from the forwarded message, using the same invoice.
+	// If non-empty, forwarded copies of the sent message will have a URL button with a deep link to the
+	// bot (instead of a Pay button), with the value used as the start parameter
+	StartParameter string `json:"start_parameter,omitempty"`
+	// JSON-serialized data about the invoice, which will be shared with the payment provider. A detailed
+	// description of required fields should be provided by the payment provider.
+	ProviderData string `json:"provider_data,omitempty"`
+	// URL of the product photo for the invoice. Can be a photo of the goods or a marketing image for a
+	// service. People like it better when they see what they are paying for.
+	PhotoURL string `json:"photo_url,omitempty"`
+	// Photo size in bytes
+	PhotoSize int64 `json:"photo_size,omitempty"`
+	// Photo width
+	PhotoWidth int64 `json:"photo_width,omitempty"`
+	// Photo height
+	PhotoHeight int64 `json:"photo_height,omitempty"`
+	// Pass True if you require the user's full name to complete the order
+	NeedName bool `json:"need_name,omitempty"`
+	// Pass True if you require the user's phone number to complete the order
+	NeedPhoneNumber bool `json:"need_phone_number,omitempty"`
+	// Pass True if you require the user's email address to complete the order
+	NeedEmail bool `json:"need_email,omitempty"`
+	// Pass True if you require the user's shipping address to complete the order
+	NeedShippingAddress bool `json:"need_shipping_address,omitempty"`
+	// Pass True if the user's phone number should be sent to provider
+	SendPhoneNumberToProvider bool `json:"send_phone_number_to_provider,omitempty"`
+	// Pass True if the user's email address should be sent to provider
+	SendEmailToProvider bool `json:"send_email_to_provider,omitempty"`
+	// Pass True if the final price depends on the shipping method
+	IsFlexible bool `json:"is_flexible,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// A JSON-serialized object for an inline keyboard. If empty, one 'Pay total price' button will be
+	// shown. If not empty, the first button must be a Pay button.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// SendInvoice calls sendInvoice, see https://core.telegram.org/bots/api#sendinvoice
+//
+// Use this method to send invoices. On success, the sent Message is returned.
+func SendInvoice(c Client, params SendInvoiceParams) (*Message, error) {
+	return call[*Message](c, "sendInvoice", params)
+}
+
+// SendLocationParams are the parameters of sendLocation
+type SendLocationParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Latitude of the location
+	Latitude float64 `json:"latitude"`
+	// Longitude of the location
+	Longitude float64 `json:"longitude"`
+	// The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	// Period in seconds for which the location will be updated (see Live Locations, should be between 60
+	// and 86400.
+	LivePeriod int64 `json:"live_period,omitempty"`
+	// For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360
+	// if specified.
+	Heading int64 `json:"heading,omitempty"`
+	// For live locations, a maximum distance for proximity alerts about approaching another chat member,
+	// in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int64 `json:"proximity_alert_radius,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendLocation calls sendLocation, see https://core.telegram.org/bots/api#sendlocation
+//
+// Use this method to send point on the map. On success, the sent Message is returned.
+func SendLocation(c Client, params SendLocationParams) (*Message, error) {
+	return call[*Message](c, "sendLocation", params)
+}
+
+// SendMediaGroupParams are the parameters of sendMediaGroup
+type SendMediaGroupParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// A JSON-serialized array describing messages to be sent, must include 2-10 items
+	Media any `json:"media"`
+	// Sends messages silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent messages from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+}
+
+// SendMediaGroup calls sendMediaGroup, see https://core.telegram.org/bots/api#sendmediagroup
+//
+// Use this method to send a group of photos, videos, documents or audios as an album. Documents and
+// audio files can be only grouped in an album with messages of the same type. On success, an array of
+// Messages that were sent is returned.
+func SendMediaGroup(c Client, params SendMediaGroupParams) ([]Message, error) {
+	return call[[]Message](c, "sendMediaGroup", params)
+}
+
+// SendMessageParams are the parameters of sendMessage
+type SendMessageParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Text of the message to be sent, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+	// Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in message text, which can be specified
+	// instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+	// Link preview generation options for the message
+	LinkPreviewOptions *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendMessage calls sendMessage, see https://core.telegram.org/bots/api#sendmessage
+//
+// Use this method to send text messages. On success, the sent Message is returned.
+func SendMessage(c Client, params SendMessageParams) (*Message, error) {
+	return call[*Message](c, "sendMessage", params)
+}
+
+// SendPhotoParams are the parameters of sendPhoto
+type SendPhotoParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Photo to send. Pass a file_id as String to send a photo that exists on the Telegram servers
+	// (recommended), pass an HTTP URL as a String for Telegram to get a photo from the Internet, or upload
+	// a new photo using multipart/form-data. The photo must be at most 10 MB in size. The photo's width
+	// and height must not exceed 10000 in total. Width and height ratio must be at most 20.
+	Photo string `json:"photo"`
+	// Photo caption (may also be used when resending photos by file_id), 0-1024 characters after entities
+	// parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the photo caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Pass True if the photo needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendPhoto calls sendPhoto, see https://core.telegram.org/bots/api#sendphoto
+//
+// Use this method to send photos. On success, the sent Message is returned.
+func SendPhoto(c Client, params SendPhotoParams) (*Message, error) {
+	return call[*Message](c, "sendPhoto", params)
+}
+
+// SendPollParams are the parameters of sendPoll
+type SendPollParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+	// A JSON-serialized list of answer options, 2-10 strings 1-100 characters each
+	Options []string `json:"options"`
+	// True, if the poll needs to be anonymous, defaults to True
+	IsAnonymous bool `json:"is_anonymous,omitempty"`
+	// Poll type, “quiz” or “regular”, defaults to “regular”
+	Type string `json:"type,omitempty"`
+	// True, if the poll allows multiple answers, ignored for polls in quiz mode, defaults to False
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers,omitempty"`
+	// 0-based identifier of the correct answer option, required for polls in quiz mode
+	CorrectOptionID int64 `json:"correct_option_id,omitempty"`
+	// Text that is shown when a user chooses an incorrect answer or taps on the lamp icon in a quiz-style
+	// poll, 0-200 characters with at most 2 line feeds after entities parsing
+	Explanation string `json:"explanation,omitempty"`
+	// Mode for parsing entities in the explanation. See formatting options for more details.
+	ExplanationParseMode string `json:"explanation_parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the poll explanation, which can be
+	// specified instead of parse_mode
+	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
+	// Amount of time in seconds the poll will be active after creation, 5-600. Can't be used together with
+	// close_date.
+	OpenPeriod int64 `json:"open_period,omitempty"`
+	// Point in time (Unix timestamp) when the poll will be automatically closed. Must be at least 5 and no
+	// more than 600 seconds in the future. Can't be used together with open_period.
+	CloseDate int64 `json:"close_date,omitempty"`
+	// Pass True if the poll needs to be immediately closed. This can be useful for poll preview.
+	IsClosed bool `json:"is_closed,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendPoll calls sendPoll, see https://core.telegram.org/bots/api#sendpoll
+//
+// Use this method to send a native poll. On success, the sent Message is returned.
+func SendPoll(c Client, params SendPollParams) (*Message, error) {
+	return call[*Message](c, "sendPoll", params)
+}
+
+// SendStickerParams are the parameters of sendSticker
+type SendStickerParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Sticker to send. Pass a file_id as String to send a file that exists on the Telegram servers
+	// (recommended), pass an HTTP URL as a String for Telegram to get a .WEBP sticker from the Internet,
+	// or upload a new .WEBP, .TGS, or .WEBM sticker using multipart/form-data. Video and animated stickers
+	// can't be sent via an HTTP URL.
+	Sticker string `json:"sticker"`
+	// Emoji associated with the sticker; only for just uploaded stickers
+	Emoji string `json:"emoji,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account.
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendSticker calls sendSticker, see https://core.telegram.org/bots/api#sendsticker
+//
+// Use this method to send static .WEBP, animated .TGS, or video .WEBM stickers. On success, the sent
+// Message is returned.
+func SendSticker(c Client, params SendStickerParams) (*Message, error) {
+	return call[*Message](c, "sendSticker", params)
+}
+
+// SendVenueParams are the parameters of sendVenue
+type SendVenueParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Latitude of the venue
+	Latitude float64 `json:"latitude"`
+	// Longitude of the venue
+	Longitude float64 `json:"longitude"`
+	// Name of the venue
+	Title string `json:"title"`
+	// Address of the venue
+	Address string `json:"address"`
+	// Foursquare identifier of the venue
+	FoursquareID string `json:"foursquare_id,omitempty"`
+	// Foursquare type of the venue, if known. (For example, “arts_entertainment/default”,
+	// “arts_entertainment/aquarium” or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+	// Google Places identifier of the venue
+	GooglePlaceID string `json:"google_place_id,omitempty"`
+	// Google Places type of the venue. (See supported types.)
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendVenue calls sendVenue, see https://core.telegram.org/bots/api#sendvenue
+//
+// Use this method to send information about a venue. On success, the sent Message is returned.
+func SendVenue(c Client, params SendVenueParams) (*Message, error) {
+	return call[*Message](c, "sendVenue", params)
+}
+
+// SendVideoParams are the parameters of sendVideo
+type SendVideoParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Video to send. Pass a file_id as String to send a video that exists on the Telegram servers
+	// (recommended), pass an HTTP URL as a String for Telegram to get a video from the Internet, or upload
+	// a new video using multipart/form-data.
+	Video string `json:"video"`
+	// Duration of sent video in seconds
+	Duration int64 `json:"duration,omitempty"`
+	// Video width
+	Width int64 `json:"width,omitempty"`
+	// Video height
+	Height int64 `json:"height,omitempty"`
+	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
+	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's
+	// width and height should not exceed 320. Ignored if the file is not uploaded using
+	// multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can
+	// pass “attach://<file_attach_name>” if the thumbnail was uploaded using multipart/form-data under
+	// <file_attach_name>.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// Video caption (may also be used when resending videos by file_id), 0-1024 characters after entities
+	// parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the video caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Pass True if the video needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+	// Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendVideo calls sendVideo, see https://core.telegram.org/bots/api#sendvideo
+//
+// Use this method to send video files, Telegram clients support MPEG4 videos (other formats may be
+// sent as Document). On success, the sent Message is returned. Bots can currently send video files of
+// up to 50 MB in size, this limit may be changed in the future.
+func SendVideo(c Client, params SendVideoParams) (*Message, error) {
+	return call[*Message](c, "sendVideo", params)
+}
+
+// SendVideoNoteParams are the parameters of sendVideoNote
+type SendVideoNoteParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Video note to send. Pass a file_id as String to send a video note that exists on the Telegram
+	// servers (recommended) or upload a new video using multipart/form-data. Sending video notes by a URL
+	// is currently unsupported
+	VideoNote string `json:"video_note"`
+	// Duration of sent video in seconds
+	Duration int64 `json:"duration,omitempty"`
+	// Video width and height, i.e. diameter of the video message
+	Length int64 `json:"length,omitempty"`
+	// Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported
+	// server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's
+	// width and height should not exceed 320. Ignored if the file is not uploaded using
+	// multipart/form-data. Thumbnails can't be reused and can be only uploaded as a new file, so you can
+	// pass “attach://<file_attach_name>” if the thumbnail was uploaded using multipart/form-data under
+	// <file_attach_name>.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendVideoNote calls sendVideoNote, see https://core.telegram.org/bots/api#sendvideonote
+//
+// As of v.4.0, Telegram clients support rounded square MPEG4 videos of up to 1 minute long. Use this
+// method to send video messages. On success, the sent Message is returned.
+func SendVideoNote(c Client, params SendVideoNoteParams) (*Message, error) {
+	return call[*Message](c, "sendVideoNote", params)
+}
+
+// SendVoiceParams are the parameters of sendVoice
+type SendVoiceParams struct {
+	// Unique identifier of the business connection on behalf of which the message will be sent
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadID int64 `json:"message_thread_id,omitempty"`
+	// Audio file to send. Pass a file_id as String to send a file that exists on the Telegram servers
+	// (recommended), pass an HTTP URL as a String for Telegram to get a file from the Internet, or upload
+	// a new one using multipart/form-data.
+	Voice string `json:"voice"`
+	// Voice message caption, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+	// Mode for parsing entities in the voice message caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+	// A JSON-serialized list of special entities that appear in the caption, which can be specified
+	// instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+	// Duration of the voice message in seconds
+	Duration int64 `json:"duration,omitempty"`
+	// Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+	// Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+	// Description of the message to reply to
+	ReplyParameters *ReplyParameters `json:"reply_parameters,omitempty"`
+	// Additional interface options. A JSON-serialized object for an inline keyboard, custom reply
+	// keyboard, instructions to remove reply keyboard or to force a reply from the user. Not supported for
+	// messages sent on behalf of a business account
+	ReplyMarkup any `json:"reply_markup,omitempty"`
+}
+
+// SendVoice calls sendVoice, see https://core.telegram.org/bots/api#sendvoice
+//
+// Use this method to send audio files, if you want Telegram clients to display the file as a playable
+// voice message. For this to work, your audio must be in an .OGG file encoded with OPUS (other formats
+// may be sent as Audio or Document). On success, the sent Message is returned. Bots can currently send
+// voice messages of up to 50 MB in size, this limit may be changed in the future.
+func SendVoice(c Client, params SendVoiceParams) (*Message, error) {
+	return call[*Message](c, "sendVoice", params)
+}
+
+// SetChatAdministratorCustomTitleParams are the parameters of setChatAdministratorCustomTitle
+type SetChatAdministratorCustomTitleParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+	// New custom title for the administrator; 0-16 characters, emoji are not allowed
+	CustomTitle string `json:"custom_title"`
+}
+
+// SetChatAdministratorCustomTitle calls setChatAdministratorCustomTitle, see
+// https://core.telegram.org/bots/api#setchatadministratorcustomtitle
+//
+// Use this method to set a custom title for an administrator in a supergroup promoted by the bot.
+// Returns True on success.
+func SetChatAdministratorCustomTitle(c Client, params SetChatAdministratorCustomTitleParams) (bool, error) {
+	return call[bool](c, "setChatAdministratorCustomTitle", params)
+}
+
+// SetChatDescriptionParams are the parameters of setChatDescription
+type SetChatDescriptionParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// New chat description, 0-255 characters
+	Description string `json:"description,omitempty"`
+}
+
+// SetChatDescription calls setChatDescription, see
+// https://core.telegram.org/bots/api#setchatdescription
+//
+// Use this method to change the description of a group, a supergroup or a channel. The bot must be an
+// administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns True on success.
+func SetChatDescription(c Client, params SetChatDescriptionParams) (bool, error) {
+	return call[bool](c, "setChatDescription", params)
+}
+
+// SetChatMenuButtonParams are the parameters of setChatMenuButton
+type SetChatMenuButtonParams struct {
+	// Unique identifier for the target private chat. If not specified, default bot's menu button will be
+	// changed
+	ChatID int64 `json:"chat_id,omitempty"`
+	// A JSON-serialized object for the bot's new menu button. Defaults to MenuButtonDefault
+	MenuButton *MenuButton `json:"menu_button,omitempty"`
+}
+
+// SetChatMenuButton calls setChatMenuButton, see https://core.telegram.org/bots/api#setchatmenubutton
+//
+// Use this method to change the bot's menu button in a private chat, or the default menu button.
+// Returns True on success.
+func SetChatMenuButton(c Client, params SetChatMenuButtonParams) (bool, error) {
+	return call[bool](c, "setChatMenuButton", params)
+}
+
+// SetChatPermissionsParams are the parameters of setChatPermissions
+type SetChatPermissionsParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// A JSON-serialized object for new default chat permissions
+	Permissions ChatPermissions `json:"permissions"`
+	// Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages and
+	// can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios,
+	// can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes
+	// permissions; the can_send_polls permission will imply the can_send_messages permission.
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+}
+
+// SetChatPermissions calls setChatPermissions, see
+// https://core.telegram.org/bots/api#setchatpermissions
+//
+// Use this method to set default chat permissions for all members. The bot must be an administrator in
+// the group or a supergroup for this to work and must have the can_restrict_members administrator
+// rights. Returns True on success.
+func SetChatPermissions(c Client, params SetChatPermissionsParams) (bool, error) {
+	return call[bool](c, "setChatPermissions", params)
+}
+
+// SetChatPhotoParams are the parameters of setChatPhoto
+type SetChatPhotoParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// New chat photo, uploaded using multipart/form-data
+	Photo string `json:"photo"`
+}
+
+// SetChatPhoto calls setChatPhoto, see https://core.telegram.org/bots/api#setchatphoto
+//
+// Use this method to set a new profile photo for the chat. Photos can't be changed for private chats.
+// The bot must be an administrator in the chat for this to work and must have the appropriate
+// administrator rights. Returns True on success.
+func SetChatPhoto(c Client, params SetChatPhotoParams) (bool, error) {
+	return call[bool](c, "setChatPhoto", params)
+}
+
+// SetChatStickerSetParams are the parameters of setChatStickerSet
+type SetChatStickerSetParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Name of the sticker set to be set as the group sticker set
+	StickerSetName string `json:"sticker_set_name"`
+}
+
+// SetChatStickerSet calls setChatStickerSet, see https://core.telegram.org/bots/api#setchatstickerset
+//
+// Use this method to set a new group sticker set for a supergroup. The bot must be an administrator in
+// the chat for this to work and must have the appropriate administrator rights. Use the field
+// can_set_sticker_set optionally returned in getChat requests to check if the bot can use this method.
+// Returns True on success.
+func SetChatStickerSet(c Client, params SetChatStickerSetParams) (bool, error) {
+	return call[bool](c, "setChatStickerSet", params)
+}
+
+// SetChatTitleParams are the parameters of setChatTitle
+type SetChatTitleParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// New chat title, 1-128 characters
+	Title string `json:"title"`
+}
+
+// SetChatTitle calls setChatTitle, see https://core.telegram.org/bots/api#setchattitle
+//
+// Use this method to change the title of a chat. Titles can't be changed for private chats. The bot
+// must be an administrator in the chat for this to work and must have the appropriate administrator
+// rights. Returns True on success.
+func SetChatTitle(c Client, params SetChatTitleParams) (bool, error) {
+	return call[bool](c, "setChatTitle", params)
+}
+
+// SetCustomEmojiStickerSetThumbnailParams are the parameters of setCustomEmojiStickerSetThumbnail
+type SetCustomEmojiStickerSetThumbnailParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// Custom emoji identifier of a sticker from the sticker set; pass an empty string to drop the
+	// thumbnail and use the first sticker as the thumbnail.
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// SetCustomEmojiStickerSetThumbnail calls setCustomEmojiStickerSetThumbnail, see
+// https://core.telegram.org/bots/api#setcustomemojistickersetthumbnail
+//
+// Use this method to set the thumbnail of a custom emoji sticker set. Returns True on success.
+func SetCustomEmojiStickerSetThumbnail(c Client, params SetCustomEmojiStickerSetThumbnailParams) (bool, error) {
+	return call[bool](c, "setCustomEmojiStickerSetThumbnail", params)
+}
+
+// SetGameScoreParams are the parameters of setGameScore
+type SetGameScoreParams struct {
+	// User identifier
+	UserID int64 `json:"user_id"`
+	// New score, must be non-negative
+	Score int64 `json:"score"`
+	// Pass True if the high score is allowed to decrease. This can be useful when fixing mistakes or
+	// banning cheaters
+	Force bool `json:"force,omitempty"`
+	// Pass True if the game message should not be automatically edited to include the current scoreboard
+	DisableEditMessage bool `json:"disable_edit_message,omitempty"`
+	// Required if inline_message_id is not specified. Unique identifier for the target chat
+	ChatID int64 `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the sent message
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// SetGameScore calls setGameScore, see https://core.telegram.org/bots/api#setgamescore
+//
+// Use this method to set the score of the specified user in a game message. On success, if the message
+// is not an inline message, the Message is returned, otherwise True is returned. Returns an error, if
+// the new score is not greater than the user's current score in the chat and force is False.
+func SetGameScore(c Client, params SetGameScoreParams) (*Message, error) {
+	return callOrTrue[Message](c, "setGameScore", params)
+}
+
+// SetMessageReactionParams are the parameters of setMessageReaction
+type SetMessageReactionParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Identifier of the target message. If the message belongs to a media group, the reaction is set to
+	// the first non-deleted message in the group instead.
+	MessageID int64 `json:"message_id"`
+	// New list of reaction types to set on the message. Currently, as non-premium users, bots can set up
+	// to one reaction per message. A custom emoji reaction can be used if it is either already present on
+	// the message or explicitly allowed by chat administrators.
+	Reaction []ReactionType `json:"reaction,omitempty"`
+	// Pass True to set the reaction with a big animation
+	IsBig bool `json:"is_big,omitempty"`
+}
+
+// SetMessageReaction calls setMessageReaction, see
+// https://core.telegram.org/bots/api#setmessagereaction
+//
+// Use this method to change the chosen reactions on a message. Service messages can't be reacted to.
+// Automatically forwarded messages from a channel to its discussion group have the same available
+// reactions as messages in the channel. Returns True on success.
+func SetMessageReaction(c Client, params SetMessageReactionParams) (bool, error) {
+	return call[bool](c, "setMessageReaction", params)
+}
+
+// SetMyCommandsParams are the parameters of setMyCommands
+type SetMyCommandsParams struct {
+	// A JSON-serialized list of bot commands to be set as the list of the bot's commands. At most 100
+	// commands can be specified.
+	Commands []BotCommand `json:"commands"`
+	// A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to
+	// BotCommandScopeDefault.
+	Scope *BotCommandScope `json:"scope,omitempty"`
+	// A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given
+	// scope, for whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// SetMyCommands calls setMyCommands, see https://core.telegram.org/bots/api#setmycommands
+//
+// Use this method to change the list of the bot's commands. See this manual for more details about bot
+// commands. Returns True on success.
+func SetMyCommands(c Client, params SetMyCommandsParams) (bool, error) {
+	return call[bool](c, "setMyCommands", params)
+}
+
+// SetMyDefaultAdministratorRightsParams are the parameters of setMyDefaultAdministratorRights
+type SetMyDefaultAdministratorRightsParams struct {
+	// A JSON-serialized object describing new default administrator rights. If not specified, the default
+	// administrator rights will be cleared.
+	Rights *ChatAdministratorRights `json:"rights,omitempty"`
+	// Pass True to change the default administrator rights of the bot in channels. Otherwise, the default
+	// administrator rights of the bot for groups and supergroups will be changed.
+	ForChannels bool `json:"for_channels,omitempty"`
+}
+
+// SetMyDefaultAdministratorRights calls setMyDefaultAdministratorRights, see
+// https://core.telegram.org/bots/api#setmydefaultadministratorrights
+//
+// Use this method to change the default administrator rights requested by the bot when it's added as
+// an administrator to groups or channels. These rights will be suggested to users, but they are free
+// to modify the list before adding the bot. Returns True on success.
+func SetMyDefaultAdministratorRights(c Client, params SetMyDefaultAdministratorRightsParams) (bool, error) {
+	return call[bool](c, "setMyDefaultAdministratorRights", params)
+}
+
+// SetMyDescriptionParams are the parameters of setMyDescription
+type SetMyDescriptionParams struct {
+	// New bot description; 0-512 characters. Pass an empty string to remove the dedicated description for
+	// the given language.
+	Description string `json:"description,omitempty"`
+	// A two-letter ISO 639-1 language code. If empty, the description will be applied to all users for
+	// whose language there is no dedicated description.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// SetMyDescription calls setMyDescription, see https://core.telegram.org/bots/api#setmydescription
+//
+// Use this method to change the bot's description, which is shown in the chat with the bot if the chat
+// is empty. Returns True on success.
+func SetMyDescription(c Client, params SetMyDescriptionParams) (bool, error) {
+	return call[bool](c, "setMyDescription", params)
+}
+
+// SetMyNameParams are the parameters of setMyName
+type SetMyNameParams struct {
+	// New bot name; 0-64 characters. Pass an empty string to remove the dedicated name for the given
+	// language.
+	Name string `json:"name,omitempty"`
+	// A two-letter ISO 639-1 language code. If empty, the name will be shown to all users for whose
+	// language there is no dedicated name.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// SetMyName calls setMyName, see https://core.telegram.org/bots/api#setmyname
+//
+// Use this method to change the bot's name. Returns True on success.
+func SetMyName(c Client, params SetMyNameParams) (bool, error) {
+	return call[bool](c, "setMyName", params)
+}
+
+// SetMyShortDescriptionParams are the parameters of setMyShortDescription
+type SetMyShortDescriptionParams struct {
+	// New short description for the bot; 0-120 characters. Pass an empty string to remove the dedicated
+	// short description for the given language.
+	ShortDescription string `json:"short_description,omitempty"`
+	// A two-letter ISO 639-1 language code. If empty, the short description will be applied to all users
+	// for whose language there is no dedicated short description.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// SetMyShortDescription calls setMyShortDescription, see
+// https://core.telegram.org/bots/api#setmyshortdescription
+//
+// Use this method to change the bot's short description, which is shown on the bot's profile page and
+// is sent together with the link when users share the bot. Returns True on success.
+func SetMyShortDescription(c Client, params SetMyShortDescriptionParams) (bool, error) {
+	return call[bool](c, "setMyShortDescription", params)
+}
+
+// SetPassportDataErrorsParams are the parameters of setPassportDataErrors
+type SetPassportDataErrorsParams struct {
+	// User identifier
+	UserID int64 `json:"user_id"`
+	// A JSON-serialized array describing the errors
+	Errors []PassportElementError `json:"errors"`
+}
+
+// SetPassportDataErrors calls setPassportDataErrors, see
+// https://core.telegram.org/bots/api#setpassportdataerrors
+//
+// Informs a user that some of the Telegram Passport elements they provided contains errors. The user
+// will not be able to re-submit their Passport to you until the errors are fixed (the contents of the
+// field for which you returned the error must change). Returns True on success.
+//
+// Use this if the data submitted by the user doesn't satisfy the standards your service requires for
+// any reason. For example, if a birthday date seems invalid, a submitted document is blurry, a scan
+// shows evidence of tampering, etc. Supply some details in the error message to make sure the user
+// knows how to correct the issues.
+func SetPassportDataErrors(c Client, params SetPassportDataErrorsParams) (bool, error) {
+	return call[bool](c, "setPassportDataErrors", params)
+}
+
+// SetStickerEmojiListParams are the parameters of setStickerEmojiList
+type SetStickerEmojiListParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// A JSON-serialized list of 1-20 emoji associated with the sticker
+	EmojiList []string `json:"emoji_list"`
+}
+
+// SetStickerEmojiList calls setStickerEmojiList, see
+// https://core.telegram.org/bots/api#setstickeremojilist
+//
+// Use this method to change the list of emoji assigned to a regular or custom emoji sticker. The
+// sticker must belong to a sticker set created by the bot. Returns True on success.
+func SetStickerEmojiList(c Client, params SetStickerEmojiListParams) (bool, error) {
+	return call[bool](c, "setStickerEmojiList", params)
+}
+
+// SetStickerKeywordsParams are the parameters of setStickerKeywords
+type SetStickerKeywordsParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// A JSON-serialized list of 0-20 search keywords for the sticker with total length of up to 64
+	// characters
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// SetStickerKeywords calls setStickerKeywords, see
+// https://core.telegram.org/bots/api#setstickerkeywords
+//
+// Use this method to change search keywords assigned to a regular or custom emoji sticker. The sticker
+// must belong to a sticker set created by the bot. Returns True on success.
+func SetStickerKeywords(c Client, params SetStickerKeywordsParams) (bool, error) {
+	return call[bool](c, "setStickerKeywords", params)
+}
+
+// SetStickerMaskPositionParams are the parameters of setStickerMaskPosition
+type SetStickerMaskPositionParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// A JSON-serialized object with the position where the mask should be placed on faces. Omit the
+	// parameter to remove the mask position.
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+}
+
+// SetStickerMaskPosition calls setStickerMaskPosition, see
+// https://core.telegram.org/bots/api#setstickermaskposition
+//
+// Use this method to change the mask position of a mask sticker. The sticker must belong to a sticker
+// set that was created by the bot. Returns True on success.
+func SetStickerMaskPosition(c Client, params SetStickerMaskPositionParams) (bool, error) {
+	return call[bool](c, "setStickerMaskPosition", params)
+}
+
+// SetStickerPositionInSetParams are the parameters of setStickerPositionInSet
+type SetStickerPositionInSetParams struct {
+	// File identifier of the sticker
+	Sticker string `json:"sticker"`
+	// New sticker position in the set, zero-based
+	Position int64 `json:"position"`
+}
+
+// SetStickerPositionInSet calls setStickerPositionInSet, see
+// https://core.telegram.org/bots/api#setstickerpositioninset
+//
+// Use this method to move a sticker in a set created by the bot to a specific position. Returns True
+// on success.
+func SetStickerPositionInSet(c Client, params SetStickerPositionInSetParams) (bool, error) {
+	return call[bool](c, "setStickerPositionInSet", params)
+}
+
+// SetStickerSetThumbnailParams are the parameters of setStickerSetThumbnail
+type SetStickerSetThumbnailParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// User identifier of the sticker set owner
+	UserID int64 `json:"user_id"`
+	// A .WEBP or .PNG image with the thumbnail, must be up to 128 kilobytes in size and have a width and
+	// height of exactly 100px, or a .TGS animation with a thumbnail up to 32 kilobytes in size, or a WEBM
+	// video with the thumbnail up to 32 kilobytes in size. Pass a file_id as a String to send a file that
+	// already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from
+	// the Internet, or upload a new one using multipart/form-data. Animated and video sticker set
+	// thumbnails can't be uploaded via HTTP URL. If omitted, then the thumbnail is dropped and the first
+	// sticker is used as the thumbnail.
+	Thumbnail string `json:"thumbnail,omitempty"`
+	// Format of the thumbnail, must be one of “static” for a .WEBP or .PNG image, “animated” for a
+	// .TGS animation, or “video” for a WEBM video
+	Format string `json:"format"`
+}
+
+// SetStickerSetThumbnail calls setStickerSetThumbnail, see
+// https://core.telegram.org/bots/api#setstickersetthumbnail
+//
+// Use this method to set the thumbnail of a regular or mask sticker set. The format of the thumbnail
+// file must match the format of the stickers in the set. Returns True on success.
+func SetStickerSetThumbnail(c Client, params SetStickerSetThumbnailParams) (bool, error) {
+	return call[bool](c, "setStickerSetThumbnail", params)
+}
+
+// SetStickerSetTitleParams are the parameters of setStickerSetTitle
+type SetStickerSetTitleParams struct {
+	// Sticker set name
+	Name string `json:"name"`
+	// Sticker set title, 1-64 characters
+	Title string `json:"title"`
+}
+
+// SetStickerSetTitle calls setStickerSetTitle, see
+// https://core.telegram.org/bots/api#setstickersettitle
+//
+// Use this method to set the title of a created sticker set. Returns True on success.
+func SetStickerSetTitle(c Client, params SetStickerSetTitleParams) (bool, error) {
+	return call[bool](c, "setStickerSetTitle", params)
+}
+
+// SetWebhookParams are the parameters of setWebhook
+type SetWebhookParams struct {
+	// HTTPS URL to send updates to. Use an empty string to remove webhook integration
+	URL string `json:"url"`
+	// Upload your public key certificate so that the root certificate in use can be checked. See our
+	// self-signed guide for details.
+	Certificate string `json:"certificate,omitempty"`
+	// The fixed IP address which will be used to send webhook requests instead of the IP address resolved
+	// through DNS
+	IPAddress string `json:"ip_address,omitempty"`
+	// The maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery,
+	// 1-100. Defaults to 40. Use lower values to limit the load on your bot's server, and higher values to
+	// increase your bot's throughput.
+	MaxConnections int64 `json:"max_connections,omitempty"`
+	// A JSON-serialized list of the update types you want your bot to receive. For example, specify
+	// ["message", "edited_channel_post", "callback_query"] to only receive updates of these types. See
+	// Update for a complete list of available update types. Specify an empty list to receive all update
+	// types except chat_member, message_reaction, and message_reaction_count (default). If not specified,
+	// the previous setting will be used.
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+	// Pass True to drop all pending updates
+	DropPendingUpdates bool `json:"drop_pending_updates,omitempty"`
+	// A secret token to be sent in a header “X-Telegram-Bot-Api-Secret-Token” in every webhook
+	// request, 1-256 characters. Only characters A-Z, a-z, 0-9, _ and - are allowed. The header is useful
+	// to ensure that the request comes from a webhook set by you.
+	SecretToken string `json:"secret_token,omitempty"`
+}
+
+// SetWebhook calls setWebhook, see https://core.telegram.org/bots/api#setwebhook
+//
+// Use this method to specify a URL and receive incoming updates via an outgoing webhook. Whenever
+// there is an update for the bot, we will send an HTTPS POST request to the specified URL, containing
+// a JSON-serialized Update. In case of an unsuccessful request, we will give up after a reasonable
+// amount of attempts. Returns True on success.
+//
+// If you'd like to make sure that the webhook was set by you, you can specify secret data in the
+// parameter secret_token. If specified, the request will contain a header
+// “X-Telegram-Bot-Api-Secret-Token” with the secret token as content.
+func SetWebhook(c Client, params SetWebhookParams) (bool, error) {
+	return call[bool](c, "setWebhook", params)
+}
+
+// StopMessageLiveLocationParams are the parameters of stopMessageLiveLocation
+type StopMessageLiveLocationParams struct {
+	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of
+	// the target channel (in the format @channelusername)
+	ChatID string `json:"chat_id,omitempty"`
+	// Required if inline_message_id is not specified. Identifier of the message with live location to stop
+	MessageID int64 `json:"message_id,omitempty"`
+	// Required if chat_id and message_id are not specified. Identifier of the inline message
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+	// A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopMessageLiveLocation calls stopMessageLiveLocation, see
+// https://core.telegram.org/bots/api#stopmessagelivelocation
+//
+// Use this method to stop updating a live location message before live_period expires. On success, if
+// the message is not an inline message, the edited Message is returned, otherwise True is returned.
+func StopMessageLiveLocation(c Client, params StopMessageLiveLocationParams) (*Message, error) {
+	return callOrTrue[Message](c, "stopMessageLiveLocation", params)
+}
+
+// StopPollParams are the parameters of stopPoll
+type StopPollParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Identifier of the original message with the poll
+	MessageID int64 `json:"message_id"`
+	// A JSON-serialized object for a new message inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// StopPoll calls stopPoll, see https://core.telegram.org/bots/api#stoppoll
+//
+// Use this method to stop a poll which was sent by the bot. On success, the stopped Poll is returned.
+func StopPoll(c Client, params StopPollParams) (*Poll, error) {
+	return call[*Poll](c, "stopPoll", params)
+}
+
+// UnbanChatMemberParams are the parameters of unbanChatMember
+type UnbanChatMemberParams struct {
+	// Unique identifier for the target group or username of the target supergroup or channel (in the
+	// format @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target user
+	UserID int64 `json:"user_id"`
+	// Do nothing if the user is not banned
+	OnlyIfBanned bool `json:"only_if_banned,omitempty"`
+}
+
+// UnbanChatMember calls unbanChatMember, see https://core.telegram.org/bots/api#unbanchatmember
+//
+// Use this method to unban a previously banned user in a supergroup or channel. The user will not
+// return to the group or channel automatically, but will be able to join via link, etc. The bot must
+// be an administrator for this to work. By default, this method guarantees that after the call the
+// user is not a member of the chat, but will be able to join it. So if the user is a member of the
+// chat they will also be removed from the chat. If you don't want this, use the parameter
+// only_if_banned. Returns True on success.
+func UnbanChatMember(c Client, params UnbanChatMemberParams) (bool, error) {
+	return call[bool](c, "unbanChatMember", params)
+}
+
+// UnbanChatSenderChatParams are the parameters of unbanChatSenderChat
+type UnbanChatSenderChatParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier of the target sender chat
+	SenderChatID int64 `json:"sender_chat_id"`
+}
+
+// UnbanChatSenderChat calls unbanChatSenderChat, see
+// https://core.telegram.org/bots/api#unbanchatsenderchat
+//
+// Use this method to unban a previously banned channel chat in a supergroup or channel. The bot must
+// be an administrator for this to work and must have the appropriate administrator rights. Returns
+// True on success.
+func UnbanChatSenderChat(c Client, params UnbanChatSenderChatParams) (bool, error) {
+	return call[bool](c, "unbanChatSenderChat", params)
+}
+
+// UnhideGeneralForumTopicParams are the parameters of unhideGeneralForumTopic
+type UnhideGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+}
+
+// UnhideGeneralForumTopic calls unhideGeneralForumTopic, see
+// https://core.telegram.org/bots/api#unhidegeneralforumtopic
+//
+// Use this method to unhide the 'General' topic in a forum supergroup chat. The bot must be an
+// administrator in the chat for this to work and must have the can_manage_topics administrator rights.
+// Returns True on success.
+func UnhideGeneralForumTopic(c Client, params UnhideGeneralForumTopicParams) (bool, error) {
+	return call[bool](c, "unhideGeneralForumTopic", params)
+}
+
+// UnpinAllChatMessagesParams are the parameters of unpinAllChatMessages
+type UnpinAllChatMessagesParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+}
+
+// UnpinAllChatMessages calls unpinAllChatMessages, see
+// https://core.telegram.org/bots/api#unpinallchatmessages
+//
+// Use this method to clear the list of pinned messages in a chat. If the chat is not a private chat,
+// the bot must be an administrator in the chat for this to work and must have the 'can_pin_messages'
+// administrator right in a supergroup or 'can_edit_messages' administrator right in a channel. Returns
+// True on success.
+func UnpinAllChatMessages(c Client, params UnpinAllChatMessagesParams) (bool, error) {
+	return call[bool](c, "unpinAllChatMessages", params)
+}
+
+// UnpinAllForumTopicMessagesParams are the parameters of unpinAllForumTopicMessages
+type UnpinAllForumTopicMessagesParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadID int64 `json:"message_thread_id"`
+}
+
+// UnpinAllForumTopicMessages calls unpinAllForumTopicMessages, see
+// https://core.telegram.org/bots/api#unpinallforumtopicmessages
+//
+// Use this method to clear the list of pinned messages in a forum topic. The bot must be an
+// administrator in the chat for this to work and must have the can_pin_messages administrator right in
+// the supergroup. Returns True on success.
+func UnpinAllForumTopicMessages(c Client, params UnpinAllForumTopicMessagesParams) (bool, error) {
+	return call[bool](c, "unpinAllForumTopicMessages", params)
+}
+
+// UnpinAllGeneralForumTopicMessagesParams are the parameters of unpinAllGeneralForumTopicMessages
+type UnpinAllGeneralForumTopicMessagesParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format
+	// @supergroupusername)
+	ChatID string `json:"chat_id"`
+}
+
+// UnpinAllGeneralForumTopicMessages calls unpinAllGeneralForumTopicMessages, see
+// https://core.telegram.org/bots/api#unpinallgeneralforumtopicmessages
+//
+// Use this method to clear the list of pinned messages in a General forum topic. The bot must be an
+// administrator in the chat for this to work and must have the can_pin_messages administrator right in
+// the supergroup. Returns True on success.
+func UnpinAllGeneralForumTopicMessages(c Client, params UnpinAllGeneralForumTopicMessagesParams) (bool, error) {
+	return call[bool](c, "unpinAllGeneralForumTopicMessages", params)
+}
+
+// UnpinChatMessageParams are the parameters of unpinChatMessage
+type UnpinChatMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format
+	// @channelusername)
+	ChatID string `json:"chat_id"`
+	// Identifier of a message to unpin. If not specified, the most recent pinned message (by sending date)
+	// will be unpinned.
+	MessageID int64 `json:"message_id,omitempty"`
+}
+
+// UnpinChatMessage calls unpinChatMessage, see https://core.telegram.org/bots/api#unpinchatmessage
+//
+// Use this method to remove a message from the list of pinned messages in a chat. If the chat is not a
+// private chat, the bot must be an administrator in the chat for this to work and must have the
+// 'can_pin_messages' administrator right in a supergroup or 'can_edit_messages' administrator right in
+// a channel. Returns True on success.
+func UnpinChatMessage(c Client, params UnpinChatMessageParams) (bool, error) {
+	return call[bool](c, "unpinChatMessage", params)
+}
+
+// UploadStickerFileParams are the parameters of uploadStickerFile
+type UploadStickerFileParams struct {
+	// User identifier of sticker file owner
+	UserID int64 `json:"user_id"`
+	// A file with the sticker in .WEBP, .PNG, .TGS, or .WEBM format. See
+	// https://core.telegram.org/stickers for technical requirements.
+	Sticker string `json:"sticker"`
+	// Format of the sticker, must be one of “static”, “animated”, “video”
+	StickerFormat string `json:"sticker_format"`
+}
+
+// UploadStickerFile calls uploadStickerFile, see https://core.telegram.org/bots/api#uploadstickerfile
+//
+// Use this method to upload a file with a sticker for later use in the createNewStickerSet,
+// addStickerToSet, or replaceStickerInSet methods (the file can be used multiple times). Returns the
+// uploaded File on success.
+func UploadStickerFile(c Client, params UploadStickerFileParams) (*File, error) {
+	return call[*File](c, "uploadStickerFile", params)
+}
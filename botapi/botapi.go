@@ -0,0 +1,94 @@
+// Package botapi is the low-level typed Bot API, generated from the spec by cmd/botapigen.
+// The requests are sent by a Client, which is usually a *tg.Bot, so they are scheduled and retried
+// the same way as the ones of the high-level API:
+//
+//	boosts, err := botapi.GetUserChatBoosts(bot, botapi.GetUserChatBoostsParams{
+//		ChatID: "@channel",
+//		UserID: user.ID,
+//	})
+//
+// Files are passed by ID or URL only, use the high-level API to upload them.
+//
+// The unions of the spec, i.e. ReactionType, are the structs of all the fields of their subtypes,
+// the values of their discriminators are the constants, named after the subtypes. The subtypes
+// without a discriminator, i.e. Message of MaybeInaccessibleMessage, are the types of their own too.
+//
+// api.json is the spec in the format of the telegram-bot-api-spec project, update it
+// and run go generate to follow the new versions of the API.
+package botapi
+
+//go:generate go run ../cmd/botapigen -spec api.json -out api.go
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Client sends the requests, it's implemented by *tg.Bot.
+type Client interface {
+	// Raw sends the request and returns the response, if it's ok.
+	Raw(method string, payload interface{}) ([]byte, error)
+}
+
+// call sends the params to the method, decoding the result.
+func call[T any](c Client, method string, params any) (result T, err error) {
+	payload, err := EncodeParams(params)
+	if err != nil {
+		return result, fmt.Errorf("botapi: %s: %v", method, err)
+	}
+	data, err := c.Raw(method, payload)
+	if err != nil {
+		return result, err
+	}
+
+	var resp struct {
+		Result T `json:"result"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return result, fmt.Errorf("botapi: %s: %v", method, err)
+	}
+	return resp.Result, nil
+}
+
+// callOrTrue is call of the methods, which return True instead of the result sometimes, the result is nil then.
+func callOrTrue[T any](c Client, method string, params any) (result *T, err error) {
+	raw, err := call[json.RawMessage](c, method, params)
+	if err != nil || string(raw) == "true" {
+		return nil, err
+	}
+	result = new(T)
+	if err := json.Unmarshal(raw, result); err != nil {
+		return nil, fmt.Errorf("botapi: %s: %v", method, err)
+	}
+	return result, nil
+}
+
+// EncodeParams encodes the params the way the high-level API does: the strings are passed as they are,
+// the rest of the values are JSON-serialized, and the nulls are skipped, so the requests are scheduled
+// by their chat_id. It's used by tg.Bot.Raw to read the params of the payloads, which are not encoded yet.
+func EncodeParams(params any) (map[string]string, error) {
+	if params == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	encoded := make(map[string]string, len(fields))
+	for name, value := range fields {
+		var s string
+		switch {
+		case string(value) == "null":
+		case json.Unmarshal(value, &s) == nil:
+			encoded[name] = s
+		default:
+			encoded[name] = string(value)
+		}
+	}
+	return encoded, nil
+}
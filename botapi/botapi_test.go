@@ -0,0 +1,68 @@
+package botapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testClient struct {
+	method   string
+	payload  interface{}
+	response string
+}
+
+func (c *testClient) Raw(method string, payload interface{}) ([]byte, error) {
+	c.method, c.payload = method, payload
+	return []byte(c.response), nil
+}
+
+func TestCall(t *testing.T) {
+	c := &testClient{response: `{"ok":true,"result":{"boosts":[{"boost_id":"1","source":{"source":"premium","user":{"id":3}}}]}}`}
+	boosts, err := GetUserChatBoosts(c, GetUserChatBoostsParams{ChatID: "@channel", UserID: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "getUserChatBoosts", c.method)
+	assert.Equal(t, map[string]string{"chat_id": "@channel", "user_id": "3"}, c.payload)
+	require.Len(t, boosts.Boosts, 1)
+	assert.Equal(t, ChatBoostSourcePremium, boosts.Boosts[0].Source.Source)
+	assert.EqualValues(t, 3, boosts.Boosts[0].Source.User.ID)
+
+	c.response = `{"ok":true,"result":true}`
+	msg, err := StopMessageLiveLocation(c, StopMessageLiveLocationParams{InlineMessageID: "inline"})
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	c.response = `{"ok":true,"result":{"message_id":4,"chat":{"id":1},"location":{"latitude":1.5,"longitude":2}}}`
+	msg, err = EditMessageLiveLocation(c, EditMessageLiveLocationParams{
+		ChatID:      "1",
+		MessageID:   4,
+		Latitude:    1.5,
+		Longitude:   2,
+		ReplyMarkup: &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{{{Text: "stop", CallbackData: "stop"}}}},
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, msg.MessageID)
+	assert.Equal(t, 1.5, msg.Location.Latitude)
+
+	payload := c.payload.(map[string]string)
+	assert.Equal(t, "1.5", payload["latitude"])
+	assert.JSONEq(t, `{"inline_keyboard":[[{"text":"stop","callback_data":"stop"}]]}`, payload["reply_markup"])
+
+	c.response = `{"ok":true,"result":{"message_id":"oops"}}`
+	_, err = EditMessageLiveLocation(c, EditMessageLiveLocationParams{ChatID: "1", MessageID: 4})
+	assert.ErrorContains(t, err, "botapi: editMessageLiveLocation")
+}
+
+func TestEncodeParams(t *testing.T) {
+	params, err := EncodeParams(map[string]any{"chat_id": -1, "text": "hi", "reply_markup": nil, "entities": []int{1}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"chat_id": "-1", "text": "hi", "entities": "[1]"}, params)
+
+	params, err = EncodeParams(nil)
+	require.NoError(t, err)
+	assert.Nil(t, params)
+
+	_, err = EncodeParams([]string{"chat_id"})
+	assert.Error(t, err)
+}
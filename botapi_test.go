@@ -0,0 +1,81 @@
+package tg
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	gotoken "go/token"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotReact(t *testing.T) {
+	b, _, requests := newJobsTestBot(t, nil)
+	msg := &Message{ID: 2, Chat: &Chat{ID: 1}}
+
+	require.NoError(t, b.React(msg, ReactionFire, true))
+	require.NoError(t, b.SetReactions(msg, ReactionLike, "5368324170671202286"))
+	require.NoError(t, b.SetReactions(msg))
+	// the emojis of several code points aren't the custom ones
+	require.NoError(t, b.React(msg, "👩‍❤️‍👨"))
+
+	assert.Equal(t, []jobRequest{
+		{"setMessageReaction", map[string]string{"chat_id": "1", "message_id": "2", "is_big": "true",
+			"reaction": `[{"type":"emoji","emoji":"🔥"}]`}},
+		{"setMessageReaction", map[string]string{"chat_id": "1", "message_id": "2",
+			"reaction": `[{"type":"emoji","emoji":"👍"},{"type":"custom_emoji","custom_emoji_id":"5368324170671202286"}]`}},
+		{"setMessageReaction", map[string]string{"chat_id": "1", "message_id": "2"}},
+		{"setMessageReaction", map[string]string{"chat_id": "1", "message_id": "2",
+			"reaction": `[{"type":"emoji","emoji":"👩‍❤️‍👨"}]`}},
+	}, requests())
+}
+
+// TestBotAPICoverage reports the methods of the spec, which the bot doesn't call yet,
+// neither by name, nor with the botapi package.
+func TestBotAPICoverage(t *testing.T) {
+	data, err := os.ReadFile("botapi/api.json")
+	require.NoError(t, err)
+	spec := struct {
+		Methods map[string]json.RawMessage `json:"methods"`
+	}{}
+	require.NoError(t, json.Unmarshal(data, &spec))
+
+	used := map[string]bool{}
+	pkgs, err := parser.ParseDir(gotoken.NewFileSet(), ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+	for _, pkg := range pkgs {
+		ast.Inspect(pkg, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.BasicLit:
+				if s, err := strconv.Unquote(node.Value); err == nil && node.Kind == gotoken.STRING {
+					used[s] = true
+				}
+			case *ast.SelectorExpr:
+				if x, ok := node.X.(*ast.Ident); ok && x.Name == "botapi" {
+					used[node.Sel.Name] = true
+				}
+			}
+			return true
+		})
+	}
+
+	uncovered := []string{}
+	for method := range spec.Methods {
+		if !used[method] && !used[strings.ToUpper(method[:1])+method[1:]] {
+			uncovered = append(uncovered, method)
+		}
+	}
+	slices.Sort(uncovered)
+
+	assert.NotContains(t, uncovered, "setMessageReaction")
+	t.Logf("%d of %d methods are covered, not covered: %s",
+		len(spec.Methods)-len(uncovered), len(spec.Methods), strings.Join(uncovered, ", "))
+}
@@ -0,0 +1,391 @@
+// Package broadcast sends a message to lots of recipients: it goes as fast as the bot's Scheduler allows,
+// sorts out the recipients, that can't be reached, and resumes from a checkpoint after a restart.
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/scheduler"
+)
+
+// ErrCancelled is returned by Run, if the broadcast was cancelled.
+var ErrCancelled = errors.New("broadcast: cancelled")
+
+// Progress of a broadcast.
+type Progress struct {
+	// Done is the number of recipients, that are handled in a row from the start, including the skipped on resume.
+	Done int
+	// Sent is the number of successfully sent messages within this run.
+	Sent int
+
+	Blocked     int
+	Deactivated int
+	NotFound    int
+	Migrated    int
+	Failed      int
+}
+
+// Broadcast sends a message to every recipient of the iterator, see New and Copy.
+// Set the fields before Run.
+type Broadcast struct {
+	// Workers is the number of concurrent sends, defaulted to scheduler.ApiRequestQuota.
+	// The actual throughput is limited by the Scheduler of the bot.
+	Workers int
+
+	// Checkpoint keeps the progress, so the broadcast could be resumed after a restart.
+	Checkpoint Checkpoint
+	// CheckpointEvery is the number of recipients between checkpoint saves, defaulted to 100.
+	CheckpointEvery int
+
+	// MaxFloodRetries limits resends to a recipient after 429 errors, defaulted to 5.
+	MaxFloodRetries int
+
+	// OnSent is called for every sent message.
+	OnSent func(to tg.Recipient, msg *tg.Message)
+	// OnBlocked is called, if the bot is blocked, kicked, or has never been started by the recipient.
+	OnBlocked func(to tg.Recipient, err error)
+	// OnDeactivated is called, if the user is deleted.
+	OnDeactivated func(to tg.Recipient, err error)
+	// OnNotFound is called, if the chat does not exist.
+	OnNotFound func(to tg.Recipient, err error)
+	// OnMigrated is called, if the group is migrated to a supergroup, the message is resent there.
+	OnMigrated func(to tg.Recipient, migratedTo int64)
+	// OnFailed is called on the rest of the errors.
+	OnFailed func(to tg.Recipient, err error)
+
+	bot        *tg.Bot
+	recipients Iterator
+	send       func(to tg.Recipient) (*tg.Message, error)
+
+	mu       sync.Mutex
+	paused   bool
+	resume   chan struct{}
+	cancel   context.CancelFunc
+	progress Progress
+}
+
+// New broadcasts what (a string or a tg.Sendable) with Bot.Send. The first message is sent alone,
+// so the media is uploaded once, the rest reuse its FileID.
+func New(b *tg.Bot, recipients Iterator, what interface{}, opts ...interface{}) *Broadcast {
+	bc := newBroadcast(b, recipients)
+
+	mu := sync.Mutex{}
+	first := true
+	bc.send = func(to tg.Recipient) (*tg.Message, error) {
+		mu.Lock()
+		if first {
+			defer mu.Unlock()
+			msg, err := b.Send(to, what, opts...)
+			if err == nil {
+				first = false
+			}
+			return msg, err
+		}
+		mu.Unlock()
+		return b.Send(to, cloneSendable(what), opts...)
+	}
+	return bc
+}
+
+// Copy broadcasts an existing message with copyMessage, see Bot.Copy.
+func Copy(b *tg.Bot, recipients Iterator, msg tg.Editable, opts ...interface{}) *Broadcast {
+	bc := newBroadcast(b, recipients)
+	bc.send = func(to tg.Recipient) (*tg.Message, error) {
+		return b.Copy(to, msg, opts...)
+	}
+	return bc
+}
+
+func newBroadcast(b *tg.Bot, recipients Iterator) *Broadcast {
+	return &Broadcast{bot: b, recipients: recipients, resume: make(chan struct{})}
+}
+
+// cloneSendable makes a shallow copy of a Sendable, as sending modifies it.
+func cloneSendable(what interface{}) interface{} {
+	value := reflect.ValueOf(what)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return what
+	}
+	clone := reflect.New(value.Elem().Type())
+	clone.Elem().Set(value.Elem())
+	return clone.Interface()
+}
+
+// Progress returns the current progress.
+func (bc *Broadcast) Progress() Progress {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	return bc.progress
+}
+
+// Pause stops taking new recipients, the sends in flight are finished.
+func (bc *Broadcast) Pause() {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.paused = true
+}
+
+// Resume continues the paused broadcast.
+func (bc *Broadcast) Resume() {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	if bc.paused {
+		bc.paused = false
+		close(bc.resume)
+		bc.resume = make(chan struct{})
+	}
+}
+
+// Cancel stops the broadcast, Run saves the checkpoint and returns ErrCancelled.
+func (bc *Broadcast) Cancel() {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	if bc.cancel != nil {
+		bc.cancel()
+	}
+}
+
+type item struct {
+	index int
+	to    tg.Recipient
+}
+
+// Run sends the messages, blocking until all the recipients are handled, ctx is cancelled, or the iterator fails.
+// If the Checkpoint has a progress, the recipients that are already done are skipped.
+func (bc *Broadcast) Run(ctx context.Context) (Progress, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	bc.mu.Lock()
+	bc.cancel = cancel
+	bc.mu.Unlock()
+
+	workers := bc.Workers
+	if workers <= 0 {
+		workers = scheduler.ApiRequestQuota
+	}
+	every := bc.CheckpointEvery
+	if every <= 0 {
+		every = 100
+	}
+
+	start, err := bc.skip()
+	if err != nil {
+		return bc.Progress(), err
+	}
+	bc.mu.Lock()
+	bc.progress.Done = start
+	bc.mu.Unlock()
+
+	items := make(chan item)
+	results := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for it := range items {
+				if bc.deliver(ctx, it.to) {
+					results <- it.index
+				}
+			}
+		}()
+	}
+
+	var iterErr, saveErr error
+	go func() {
+		defer close(items)
+		for index := start; ; index++ {
+			if !bc.wait(ctx) {
+				return
+			}
+			to, err := bc.recipients.Next()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				iterErr = err
+				return
+			}
+			select {
+			case items <- item{index, to}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// done is the low watermark: all the recipients before it are handled
+	done, saved := start, start
+	handled := map[int]bool{}
+	for index := range results {
+		handled[index] = true
+		for handled[done] {
+			delete(handled, done)
+			done++
+		}
+
+		bc.mu.Lock()
+		bc.progress.Done = done
+		bc.mu.Unlock()
+		if done-saved >= every && saveErr == nil {
+			if saveErr = bc.save(done); saveErr != nil {
+				cancel()
+			}
+			saved = done
+		}
+	}
+
+	if saveErr == nil {
+		saveErr = bc.save(done)
+	}
+	switch {
+	case iterErr != nil:
+		return bc.Progress(), iterErr
+	case saveErr != nil:
+		return bc.Progress(), saveErr
+	case ctx.Err() != nil:
+		return bc.Progress(), ErrCancelled
+	}
+	return bc.Progress(), nil
+}
+
+// skip skips the recipients, which are already done according to the checkpoint.
+func (bc *Broadcast) skip() (int, error) {
+	if bc.Checkpoint == nil {
+		return 0, nil
+	}
+	done, err := bc.Checkpoint.Load()
+	if err != nil || done == 0 {
+		return 0, err
+	}
+
+	if skipper, ok := bc.recipients.(Skipper); ok {
+		return done, skipper.Skip(done)
+	}
+	for i := 0; i < done; i++ {
+		if _, err := bc.recipients.Next(); err != nil {
+			if err == io.EOF {
+				return i, nil
+			}
+			return i, err
+		}
+	}
+	return done, nil
+}
+
+func (bc *Broadcast) save(done int) error {
+	if bc.Checkpoint == nil {
+		return nil
+	}
+	return bc.Checkpoint.Save(done)
+}
+
+// wait blocks while the broadcast is paused, returns false, if it's cancelled.
+func (bc *Broadcast) wait(ctx context.Context) bool {
+	for {
+		bc.mu.Lock()
+		paused, resume := bc.paused, bc.resume
+		bc.mu.Unlock()
+		if !paused {
+			return ctx.Err() == nil
+		}
+
+		select {
+		case <-resume:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// deliver sends the message to the recipient, classifying the errors.
+// It returns false, if the broadcast is cancelled before the recipient is handled.
+func (bc *Broadcast) deliver(ctx context.Context, to tg.Recipient) bool {
+	maxRetries := bc.MaxFloodRetries
+	if maxRetries <= 0 {
+		maxRetries = 5
+	}
+
+	migrated := false
+	for try := 0; ; try++ {
+		msg, err := bc.send(to)
+
+		var flood tg.FloodError
+		var group tg.GroupError
+		switch {
+		case err == nil:
+			bc.count(func(p *Progress) { p.Sent++ })
+			notify(bc.OnSent, to, msg)
+			return true
+
+		case errors.As(err, &flood) && try < maxRetries:
+			if !bc.sleep(ctx, time.Duration(flood.RetryAfter)*time.Second) {
+				return false
+			}
+			continue
+
+		case errors.As(err, &group) && group.MigratedTo != 0 && !migrated:
+			migrated = true
+			bc.count(func(p *Progress) { p.Migrated++ })
+			if bc.OnMigrated != nil {
+				bc.OnMigrated(to, group.MigratedTo)
+			}
+			to = tg.ChatID(group.MigratedTo)
+			continue
+
+		case errors.Is(err, tg.ErrBlockedByUser), errors.Is(err, tg.ErrNotStartedByUser),
+			errors.Is(err, tg.ErrKickedFromGroup), errors.Is(err, tg.ErrKickedFromSuperGroup),
+			errors.Is(err, tg.ErrKickedFromChannel):
+			bc.count(func(p *Progress) { p.Blocked++ })
+			notify(bc.OnBlocked, to, err)
+
+		case errors.Is(err, tg.ErrUserIsDeactivated):
+			bc.count(func(p *Progress) { p.Deactivated++ })
+			notify(bc.OnDeactivated, to, err)
+
+		case errors.Is(err, tg.ErrChatNotFound):
+			bc.count(func(p *Progress) { p.NotFound++ })
+			notify(bc.OnNotFound, to, err)
+
+		default:
+			bc.count(func(p *Progress) { p.Failed++ })
+			notify(bc.OnFailed, to, fmt.Errorf("broadcast: %s: %w", to.Recipient(), err))
+		}
+		return true
+	}
+}
+
+func (bc *Broadcast) sleep(ctx context.Context, d time.Duration) bool {
+	timer := bc.bot.Clock().NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (bc *Broadcast) count(fn func(p *Progress)) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	fn(&bc.progress)
+}
+
+func notify[T any](fn func(to tg.Recipient, arg T), to tg.Recipient, arg T) {
+	if fn != nil {
+		fn(to, arg)
+	}
+}
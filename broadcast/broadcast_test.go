@@ -0,0 +1,142 @@
+package broadcast
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBot(t *testing.T) (*tg.Bot, func() []string) {
+	mu := sync.Mutex{}
+	sent := []string{}
+	flooded := false
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		params := map[string]string{}
+		_ = json.Unmarshal(data, &params)
+		chat := params["chat_id"]
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch chat {
+		case "2":
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`))
+		case "3":
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":403,"description":"Forbidden: user is deactivated"}`))
+		case "4":
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-1004}}`))
+		case "5":
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`))
+		case "6":
+			if !flooded {
+				flooded = true
+				_, _ = w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 0","parameters":{"retry_after":0}}`))
+				return
+			}
+			fallthrough
+		default:
+			sent = append(sent, chat)
+			_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":` + chat + `}}}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	b, err := tg.NewBot(tg.Settings{Offline: true, URL: srv.URL, Client: srv.Client()})
+	require.NoError(t, err)
+	return b, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		ret := append([]string{}, sent...)
+		sort.Strings(ret)
+		return ret
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	b, sent := newTestBot(t)
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	mu := sync.Mutex{}
+	events := map[string][]string{}
+	record := func(kind string) func(to tg.Recipient, err error) {
+		return func(to tg.Recipient, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			events[kind] = append(events[kind], to.Recipient())
+		}
+	}
+
+	bc := New(b, IDs(1, 2, 3, 4, 5, 6, 7), "hello")
+	bc.Workers = 3
+	bc.Checkpoint = FileCheckpoint(path)
+	bc.OnBlocked = record("blocked")
+	bc.OnDeactivated = record("deactivated")
+	bc.OnNotFound = record("not found")
+	bc.OnMigrated = func(to tg.Recipient, migratedTo int64) {
+		record("migrated")(to, nil)
+	}
+
+	progress, err := bc.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Progress{Done: 7, Sent: 4, Blocked: 1, Deactivated: 1, NotFound: 1, Migrated: 1}, progress)
+	assert.Equal(t, []string{"-1004", "1", "6", "7"}, sent())
+	assert.Equal(t, map[string][]string{
+		"blocked":     {"2"},
+		"deactivated": {"3"},
+		"not found":   {"5"},
+		"migrated":    {"4"},
+	}, events)
+
+	done, err := FileCheckpoint(path).Load()
+	require.NoError(t, err)
+	assert.Equal(t, 7, done)
+
+	// resuming
+	require.NoError(t, os.WriteFile(path, []byte(`{"done":5}`), 0o600))
+	progress, err = New(b, IDs(1, 2, 3, 4, 5, 8, 9), "hello").withCheckpoint(FileCheckpoint(path)).Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Progress{Done: 7, Sent: 2}, progress)
+	assert.Equal(t, []string{"-1004", "1", "6", "7", "8", "9"}, sent())
+}
+
+func TestBroadcastCancel(t *testing.T) {
+	b, sent := newTestBot(t)
+
+	var bc *Broadcast
+	next := 0
+	bc = New(b, IteratorFunc(func() (tg.Recipient, error) {
+		next++
+		if next == 3 {
+			bc.Pause()
+		}
+		return tg.ChatID(next + 10), nil
+	}), "hello")
+	bc.Workers = 1
+
+	go func() {
+		assert.Eventually(t, func() bool { return bc.Progress().Done == 3 }, time.Second, time.Millisecond)
+		bc.Cancel()
+	}()
+	progress, err := bc.Run(context.Background())
+	assert.ErrorIs(t, err, ErrCancelled)
+	assert.Equal(t, 3, progress.Done)
+	assert.Equal(t, []string{"11", "12", "13"}, sent())
+}
+
+func (bc *Broadcast) withCheckpoint(checkpoint Checkpoint) *Broadcast {
+	bc.Checkpoint = checkpoint
+	return bc
+}
@@ -0,0 +1,109 @@
+package broadcast
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/heilkit/tg"
+)
+
+// Iterator yields the recipients of a broadcast, io.EOF means there are no more of them.
+// The order must be stable between runs, so the checkpoint makes sense.
+type Iterator interface {
+	Next() (tg.Recipient, error)
+}
+
+// Skipper is an Iterator, which skips the first n recipients faster, than calling Next n times, i.e. with OFFSET.
+type Skipper interface {
+	Iterator
+	Skip(n int) error
+}
+
+// IteratorFunc is a function, implementing Iterator.
+type IteratorFunc func() (tg.Recipient, error)
+
+func (f IteratorFunc) Next() (tg.Recipient, error) {
+	return f()
+}
+
+// Slice iterates over the recipients.
+func Slice(recipients ...tg.Recipient) Iterator {
+	return &sliceIterator{recipients: recipients}
+}
+
+// IDs iterates over chat ids.
+func IDs(ids ...int64) Iterator {
+	recipients := make([]tg.Recipient, len(ids))
+	for i, id := range ids {
+		recipients[i] = tg.ChatID(id)
+	}
+	return Slice(recipients...)
+}
+
+type sliceIterator struct {
+	recipients []tg.Recipient
+	next       int
+}
+
+func (it *sliceIterator) Next() (tg.Recipient, error) {
+	if it.next >= len(it.recipients) {
+		return nil, io.EOF
+	}
+	it.next++
+	return it.recipients[it.next-1], nil
+}
+
+func (it *sliceIterator) Skip(n int) error {
+	it.next = min(it.next+n, len(it.recipients))
+	return nil
+}
+
+// Checkpoint keeps the number of the recipients, which are done in a row from the start.
+type Checkpoint interface {
+	Load() (int, error)
+	Save(done int) error
+}
+
+// FileCheckpoint keeps the progress in a file, a missing file means no progress.
+func FileCheckpoint(path string) Checkpoint {
+	return fileCheckpoint(path)
+}
+
+type fileCheckpoint string
+
+func (path fileCheckpoint) Load() (int, error) {
+	data, err := os.ReadFile(string(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var state struct {
+		Done int `json:"done"`
+	}
+	err = json.Unmarshal(data, &state)
+	return state.Done, err
+}
+
+func (path fileCheckpoint) Save(done int) error {
+	data, _ := json.Marshal(map[string]int{"done": done})
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(string(path)), filepath.Base(string(path))+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), string(path))
+}
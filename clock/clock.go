@@ -0,0 +1,86 @@
+// Package clock abstracts the time, so the timing of the bot could be driven by tests, see clocktest.
+package clock
+
+import "time"
+
+// Clock is the source of time, timers and tickers.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	Sleep(d time.Duration)
+
+	// NewTimer is time.NewTimer.
+	NewTimer(d time.Duration) Timer
+	// AfterFunc is time.AfterFunc, Timer.C of the result is nil.
+	AfterFunc(d time.Duration, f func()) Timer
+	// NewTicker is time.NewTicker.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is *time.Timer behind an interface.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Ticker is *time.Ticker behind an interface.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// Real is the Clock of the time package.
+func Real() Clock {
+	return realClock{}
+}
+
+// Or returns clk, or Real, if clk is nil.
+func Or(clk Clock) Clock {
+	if clk == nil {
+		return Real()
+	}
+	return clk
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return realTimer{time.AfterFunc(d, f)}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t realTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
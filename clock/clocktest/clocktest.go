@@ -0,0 +1,205 @@
+// Package clocktest provides a fake clock.Clock, which time moves only when told to.
+package clocktest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/heilkit/tg/clock"
+)
+
+// Clock is a fake clock.Clock. Its timers fire in order of their deadlines, while Advance moves the time.
+// AfterFunc functions are called synchronously by Advance, timers with non-positive durations fire on the next one.
+type Clock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*waiter
+}
+
+var _ clock.Clock = &Clock{}
+
+// New returns a fake clock, which shows the start time.
+func New(start time.Time) *Clock {
+	c := &Clock{now: start}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+type waiter struct {
+	clock  *Clock
+	at     time.Time
+	period time.Duration
+	c      chan time.Time
+	f      func()
+	active bool
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Sleep blocks until the clock is advanced by d.
+func (c *Clock) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	<-c.NewTimer(d).C()
+}
+
+func (c *Clock) NewTimer(d time.Duration) clock.Timer {
+	return c.add(d, 0, make(chan time.Time, 1), nil)
+}
+
+func (c *Clock) AfterFunc(d time.Duration, f func()) clock.Timer {
+	return c.add(d, 0, nil, f)
+}
+
+func (c *Clock) NewTicker(d time.Duration) clock.Ticker {
+	if d <= 0 {
+		panic("clocktest: non-positive interval for NewTicker")
+	}
+	return ticker{c.add(d, d, make(chan time.Time, 1), nil)}
+}
+
+func (c *Clock) add(d, period time.Duration, ch chan time.Time, f func()) *waiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w := &waiter{clock: c, at: c.now.Add(d), period: period, c: ch, f: f, active: true}
+	if d <= 0 && ch != nil {
+		w.active = false
+		ch <- c.now
+		return w
+	}
+	c.waiters = append(c.waiters, w)
+	c.cond.Broadcast()
+	return w
+}
+
+// Advance moves the time forward by d, firing the due timers and tickers one by one.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	for {
+		next := c.nextLocked(target)
+		if next == nil {
+			break
+		}
+		if next.at.After(c.now) {
+			c.now = next.at
+		}
+		if next.period > 0 {
+			next.at = next.at.Add(next.period)
+		} else {
+			next.active = false
+			c.removeLocked(next)
+		}
+
+		if next.f != nil {
+			c.mu.Unlock()
+			next.f()
+			c.mu.Lock()
+			continue
+		}
+		select {
+		case next.c <- c.now:
+		default:
+		}
+	}
+	if target.After(c.now) {
+		c.now = target
+	}
+	c.mu.Unlock()
+}
+
+// Set moves the time forward to t, see Advance.
+func (c *Clock) Set(t time.Time) {
+	c.Advance(t.Sub(c.Now()))
+}
+
+// Waiters returns the number of active timers and tickers.
+func (c *Clock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// BlockUntil blocks until there are at least n active timers and tickers,
+// use it to wait for a goroutine to Sleep before advancing the clock.
+func (c *Clock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+func (c *Clock) nextLocked(target time.Time) *waiter {
+	var next *waiter
+	for _, w := range c.waiters {
+		if w.at.After(target) {
+			continue
+		}
+		if next == nil || w.at.Before(next.at) {
+			next = w
+		}
+	}
+	return next
+}
+
+func (c *Clock) removeLocked(w *waiter) {
+	for i, other := range c.waiters {
+		if other == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+func (w *waiter) C() <-chan time.Time {
+	return w.c
+}
+
+func (w *waiter) Stop() bool {
+	c := w.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	active := w.active
+	w.active = false
+	c.removeLocked(w)
+	return active
+}
+
+func (w *waiter) Reset(d time.Duration) bool {
+	c := w.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	active := w.active
+	c.removeLocked(w)
+	w.at = c.now.Add(d)
+	w.active = true
+	c.waiters = append(c.waiters, w)
+	c.cond.Broadcast()
+	return active
+}
+
+type ticker struct {
+	w *waiter
+}
+
+func (t ticker) C() <-chan time.Time {
+	return t.w.c
+}
+
+func (t ticker) Stop() {
+	t.w.Stop()
+}
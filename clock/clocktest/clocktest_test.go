@@ -0,0 +1,65 @@
+package clocktest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClock(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	t.Run("Timers", func(t *testing.T) {
+		c := New(start)
+		fired := []int{}
+		c.AfterFunc(2*time.Second, func() { fired = append(fired, 2) })
+		c.AfterFunc(time.Second, func() {
+			fired = append(fired, 1)
+			assert.Equal(t, start.Add(time.Second), c.Now())
+		})
+		stopped := c.AfterFunc(time.Second, func() { fired = append(fired, 0) })
+		assert.True(t, stopped.Stop())
+		assert.False(t, stopped.Stop())
+
+		timer := c.NewTimer(3 * time.Second)
+		c.Advance(2500 * time.Millisecond)
+		assert.Equal(t, []int{1, 2}, fired)
+		assert.Equal(t, start.Add(2500*time.Millisecond), c.Now())
+		select {
+		case <-timer.C():
+			t.Fatal("the timer fired too early")
+		default:
+		}
+
+		assert.True(t, timer.Reset(time.Second))
+		c.Advance(time.Second)
+		assert.Equal(t, start.Add(3500*time.Millisecond), <-timer.C())
+		assert.Zero(t, c.Waiters())
+	})
+
+	t.Run("Ticker", func(t *testing.T) {
+		c := New(start)
+		ticker := c.NewTicker(time.Second)
+		c.Advance(1500 * time.Millisecond)
+		assert.Equal(t, start.Add(time.Second), <-ticker.C())
+
+		c.Advance(5 * time.Second)
+		assert.Equal(t, start.Add(2*time.Second), <-ticker.C(), "unread ticks are dropped")
+		ticker.Stop()
+		assert.Zero(t, c.Waiters())
+	})
+
+	t.Run("Sleep", func(t *testing.T) {
+		c := New(start)
+		done := make(chan struct{})
+		go func() {
+			c.Sleep(time.Minute)
+			close(done)
+		}()
+
+		c.BlockUntil(1)
+		c.Advance(time.Minute)
+		<-done
+	})
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// commentWidth is the width, the descriptions are wrapped at.
+const commentWidth = 100
+
+// initialisms are the words, which are upper-cased in the Go names.
+var initialisms = map[string]string{"id": "ID", "url": "URL", "ip": "IP", "html": "HTML", "json": "JSON"}
+
+// discriminatorRx matches the description of the field, which tells the subtypes of a union apart,
+// i.e. "always “emoji”" or "must be photo".
+var discriminatorRx = regexp.MustCompile(`always “([^”]+)”|must be ([a-z_]+)$`)
+
+// Generate emits the code of the package from the spec, the types and the methods are sorted by name.
+func Generate(spec *Spec, pkg string) ([]byte, error) {
+	g := &generator{spec: spec}
+	for _, name := range sortedKeys(spec.Types) {
+		if err := g.writeType(spec.Types[name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range sortedKeys(spec.Methods) {
+		if err := g.writeMethod(spec.Methods[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	header := &strings.Builder{}
+	fmt.Fprintf(header, "// Code generated by botapigen from %s. DO NOT EDIT.\n\npackage %s\n\n", spec.Version, pkg)
+	if g.usesJSON {
+		header.WriteString("import \"encoding/json\"\n\n")
+	}
+
+	code, err := format.Source([]byte(header.String() + g.out.String()))
+	if err != nil {
+		return nil, fmt.Errorf("botapigen: %v", err)
+	}
+	return code, nil
+}
+
+type generator struct {
+	spec     *Spec
+	out      strings.Builder
+	usesJSON bool
+}
+
+func (g *generator) writeType(t *Type) error {
+	if g.merged(t) {
+		return nil
+	}
+
+	fields, description := t.Fields, t.Description
+	if len(t.Subtypes) > 0 {
+		var err error
+		if fields, err = g.unionFields(t); err != nil {
+			return err
+		}
+		description = append(slices.Clone(description), "The fields of "+strings.Join(t.Subtypes, ", ")+" are merged.")
+	}
+
+	writeComment(&g.out, fmt.Sprintf("%s is the %s object, see %s", t.Name, t.Name, t.Href), description)
+	fmt.Fprintf(&g.out, "type %s struct {\n", t.Name)
+	if err := g.writeFields(fields, false); err != nil {
+		return fmt.Errorf("botapigen: %s: %v", t.Name, err)
+	}
+	g.out.WriteString("}\n\n")
+
+	if len(t.Subtypes) > 0 {
+		g.writeDiscriminators(t)
+	}
+	return nil
+}
+
+// merged tells, if the type is merged into its union. Only the subtypes with a discriminator are,
+// the rest, i.e. Message of MaybeInaccessibleMessage, are the types of their own too.
+func (g *generator) merged(t *Type) bool {
+	if len(t.SubtypeOf) == 0 {
+		return false
+	}
+	_, _, ok := discriminator(t)
+	return ok
+}
+
+// discriminator returns the field, which tells the subtype of a union apart, and its value.
+func discriminator(t *Type) (string, string, bool) {
+	for _, field := range t.Fields {
+		if match := discriminatorRx.FindStringSubmatch(field.Description); match != nil {
+			return field.Name, match[1] + match[2], true
+		}
+	}
+	return "", "", false
+}
+
+// unionFields merges the fields of the subtypes, a field is required, if it's required in every subtype.
+func (g *generator) unionFields(t *Type) ([]Field, error) {
+	var fields []Field
+	count := map[string]int{}
+	required := map[string]int{}
+	for _, name := range t.Subtypes {
+		sub, ok := g.spec.Types[name]
+		if !ok {
+			return nil, fmt.Errorf("botapigen: %s: unknown subtype %s", t.Name, name)
+		}
+		for _, field := range sub.Fields {
+			if count[field.Name] == 0 {
+				fields = append(fields, field)
+			}
+			count[field.Name]++
+			if field.Required {
+				required[field.Name]++
+			}
+		}
+	}
+	for i := range fields {
+		fields[i].Required = required[fields[i].Name] == len(t.Subtypes)
+		if loc := discriminatorRx.FindStringIndex(fields[i].Description); loc != nil {
+			prefix := strings.TrimRight(fields[i].Description[:loc[0]], " ,")
+			fields[i].Description = prefix + ", one of " + strings.Join(t.Subtypes, ", ")
+		}
+	}
+	return fields, nil
+}
+
+// writeDiscriminators writes the constants of the values of the field, which tells the subtypes of the union apart.
+func (g *generator) writeDiscriminators(t *Type) {
+	consts := &strings.Builder{}
+	for _, name := range t.Subtypes {
+		sub := g.spec.Types[name]
+		field, value, ok := discriminator(sub)
+		if !ok {
+			continue
+		}
+		writeComment(consts, fmt.Sprintf("%s is the %s of %s, see %s", name, field, t.Name, sub.Href), sub.Description)
+		fmt.Fprintf(consts, "%s = %q\n", name, value)
+	}
+	if consts.Len() > 0 {
+		g.out.WriteString("const (\n" + consts.String() + ")\n\n")
+	}
+}
+
+func (g *generator) writeMethod(m *Method) error {
+	name := exportedName(m.Name)
+	// i.e. the edits return the message, or True for the inline ones, the latter is nil then
+	orTrue := len(m.Returns) == 2 && m.Returns[1] == "Boolean"
+	call, types := "call", m.Returns
+	if orTrue {
+		call, types = "callOrTrue", types[:1]
+	}
+	result, err := g.goType(types, orTrue, false)
+	if err != nil {
+		return fmt.Errorf("botapigen: %s: %v", m.Name, err)
+	}
+	returns := result
+	if orTrue {
+		returns = "*" + result
+	}
+
+	params := ""
+	if len(m.Fields) > 0 {
+		params = name + "Params"
+		writeComment(&g.out, fmt.Sprintf("%s are the parameters of %s", params, m.Name), nil)
+		fmt.Fprintf(&g.out, "type %s struct {\n", params)
+		if err := g.writeFields(m.Fields, true); err != nil {
+			return fmt.Errorf("botapigen: %s: %v", m.Name, err)
+		}
+		g.out.WriteString("}\n\n")
+	}
+
+	writeComment(&g.out, fmt.Sprintf("%s calls %s, see %s", name, m.Name, m.Href), m.Description)
+	if params == "" {
+		fmt.Fprintf(&g.out, "func %s(c Client) (%s, error) {\n", name, returns)
+		fmt.Fprintf(&g.out, "return %s[%s](c, %q, nil)\n}\n\n", call, result, m.Name)
+	} else {
+		fmt.Fprintf(&g.out, "func %s(c Client, params %s) (%s, error) {\n", name, params, returns)
+		fmt.Fprintf(&g.out, "return %s[%s](c, %q, params)\n}\n\n", call, result, m.Name)
+	}
+	return nil
+}
+
+func (g *generator) writeFields(fields []Field, params bool) error {
+	for _, field := range fields {
+		typ, err := g.goType(field.Types, field.Required, params)
+		if err != nil {
+			return fmt.Errorf("%s: %v", field.Name, err)
+		}
+		tag := field.Name
+		if !field.Required {
+			tag += ",omitempty"
+		}
+
+		writeComment(&g.out, field.Description, nil)
+		fmt.Fprintf(&g.out, "%s %s `json:\"%s\"`\n", exportedName(field.Name), typ, tag)
+	}
+	return nil
+}
+
+// goType maps the spec types to the Go one. The optional objects are pointers. Several types are
+// a string, if they are the IDs, i.e. "Integer or String", otherwise any in the params, and the raw JSON elsewhere.
+func (g *generator) goType(types []string, required, params bool) (string, error) {
+	switch {
+	case len(types) == 0:
+		return "", fmt.Errorf("no type")
+	case len(types) > 1:
+		ids := true
+		for _, t := range types {
+			ids = ids && (t == "Integer" || t == "String" || t == "InputFile")
+		}
+		switch {
+		case ids:
+			return "string", nil
+		case params:
+			return "any", nil
+		}
+		g.usesJSON = true
+		return "json.RawMessage", nil
+	}
+
+	t := types[0]
+	if elem, ok := strings.CutPrefix(t, "Array of "); ok {
+		typ, err := g.goType([]string{elem}, true, params)
+		return "[]" + typ, err
+	}
+
+	switch t {
+	case "Integer":
+		return "int64", nil
+	case "Float", "Float number":
+		return "float64", nil
+	case "String", "InputFile":
+		return "string", nil
+	case "Boolean", "True":
+		return "bool", nil
+	}
+
+	typ, ok := g.spec.Types[t]
+	if !ok {
+		return "", fmt.Errorf("unknown type %s", t)
+	}
+	if g.merged(typ) {
+		t = typ.SubtypeOf[0]
+	}
+	if !required {
+		return "*" + t, nil
+	}
+	return t, nil
+}
+
+// exportedName makes the Go name of the snake or camel cased one, i.e. chat_id is ChatID.
+func exportedName(name string) string {
+	b := strings.Builder{}
+	for _, word := range strings.Split(name, "_") {
+		if word == "" {
+			continue
+		}
+		if initialism, ok := initialisms[word]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+	return b.String()
+}
+
+// writeComment writes the title and the wrapped paragraphs as a comment.
+func writeComment(b *strings.Builder, title string, paragraphs []string) {
+	lines := wrap(title)
+	for _, p := range paragraphs {
+		lines = append(lines, "")
+		lines = append(lines, wrap(p)...)
+	}
+	for _, line := range lines {
+		if line == "" {
+			b.WriteString("//\n")
+		} else {
+			b.WriteString("// " + line + "\n")
+		}
+	}
+}
+
+func wrap(text string) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > commentWidth {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	spec, err := LoadSpec("../../botapi/api.json")
+	require.NoError(t, err)
+	code, err := Generate(spec, "botapi")
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile("../../botapi/api.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(generated), string(code), "botapi is outdated, run go generate ./botapi")
+}
+
+func TestGenerateErrors(t *testing.T) {
+	spec := &Spec{Types: map[string]*Type{
+		"Message": {Name: "Message", Fields: []Field{{Name: "chat", Types: []string{"Chat"}, Required: true}}},
+	}}
+	_, err := Generate(spec, "botapi")
+	assert.ErrorContains(t, err, "unknown type Chat")
+}
+
+func TestExportedName(t *testing.T) {
+	assert.Equal(t, "ChatID", exportedName("chat_id"))
+	assert.Equal(t, "ReplyToMessage", exportedName("reply_to_message"))
+	assert.Equal(t, "GetMe", exportedName("getMe"))
+	assert.Equal(t, "URL", exportedName("url"))
+}
+
+func TestGenerateUnions(t *testing.T) {
+	str := func(name, description string) Field {
+		return Field{Name: name, Types: []string{"String"}, Required: true, Description: description}
+	}
+	spec := &Spec{Types: map[string]*Type{
+		"Scope":        {Name: "Scope", Subtypes: []string{"ScopeDefault", "ScopeChat"}},
+		"ScopeDefault": {Name: "ScopeDefault", SubtypeOf: []string{"Scope"}, Fields: []Field{str("type", "Scope type, must be default")}},
+		"ScopeChat": {Name: "ScopeChat", SubtypeOf: []string{"Scope"}, Fields: []Field{
+			str("type", "Scope type, must be chat"),
+			str("chat_id", "Unique identifier for the target chat"),
+		}},
+		"Content":     {Name: "Content", Subtypes: []string{"TextContent"}},
+		"TextContent": {Name: "TextContent", SubtypeOf: []string{"Content"}, Fields: []Field{str("text", "Text of the message")}},
+		"Command":     {Name: "Command", Fields: []Field{{Name: "scope", Types: []string{"ScopeChat"}, Description: "Scope"}}},
+	}}
+	code, err := Generate(spec, "botapi")
+	require.NoError(t, err)
+
+	assert.Contains(t, string(code), `ScopeDefault = "default"`)
+	assert.Contains(t, string(code), `ScopeChat = "chat"`)
+	assert.NotContains(t, string(code), "type ScopeChat struct")
+	assert.Contains(t, string(code), "Scope *Scope `json:\"scope,omitempty\"`")
+	// no discriminator, the subtype is a type of its own
+	assert.Contains(t, string(code), "type TextContent struct")
+	assert.Contains(t, string(code), "type Content struct")
+}
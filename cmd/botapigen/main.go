@@ -0,0 +1,31 @@
+// Command botapigen generates the botapi package from a local copy of the Bot API spec:
+//
+//	go run ./cmd/botapigen -spec botapi/api.json -out botapi/api.go
+//
+// It emits a struct for every type, a params struct and a function for every method.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+)
+
+func main() {
+	specPath := flag.String("spec", "api.json", "path of the spec")
+	out := flag.String("out", "api.go", "path of the generated file")
+	pkg := flag.String("pkg", "botapi", "package of the generated file")
+	flag.Parse()
+
+	spec, err := LoadSpec(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := Generate(spec, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Spec is the Bot API spec in the format of the telegram-bot-api-spec project.
+type Spec struct {
+	Version     string             `json:"version"`
+	ReleaseDate string             `json:"release_date"`
+	Methods     map[string]*Method `json:"methods"`
+	Types       map[string]*Type   `json:"types"`
+}
+
+// Method of the API.
+type Method struct {
+	Name        string   `json:"name"`
+	Href        string   `json:"href"`
+	Description []string `json:"description"`
+	Returns     []string `json:"returns"`
+	Fields      []Field  `json:"fields"`
+}
+
+// Type of the API, it's either an object with the fields, or a union of the subtypes.
+type Type struct {
+	Name        string   `json:"name"`
+	Href        string   `json:"href"`
+	Description []string `json:"description"`
+	Fields      []Field  `json:"fields"`
+	Subtypes    []string `json:"subtypes"`
+	SubtypeOf   []string `json:"subtype_of"`
+}
+
+// Field of a type or a parameter of a method.
+type Field struct {
+	Name        string   `json:"name"`
+	Types       []string `json:"types"`
+	Required    bool     `json:"required"`
+	Description string   `json:"description"`
+}
+
+// LoadSpec reads the spec from the file.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("botapigen: %v", err)
+	}
+	spec := &Spec{}
+	if err := json.Unmarshal(data, spec); err != nil {
+		return nil, fmt.Errorf("botapigen: %s: %v", path, err)
+	}
+	return spec, nil
+}
@@ -2,6 +2,7 @@ package tg
 
 import (
 	"errors"
+	"github.com/heilkit/tg/clock"
 	"strings"
 	"sync"
 	"time"
@@ -129,8 +130,9 @@ type Context interface {
 
 	// DeleteAfter waits for the duration to elapse and then removes the
 	// message. It handles an error automatically using b.OnError callback.
+	// The deletion is a job (see Settings.Jobs), so it could survive restarts.
 	// It returns a Timer that can be used to cancel the call using its Stop method.
-	DeleteAfter(d time.Duration) *time.Timer
+	DeleteAfter(d time.Duration) clock.Timer
 
 	// Notify updates the chat action for the current recipient.
 	// See Notify from bot.go.
@@ -166,6 +168,7 @@ type nativeContext struct {
 	u     Update
 	lock  sync.RWMutex
 	store map[string]interface{}
+	reply *webhookReply
 }
 
 func (c *nativeContext) Bot() *Bot {
@@ -368,8 +371,10 @@ func (c *nativeContext) Args() []string {
 }
 
 func (c *nativeContext) Send(what interface{}, opts ...interface{}) error {
-	_, err := c.b.Send(c.Recipient(), what, opts...)
-	return err
+	return c.reply.send(func(rec *jobRecorder) error {
+		_, err := c.b.send(rec, c.Recipient(), what, opts...)
+		return err
+	})
 }
 
 func (c *nativeContext) SendAlbum(a Album, opts ...interface{}) error {
@@ -382,8 +387,10 @@ func (c *nativeContext) Reply(what interface{}, opts ...interface{}) error {
 	if msg == nil {
 		return ErrBadContext
 	}
-	_, err := c.b.Reply(msg, what, opts...)
-	return err
+	return c.reply.send(func(rec *jobRecorder) error {
+		_, err := c.b.reply(rec, msg, what, opts...)
+		return err
+	})
 }
 
 func (c *nativeContext) Forward(msg Editable, opts ...interface{}) error {
@@ -448,12 +455,13 @@ func (c *nativeContext) Delete() error {
 	return c.b.Delete(msg)
 }
 
-func (c *nativeContext) DeleteAfter(d time.Duration) *time.Timer {
-	return time.AfterFunc(d, func() {
-		if err := c.Delete(); err != nil {
-			c.b.OnError(err, c)
-		}
-	})
+func (c *nativeContext) DeleteAfter(d time.Duration) clock.Timer {
+	msg := c.Message()
+	if msg == nil {
+		c.b.OnError(ErrBadContext, c)
+		return c.b.deleteAfter(d, c)
+	}
+	return c.b.deleteAfter(d, c, msg)
 }
 
 func (c *nativeContext) Notify(action ChatAction) error {
@@ -478,7 +486,9 @@ func (c *nativeContext) Respond(resp ...*CallbackResponse) error {
 	if c.u.Callback == nil {
 		return errors.New("telebot: context callback is nil")
 	}
-	return c.b.Respond(c.u.Callback, resp...)
+	return c.reply.send(func(rec *jobRecorder) error {
+		return c.b.respond(rec, c.u.Callback, resp...)
+	})
 }
 
 func (c *nativeContext) Answer(resp *QueryResponse) error {
@@ -0,0 +1,202 @@
+package tg
+
+import (
+	"fmt"
+	"github.com/heilkit/tg/clock"
+	"github.com/heilkit/tg/cron"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// CronJob is a recurring job, see Bot.Cron.
+type CronJob struct {
+	Schedule *cron.Schedule
+	Chats    []*Chat
+
+	handler HandlerFunc
+	runner  *cronRunner
+	timer   clock.Timer
+	next    time.Time
+	running bool
+}
+
+// Next returns the time of the next run, zero time means the job is not scheduled.
+func (job *CronJob) Next() time.Time {
+	job.runner.mu.Lock()
+	defer job.runner.mu.Unlock()
+	return job.next
+}
+
+// Cron runs the handler on the cron schedule (see package cron), while the bot is started.
+// The handler gets a synthetic Context (see Bot.NewContext) of a message in each of the chats in turn,
+// so c.Send() posts to the chat. Without chats, the Context is empty.
+//
+// Runs of a job never overlap: if the previous run is not finished yet, the next one is skipped.
+// Bot.Stop waits for the running jobs to finish.
+func (b *Bot) Cron(spec string, handler HandlerFunc, chats ...Recipient) (*CronJob, error) {
+	schedule, err := cron.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &CronJob{Schedule: schedule, handler: handler, runner: b.crons}
+	for _, to := range chats {
+		chat, err := cronChat(to)
+		if err != nil {
+			return nil, err
+		}
+		job.Chats = append(job.Chats, chat)
+	}
+
+	b.crons.add(job)
+	return job, nil
+}
+
+// RemoveCron stops scheduling the job, its current run is not interrupted.
+func (b *Bot) RemoveCron(job *CronJob) {
+	b.crons.remove(job)
+}
+
+func cronChat(to Recipient) (*Chat, error) {
+	switch to := to.(type) {
+	case *Chat:
+		return to, nil
+	case *User:
+		return &Chat{ID: to.ID, Type: ChatPrivate, Username: to.Username}, nil
+	}
+
+	id, err := strconv.ParseInt(to.Recipient(), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("telebot: cron chat %q must be a numeric id", to.Recipient())
+	}
+	return &Chat{ID: id}, nil
+}
+
+// cronRunner arms the jobs, while the bot is started.
+type cronRunner struct {
+	bot *Bot
+
+	mu      sync.Mutex
+	jobs    map[*CronJob]struct{}
+	started bool
+}
+
+func newCronRunner(b *Bot) *cronRunner {
+	return &cronRunner{bot: b, jobs: make(map[*CronJob]struct{})}
+}
+
+func (runner *cronRunner) add(job *CronJob) {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	runner.jobs[job] = struct{}{}
+	if runner.started {
+		runner.armLocked(job, runner.bot.clock.Now())
+	}
+}
+
+func (runner *cronRunner) remove(job *CronJob) {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	delete(runner.jobs, job)
+	runner.disarmLocked(job)
+}
+
+func (runner *cronRunner) start() {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	runner.started = true
+	now := runner.bot.clock.Now()
+	for job := range runner.jobs {
+		runner.armLocked(job, now)
+	}
+}
+
+// stop disarms the jobs, the running ones are waited for by Bot.StopContext.
+func (runner *cronRunner) stop() {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	runner.started = false
+	for job := range runner.jobs {
+		runner.disarmLocked(job)
+	}
+}
+
+func (runner *cronRunner) armLocked(job *CronJob, after time.Time) {
+	runner.disarmLocked(job)
+
+	clk := runner.bot.clock
+	job.next = job.Schedule.Next(after)
+	if job.next.IsZero() {
+		return
+	}
+	job.timer = clk.AfterFunc(job.next.Sub(clk.Now()), func() { runner.fire(job) })
+}
+
+func (runner *cronRunner) disarmLocked(job *CronJob) {
+	if job.timer != nil {
+		job.timer.Stop()
+		job.timer = nil
+	}
+	job.next = time.Time{}
+}
+
+func (runner *cronRunner) fire(job *CronJob) {
+	runner.mu.Lock()
+	if _, ok := runner.jobs[job]; !ok || !runner.started {
+		runner.mu.Unlock()
+		return
+	}
+
+	now := runner.bot.clock.Now()
+	if now.Before(job.next) {
+		now = job.next
+	}
+	runner.armLocked(job, now)
+
+	if job.running {
+		runner.mu.Unlock()
+		runner.bot.debug(fmt.Errorf("telebot: cron %s is still running, the run is skipped", job.Schedule))
+		return
+	}
+	job.running = true
+	runner.bot.inflight.add()
+	runner.mu.Unlock()
+
+	go func() {
+		defer runner.bot.inflight.done()
+		runner.run(job, now)
+
+		runner.mu.Lock()
+		job.running = false
+		runner.mu.Unlock()
+	}()
+}
+
+func (runner *cronRunner) run(job *CronJob, at time.Time) {
+	b := runner.bot
+	contexts := []Context{}
+	for _, chat := range job.Chats {
+		contexts = append(contexts, b.NewContext(Update{Message: &Message{Chat: chat, Unixtime: at.Unix()}}))
+	}
+	if len(contexts) == 0 {
+		contexts = append(contexts, b.NewContext(Update{}))
+	}
+
+	for _, c := range contexts {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					b.OnError(fmt.Errorf("panic at tg cron %s: %v", job.Schedule, r), c)
+				}
+			}()
+			if err := job.handler(c); err != nil {
+				b.OnError(err, c)
+			}
+		}()
+	}
+}
@@ -0,0 +1,225 @@
+// Package cron parses standard 5-field cron expressions: minute, hour, day of month, month and day of week.
+//
+// Fields support `*`, `?`, lists `1,5`, ranges `1-5`, steps `*/15`, `10-40/10`, and names of months `jan-dec`
+// and days of week `sun-sat`, both 0 and 7 are Sunday. If both day of month and day of week are restricted,
+// a day matching either of them fits, as in the classic cron.
+//
+// Descriptors @yearly (@annually), @monthly, @weekly, @daily (@midnight) and @hourly are supported as well.
+// The time zone could be set with the `CRON_TZ=Europe/Amsterdam` (or `TZ=`) prefix.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	domStar, dowStar bool
+	location         *time.Location
+	expr             string
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	descriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// Parse parses the expression in the local time zone, unless it has the CRON_TZ= prefix.
+func Parse(expr string) (*Schedule, error) {
+	return ParseIn(expr, time.Local)
+}
+
+// MustParse is Parse, which panics on error.
+func MustParse(expr string) *Schedule {
+	schedule, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return schedule
+}
+
+// ParseIn parses the expression in the time zone loc, unless it has the CRON_TZ= prefix.
+func ParseIn(expr string, loc *time.Location) (*Schedule, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	spec := strings.TrimSpace(expr)
+
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if !strings.HasPrefix(spec, prefix) {
+			continue
+		}
+		zone, rest, _ := strings.Cut(strings.TrimPrefix(spec, prefix), " ")
+		var err error
+		if loc, err = time.LoadLocation(zone); err != nil {
+			return nil, fmt.Errorf("cron: bad time zone %q: %v", zone, err)
+		}
+		spec = strings.TrimSpace(rest)
+		break
+	}
+
+	if descriptor, ok := descriptors[strings.ToLower(spec)]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron: %q must have 5 fields, got %d", expr, len(fields))
+	}
+
+	schedule := &Schedule{location: loc, expr: expr}
+	var err error
+	if schedule.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if schedule.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if schedule.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if schedule.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if schedule.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow = schedule.dow&^(1<<7) | 1
+	}
+	schedule.domStar = isStar(fields[2])
+	schedule.dowStar = isStar(fields[4])
+	return schedule, nil
+}
+
+func isStar(spec string) bool {
+	return spec == "*" || spec == "?"
+}
+
+func (f field) parse(spec string) (uint64, error) {
+	ret := uint64(0)
+	for _, part := range strings.Split(spec, ",") {
+		rng, stepSpec, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepSpec); err != nil || step <= 0 {
+				return 0, fmt.Errorf("cron: bad step %q of %s", stepSpec, f.name)
+			}
+		}
+
+		from, to := f.min, f.max
+		switch {
+		case isStar(rng):
+			if f.max == 7 {
+				to = 6
+			}
+		default:
+			lo, hi, isRange := strings.Cut(rng, "-")
+			var err error
+			if from, err = f.value(lo); err != nil {
+				return 0, err
+			}
+			to = from
+			if isRange {
+				if to, err = f.value(hi); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				to = f.max
+			}
+			if from > to {
+				return 0, fmt.Errorf("cron: bad range %q of %s", rng, f.name)
+			}
+		}
+
+		for i := from; i <= to; i += step {
+			ret |= 1 << i
+		}
+	}
+	return ret, nil
+}
+
+func (f field) value(spec string) (int, error) {
+	if value, ok := f.names[strings.ToLower(spec)]; ok {
+		return value, nil
+	}
+	value, err := strconv.Atoi(spec)
+	if err != nil || value < f.min || value > f.max {
+		return 0, fmt.Errorf("cron: bad %s %q, must be %d-%d", f.name, spec, f.min, f.max)
+	}
+	return value, nil
+}
+
+// Location returns the time zone of the schedule.
+func (schedule *Schedule) Location() *time.Location {
+	return schedule.location
+}
+
+func (schedule *Schedule) String() string {
+	return schedule.expr
+}
+
+// Next returns the first time after t, which matches the schedule, or zero time, if there is none within 5 years.
+func (schedule *Schedule) Next(t time.Time) time.Time {
+	t = t.In(schedule.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case !has(schedule.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, schedule.location)
+		case !schedule.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, schedule.location)
+		case !has(schedule.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, schedule.location)
+		case !has(schedule.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (schedule *Schedule) dayMatches(t time.Time) bool {
+	dom := has(schedule.dom, t.Day())
+	dow := has(schedule.dow, int(t.Weekday()))
+	if schedule.domStar || schedule.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func has(set uint64, i int) bool {
+	return set&(1<<i) != 0
+}
@@ -0,0 +1,68 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, expr := range []string{
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * foo *",
+		"CRON_TZ=Nowhere/Nothing * * * * *",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestNext(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+	at := func(value string) time.Time {
+		ret, err := time.ParseInLocation("2006-01-02 15:04", value, time.UTC)
+		require.NoError(t, err)
+		return ret
+	}
+
+	tests := []struct {
+		expr string
+		from string
+		next string
+	}{
+		{"* * * * *", "2024-01-01 10:00", "2024-01-01 10:01"},
+		{"*/15 * * * *", "2024-01-01 10:07", "2024-01-01 10:15"},
+		{"0 9 * * mon-fri", "2024-01-05 09:00", "2024-01-08 09:00"},
+		{"30 18 * * 7", "2024-01-01 00:00", "2024-01-07 18:30"},
+		{"0 0 1,15 * *", "2024-01-02 00:00", "2024-01-15 00:00"},
+		{"0 0 13 * fri", "2024-01-01 00:00", "2024-01-05 00:00"},
+		{"0 0 29 feb *", "2024-03-01 00:00", "2028-02-29 00:00"},
+		{"10-40/10 3 * * *", "2024-01-01 03:20", "2024-01-01 03:30"},
+		{"@weekly", "2024-01-01 00:00", "2024-01-07 00:00"},
+		{"@hourly", "2024-01-01 23:59", "2024-01-02 00:00"},
+		{"CRON_TZ=Europe/Amsterdam 0 9 * * *", "2024-07-01 06:00", "2024-07-01 07:00"},
+		{"TZ=Europe/Amsterdam 0 9 * * *", "2024-01-01 09:00", "2024-01-02 08:00"},
+	}
+	for _, test := range tests {
+		schedule, err := ParseIn(test.expr, time.UTC)
+		require.NoError(t, err, test.expr)
+		assert.Equal(t, at(test.next).UTC(), schedule.Next(at(test.from)).UTC(), test.expr)
+	}
+
+	schedule := MustParse("CRON_TZ=Europe/Amsterdam 30 2 * * *")
+	assert.Equal(t, amsterdam, schedule.Location())
+	next := schedule.Next(time.Date(2024, 3, 30, 12, 0, 0, 0, amsterdam))
+	assert.Equal(t, time.Date(2024, 4, 1, 2, 30, 0, 0, amsterdam), next, "2:30 is skipped on DST switch")
+
+	assert.True(t, MustParse("0 0 31 feb *").Next(at("2024-01-01 00:00")).IsZero())
+}
@@ -0,0 +1,75 @@
+package tg
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type usernameRecipient string
+
+func (r usernameRecipient) Recipient() string {
+	return string(r)
+}
+
+func TestCron(t *testing.T) {
+	clk := clocktest.New(time.Date(2024, 1, 1, 10, 0, 30, 0, time.Local))
+	b, err := NewBot(Settings{Offline: true, Clock: clk})
+	require.NoError(t, err)
+
+	_, err = b.Cron("* * * *", func(c Context) error { return nil })
+	assert.Error(t, err)
+	_, err = b.Cron("* * * * *", func(c Context) error { return nil }, usernameRecipient("@channel"))
+	assert.Error(t, err)
+
+	mu := sync.Mutex{}
+	runs := []Recipient{}
+	release := make(chan struct{})
+	job, err := b.Cron("*/2 * * * *", func(c Context) error {
+		mu.Lock()
+		runs = append(runs, c.Recipient())
+		mu.Unlock()
+		<-release
+		return nil
+	}, ChatID(-100), &User{ID: 1})
+	require.NoError(t, err)
+	assert.True(t, job.Next().IsZero(), "jobs are armed on start")
+
+	b.crons.start()
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 2, 0, 0, time.Local), job.Next())
+
+	clk.Advance(2 * time.Minute)
+	clk.Advance(2 * time.Minute)
+	release <- struct{}{}
+	release <- struct{}{}
+	require.Eventually(t, func() bool { return clk.Waiters() == 1 && !isCronRunning(job) }, time.Second, time.Millisecond)
+
+	mu.Lock()
+	assert.Len(t, runs, 2, "overlapping run is skipped")
+	assert.Equal(t, &Chat{ID: -100}, runs[0])
+	assert.Equal(t, &Chat{ID: 1, Type: ChatPrivate}, runs[1])
+	mu.Unlock()
+
+	clk.Advance(2 * time.Minute)
+	close(release)
+	b.crons.stop()
+	_, err = b.inflight.wait(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, runs, 4)
+	assert.Zero(t, clk.Waiters())
+
+	b.RemoveCron(job)
+	b.crons.start()
+	assert.Zero(t, clk.Waiters())
+}
+
+func isCronRunning(job *CronJob) bool {
+	job.runner.mu.Lock()
+	defer job.runner.mu.Unlock()
+	return job.running
+}
@@ -0,0 +1,281 @@
+package tg
+
+import (
+	"runtime"
+	"sync"
+)
+
+// DispatcherSettings enable the dispatcher mode, see Settings.Dispatcher.
+//
+// Handlers are run by a fixed pool of workers. Updates with the same key (i.e. of the same chat)
+// are handled one by one in order they came, different keys are handled in parallel.
+// If there are QueueSize updates waiting, the dispatch blocks, so does the poller.
+// On stop, the workers exit once the queue is handled, or the context of StopContext is done.
+type DispatcherSettings struct {
+	// Workers is the number of goroutines, running handlers, defaulted to 4 * runtime.NumCPU().
+	Workers int
+
+	// QueueSize limits the number of updates, waiting for a worker, defaulted to 64 * Workers.
+	QueueSize int
+
+	// Key returns the key of the update, updates of the same key are handled in order.
+	// Zero key means the update is not ordered. Defaulted to DispatchByChat.
+	Key func(c Context) int64
+}
+
+// DispatchByChat orders the updates by chat, or by sender, if there is no chat.
+func DispatchByChat(c Context) int64 {
+	if chat := c.Chat(); chat != nil {
+		return chat.ID
+	}
+	if sender := c.Sender(); sender != nil {
+		return sender.ID
+	}
+	return 0
+}
+
+// DispatchByUser orders the updates by sender, or by chat, if there is no sender, i.e. channel posts.
+func DispatchByUser(c Context) int64 {
+	if sender := c.Sender(); sender != nil {
+		return sender.ID
+	}
+	if chat := c.Chat(); chat != nil {
+		return chat.ID
+	}
+	return 0
+}
+
+// DispatcherStats are the metrics of the dispatcher, see Bot.DispatcherStats.
+type DispatcherStats struct {
+	// Workers is the size of the pool.
+	Workers int
+	// Running is the number of handlers running at the moment.
+	Running int
+	// Queued is the number of updates, waiting for a worker.
+	Queued int
+	// Keys is the number of keys (i.e. chats) with running or queued updates.
+	Keys int
+	// LongestQueue is the longest queue of a single key.
+	LongestQueue int
+	// Handled is the total number of handled updates.
+	Handled uint64
+	// Throttled is the number of dispatches, which waited for the queue to free up.
+	Throttled uint64
+}
+
+// DispatcherStats returns the metrics of the dispatcher, they are zero, unless Settings.Dispatcher is set.
+func (b *Bot) DispatcherStats() DispatcherStats {
+	if b.dispatcher == nil {
+		return DispatcherStats{}
+	}
+	return b.dispatcher.stats()
+}
+
+// dispatchTask is a queued handler, drop is called instead of run, if it's dropped on stop.
+type dispatchTask struct {
+	run  func()
+	drop func()
+}
+
+// dispatchQueue is a queue of a key, at most one worker runs its tasks at a time.
+type dispatchQueue struct {
+	key   int64
+	tasks []dispatchTask
+}
+
+type dispatcher struct {
+	settings DispatcherSettings
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	queues  map[int64]*dispatchQueue
+	ready   []*dispatchQueue
+	queued  int
+	running int
+	started bool
+	// gen is the generation of the workers, they exit, once it's changed by close
+	gen int
+	// alive is the number of the workers, which haven't exited yet
+	alive int
+	// aborted is set, when the deadline of the stop is reached, the queued tasks are not started then
+	aborted bool
+	// dropped is the number of the dispatches, which were rejected, because of the abort
+	dropped uint64
+
+	handled   uint64
+	throttled uint64
+}
+
+func newDispatcher(settings DispatcherSettings) *dispatcher {
+	if settings.Workers <= 0 {
+		settings.Workers = 4 * runtime.NumCPU()
+	}
+	if settings.QueueSize <= 0 {
+		settings.QueueSize = 64 * settings.Workers
+	}
+	if settings.Key == nil {
+		settings.Key = DispatchByChat
+	}
+
+	d := &dispatcher{settings: settings, queues: make(map[int64]*dispatchQueue)}
+	d.cond = sync.NewCond(&d.mu)
+	return d
+}
+
+// dispatch queues the task of the context, blocking while the queue is full. If the dispatcher is
+// aborted meanwhile, the task is not queued and drop is called instead.
+func (d *dispatcher) dispatch(c Context, run, drop func()) {
+	key := d.settings.Key(c)
+
+	d.mu.Lock()
+	if !d.started {
+		d.started = true
+		d.alive += d.settings.Workers
+		for i := 0; i < d.settings.Workers; i++ {
+			go d.work(d.gen)
+		}
+	}
+
+	if d.queued >= d.settings.QueueSize && !d.aborted {
+		d.throttled++
+		for d.queued >= d.settings.QueueSize && !d.aborted {
+			d.cond.Wait()
+		}
+	}
+	if d.aborted {
+		d.dropped++
+		d.mu.Unlock()
+		drop()
+		return
+	}
+	defer d.mu.Unlock()
+	d.queued++
+
+	task := dispatchTask{run: run, drop: drop}
+	if queue, ok := d.queues[key]; ok && key != 0 {
+		// the queue is either ready or taken by a worker, which requeues it
+		queue.tasks = append(queue.tasks, task)
+		return
+	}
+
+	queue := &dispatchQueue{key: key, tasks: []dispatchTask{task}}
+	if key != 0 {
+		d.queues[key] = queue
+	}
+	d.ready = append(d.ready, queue)
+	d.cond.Broadcast()
+}
+
+func (d *dispatcher) work(gen int) {
+	d.mu.Lock()
+	defer func() {
+		d.alive--
+		d.mu.Unlock()
+	}()
+	for {
+		for d.gen == gen && (len(d.ready) == 0 || d.aborted) {
+			d.cond.Wait()
+		}
+		if d.gen != gen {
+			return
+		}
+		queue := d.ready[0]
+		d.ready = d.ready[1:]
+		task := queue.tasks[0]
+		queue.tasks = queue.tasks[1:]
+		d.queued--
+		d.running++
+		d.cond.Broadcast()
+		d.mu.Unlock()
+
+		task.run()
+
+		d.mu.Lock()
+		d.running--
+		d.handled++
+		switch {
+		case d.gen != gen:
+			// closed meanwhile, the rest of the queue is dropped by the worker, which took it
+			d.queued -= len(queue.tasks)
+			d.mu.Unlock()
+			for _, task := range queue.tasks {
+				task.drop()
+			}
+			d.mu.Lock()
+			return
+		case len(queue.tasks) > 0:
+			// to the tail, so a busy chat doesn't starve the others
+			d.ready = append(d.ready, queue)
+			d.cond.Broadcast()
+		case queue.key != 0:
+			delete(d.queues, queue.key)
+		}
+	}
+}
+
+// aborter returns the func, which makes the current workers stop taking the queued tasks and
+// the blocked dispatches return, it's called, when the deadline of the stop is reached.
+func (d *dispatcher) aborter() func() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	gen := d.gen
+	return func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		if d.gen == gen {
+			d.aborted = true
+			d.cond.Broadcast()
+		}
+	}
+}
+
+// rejected returns the number of the dispatches, dropped because of the abort.
+func (d *dispatcher) rejected() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.dropped
+}
+
+// close makes the workers exit and drops the tasks, which are still queued, i.e. after the abort.
+// The workers are started again by the next dispatch.
+func (d *dispatcher) close() {
+	d.mu.Lock()
+	var dropped []dispatchTask
+	for _, queue := range d.ready {
+		dropped = append(dropped, queue.tasks...)
+		d.queued -= len(queue.tasks)
+	}
+	// the queues, taken by the workers, are dropped by them
+	d.queues = make(map[int64]*dispatchQueue)
+	d.ready = nil
+	d.gen++
+	d.started = false
+	d.aborted = false
+	d.cond.Broadcast()
+	d.mu.Unlock()
+
+	for _, task := range dropped {
+		task.drop()
+	}
+}
+
+func (d *dispatcher) stats() DispatcherStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	stats := DispatcherStats{
+		Workers:   d.settings.Workers,
+		Running:   d.running,
+		Queued:    d.queued,
+		Keys:      len(d.queues),
+		Handled:   d.handled,
+		Throttled: d.throttled,
+	}
+	for _, queue := range d.queues {
+		stats.LongestQueue = max(stats.LongestQueue, len(queue.tasks))
+	}
+	for _, queue := range d.ready {
+		stats.LongestQueue = max(stats.LongestQueue, len(queue.tasks))
+	}
+	return stats
+}
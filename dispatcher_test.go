@@ -0,0 +1,144 @@
+package tg
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher(t *testing.T) {
+	t.Run("Order", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true, Dispatcher: &DispatcherSettings{Workers: 3}})
+		require.NoError(t, err)
+
+		mu := sync.Mutex{}
+		order := map[int64][]int{}
+		running := map[int64]int{}
+		total, maxTotal := atomic.Int32{}, atomic.Int32{}
+		b.Handle(OnText, func(c Context) error {
+			chat := c.Chat().ID
+			mu.Lock()
+			running[chat]++
+			assert.Equal(t, 1, running[chat], "updates of a chat run one by one")
+			order[chat] = append(order[chat], c.Message().ID)
+			mu.Unlock()
+
+			n := total.Add(1)
+			for prev := maxTotal.Load(); n > prev && !maxTotal.CompareAndSwap(prev, n); prev = maxTotal.Load() {
+			}
+			time.Sleep(time.Millisecond)
+			total.Add(-1)
+
+			mu.Lock()
+			running[chat]--
+			mu.Unlock()
+			return nil
+		})
+
+		expected := map[int64][]int{}
+		for id := 1; id <= 60; id++ {
+			chat := int64(id%4 + 1)
+			expected[chat] = append(expected[chat], id)
+			b.ProcessUpdate(Update{Message: &Message{ID: id, Chat: &Chat{ID: chat}, Text: "text"}})
+		}
+		require.Eventually(t, func() bool { return b.DispatcherStats().Handled == 60 }, time.Second, time.Millisecond)
+
+		assert.Equal(t, expected, order)
+		assert.LessOrEqual(t, maxTotal.Load(), int32(3))
+		assert.Equal(t, DispatcherStats{Workers: 3, Handled: 60}, b.DispatcherStats())
+	})
+
+	t.Run("Backpressure", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true, Dispatcher: &DispatcherSettings{Workers: 1, QueueSize: 2}})
+		require.NoError(t, err)
+
+		release := make(chan struct{})
+		b.Handle(OnText, func(c Context) error {
+			<-release
+			return nil
+		})
+		update := func(chat int64) Update {
+			return Update{Message: &Message{Chat: &Chat{ID: chat}, Text: "text"}}
+		}
+
+		b.ProcessUpdate(update(1))
+		require.Eventually(t, func() bool { return b.DispatcherStats().Running == 1 }, time.Second, time.Millisecond)
+		b.ProcessUpdate(update(1))
+		b.ProcessUpdate(update(2))
+
+		dispatched := make(chan struct{})
+		go func() {
+			b.ProcessUpdate(update(3))
+			close(dispatched)
+		}()
+		require.Eventually(t, func() bool { return b.DispatcherStats().Throttled == 1 }, time.Second, time.Millisecond)
+		assert.Equal(t, DispatcherStats{Workers: 1, Running: 1, Queued: 2, Keys: 2, LongestQueue: 1, Throttled: 1}, b.DispatcherStats())
+
+		release <- struct{}{}
+		<-dispatched
+		close(release)
+		require.Eventually(t, func() bool { return b.DispatcherStats().Handled == 4 }, time.Second, time.Millisecond)
+	})
+}
+
+func TestDispatcherStop(t *testing.T) {
+	updates := []Update{
+		{ID: 1, Message: &Message{ID: 1, Chat: &Chat{ID: 1}, Text: "one"}},
+		{ID: 2, Message: &Message{ID: 2, Chat: &Chat{ID: 2}, Text: "two"}},
+		{ID: 3, Message: &Message{ID: 3, Chat: &Chat{ID: 3}, Text: "three"}},
+		{ID: 4, Message: &Message{ID: 4, Chat: &Chat{ID: 4}, Text: "four"}},
+	}
+	alive := func(b *Bot) int {
+		b.dispatcher.mu.Lock()
+		defer b.dispatcher.mu.Unlock()
+		return b.dispatcher.alive
+	}
+
+	t.Run("Drain", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true, Poller: &stopPoller{updates}, Dispatcher: &DispatcherSettings{Workers: 2}})
+		require.NoError(t, err)
+		b.Handle(OnText, func(c Context) error { return nil })
+
+		go b.Start()
+		report, err := b.StopContext(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, &ShutdownReport{Drained: 4}, report)
+		assert.EqualValues(t, 4, b.DispatcherStats().Handled)
+		require.Eventually(t, func() bool { return alive(b) == 0 }, time.Second, time.Millisecond)
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		b, err := NewBot(Settings{
+			Offline:    true,
+			Poller:     &stopPoller{updates},
+			Dispatcher: &DispatcherSettings{Workers: 1, QueueSize: 1},
+		})
+		require.NoError(t, err)
+
+		release := make(chan struct{})
+		b.Handle(OnText, func(c Context) error {
+			<-release
+			return nil
+		})
+
+		go b.Start()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		report, err := b.StopContext(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+		// the first one is running, the second one is queued, the third one is dropped by the blocked dispatch
+		assert.Equal(t, &ShutdownReport{Drained: 2, Abandoned: updates[2:], Unfinished: 2}, report)
+
+		close(release)
+		require.Eventually(t, func() bool { return alive(b) == 0 }, time.Second, time.Millisecond)
+		assert.EqualValues(t, 1, b.DispatcherStats().Handled, "the queued update is dropped")
+		assert.Zero(t, b.inflight.n)
+	})
+}
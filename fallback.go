@@ -0,0 +1,201 @@
+package tg
+
+import (
+	"fmt"
+	"time"
+)
+
+// FallbackPoller receives the updates with the Webhook and fails over to the LongPoller, if telegram
+// can't deliver them, i.e. the public endpoint is unreachable. Without the Webhook, it just long polls,
+// which suits the local development.
+//
+// The webhook is deleted on fail over keeping the pending updates, so they are received by the LongPoller.
+// The updates, received by both of the pollers across a switch, are passed to the bot once.
+type FallbackPoller struct {
+	Webhook    *Webhook
+	LongPoller *LongPoller
+
+	// CheckEvery is the interval of the webhook health checks, defaulted to a minute.
+	CheckEvery time.Duration
+	// FailAfter is the number of failed checks in a row to fail over, defaulted to 3. A check fails,
+	// if there are pending updates, and telegram reports a delivery error after the last update,
+	// the webhook has received. The error date is kept, while telegram backs off the retries,
+	// so the checks keep failing, until the webhook receives an update again.
+	FailAfter int
+	// RetryWebhook is the time of long polling, after which the webhook is tried again.
+	// Zero means the poller stays with the LongPoller.
+	RetryWebhook time.Duration
+
+	// OnSwitch is called on every switch, webhook tells whether the Webhook is used now.
+	OnSwitch func(webhook bool)
+
+	seen      map[int]struct{}
+	seenOrder []int
+	failed    int
+	// delivered is the unix time of the last update, the webhook has received, or of its start
+	delivered int64
+}
+
+// fallbackSeenUpdates is the number of the recent update IDs, the FallbackPoller keeps to skip duplicates.
+const fallbackSeenUpdates = 1024
+
+// Poll receives the updates from the webhook or the long poller, switching between them.
+func (p *FallbackPoller) Poll(b *Bot, dest chan Update, stop chan struct{}) {
+	if p.LongPoller == nil {
+		p.LongPoller = &LongPoller{Timeout: 10 * time.Second}
+	}
+	if p.CheckEvery <= 0 {
+		p.CheckEvery = time.Minute
+	}
+	if p.FailAfter <= 0 {
+		p.FailAfter = 3
+	}
+	p.seen = make(map[int]struct{})
+
+	webhook := p.Webhook != nil
+	if !webhook {
+		if err := b.RemoveWebhook(); err != nil {
+			b.OnError(err, nil)
+		}
+	}
+
+	for {
+		var stopped bool
+		if webhook {
+			stopped = p.pollWebhook(b, dest, stop)
+		} else {
+			stopped = p.pollLong(b, dest, stop)
+		}
+		if stopped {
+			return
+		}
+
+		webhook = !webhook
+		if p.OnSwitch != nil {
+			p.OnSwitch(webhook)
+		}
+	}
+}
+
+// pollWebhook runs the webhook until stop, or until it's failing and deleted.
+func (p *FallbackPoller) pollWebhook(b *Bot, dest chan Update, stop chan struct{}) bool {
+	p.failed = 0
+	p.delivered = b.clock.Now().Unix()
+
+	ticker := b.clock.NewTicker(p.CheckEvery)
+	defer ticker.Stop()
+
+	tracked := p.Webhook.ReplyAfterHandling || p.Webhook.ReplyInResponse
+	return p.run(b, p.Webhook, tracked, dest, stop, ticker.C(), func() bool {
+		if !p.failing(b) {
+			p.failed = 0
+			return false
+		}
+		if p.failed++; p.failed < p.FailAfter {
+			return false
+		}
+		// the pending updates are kept for the long poller
+		if err := b.RemoveWebhook(); err != nil {
+			b.OnError(err, nil)
+			return false
+		}
+		return true
+	})
+}
+
+// pollLong runs the long poller until stop, or until it's time to retry the webhook.
+func (p *FallbackPoller) pollLong(b *Bot, dest chan Update, stop chan struct{}) bool {
+	var retry <-chan time.Time
+	if p.RetryWebhook > 0 && p.Webhook != nil {
+		timer := b.clock.NewTimer(p.RetryWebhook)
+		defer timer.Stop()
+		retry = timer.C()
+	}
+
+	tracked := p.LongPoller.Offsets != nil
+	return p.run(b, p.LongPoller, tracked, dest, stop, retry, func() bool { return true })
+}
+
+// failing checks the webhook info for a delivery error, which happened after the last delivered update.
+func (p *FallbackPoller) failing(b *Bot) bool {
+	info, err := b.Webhook()
+	if err != nil {
+		// the API is unreachable, it's not the webhook to blame
+		b.debug(err)
+		return false
+	}
+
+	failing := info.PendingUpdates > 0 && info.ErrorUnixtime >= p.delivered
+	if failing {
+		b.debug(fmt.Errorf("telebot: webhook is failing with %d pending updates: %s", info.PendingUpdates, info.ErrorMessage))
+	}
+	return failing
+}
+
+// run runs the poller until stop is closed, or switchNow returns true on a tick, forwarding its new updates to dest.
+// If the poller returns on its own, i.e. the webhook can't be set, it's switched as well.
+// Tracked tells, whether the poller tracks its updates till they are handled, see LongPoller.Offsets.
+func (p *FallbackPoller) run(b *Bot, poller Poller, tracked bool, dest chan Update, stop chan struct{}, tick <-chan time.Time, switchNow func() bool) bool {
+	// buffered as dest, so the webhook doesn't reject the updates, while one is forwarded
+	updates := make(chan Update, cap(dest))
+	stopPoller := make(chan struct{})
+	stopConfirm := make(chan struct{})
+	go func() {
+		poller.Poll(b, updates, stopPoller)
+		close(stopConfirm)
+	}()
+
+	webhook := poller == Poller(p.Webhook)
+	stopped := false
+	exited := false
+polling:
+	for {
+		select {
+		case upd := <-updates:
+			if webhook {
+				p.delivered = b.clock.Now().Unix()
+			}
+			p.forward(b, tracked, dest, upd)
+		case <-tick:
+			if switchNow() {
+				break polling
+			}
+		case <-stopConfirm:
+			exited = true
+			break polling
+		case <-stop:
+			stopped = true
+			break polling
+		}
+	}
+
+	if !exited {
+		close(stopPoller)
+		for !exited {
+			select {
+			case upd := <-updates:
+				p.forward(b, tracked, dest, upd)
+			case <-stopConfirm:
+				exited = true
+			}
+		}
+	}
+	return stopped
+}
+
+// forward passes the update to dest, unless it has been passed recently.
+func (p *FallbackPoller) forward(b *Bot, tracked bool, dest chan Update, upd Update) {
+	if _, ok := p.seen[upd.ID]; ok {
+		if tracked {
+			b.acks.done(upd.ID)
+		}
+		return
+	}
+	p.seen[upd.ID] = struct{}{}
+	p.seenOrder = append(p.seenOrder, upd.ID)
+	if len(p.seenOrder) > fallbackSeenUpdates {
+		delete(p.seen, p.seenOrder[0])
+		p.seenOrder = p.seenOrder[1:]
+	}
+	dest <- upd
+}
@@ -0,0 +1,174 @@
+package tg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackPoller(t *testing.T) {
+	mu := sync.Mutex{}
+	methods := []string{}
+	infoCalls := atomic.Int64{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		mu.Lock()
+		methods = append(methods, method)
+		mu.Unlock()
+
+		switch method {
+		case "getWebhookInfo":
+			// every check sees a new delivery error
+			errorDate := time.Now().Unix() + infoCalls.Add(1)
+			_, _ = fmt.Fprintf(w, `{"ok":true,"result":{"url":"https://example.com","pending_update_count":2,`+
+				`"last_error_date":%d,"last_error_message":"Connection refused"}}`, errorDate)
+		case "getUpdates":
+			time.Sleep(5 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"ok":true,"result":[` +
+				`{"update_id":4,"message":{"message_id":4,"chat":{"id":1},"text":"4"}},` +
+				`{"update_id":5,"message":{"message_id":5,"chat":{"id":1},"text":"5"}}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+		}
+	}))
+	defer srv.Close()
+
+	switches := make(chan bool, 10)
+	poller := &FallbackPoller{
+		Webhook:    &Webhook{},
+		LongPoller: &LongPoller{},
+		CheckEvery: 10 * time.Millisecond,
+		FailAfter:  2,
+		OnSwitch:   func(webhook bool) { switches <- webhook },
+	}
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Poller: poller})
+	require.NoError(t, err)
+
+	handled := make(chan string, 10)
+	b.Handle(OnText, func(c Context) error {
+		handled <- c.Text()
+		return nil
+	})
+	go b.Start()
+	defer b.Stop()
+
+	// delivered by the webhook before the fail over, and then by getUpdates once more
+	body := `{"update_id":4,"message":{"message_id":4,"chat":{"id":1},"text":"4"}}`
+	assert.Eventually(t, func() bool {
+		w := httptest.NewRecorder()
+		poller.Webhook.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		return w.Code == http.StatusOK
+	}, time.Second, time.Millisecond)
+
+	select {
+	case webhook := <-switches:
+		assert.False(t, webhook)
+	case <-time.After(time.Second):
+		t.Fatal("no fail over")
+	}
+
+	assert.Equal(t, "4", <-handled)
+	assert.Equal(t, "5", <-handled)
+	select {
+	case text := <-handled:
+		t.Fatalf("duplicate update %s", text)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"setWebhook", "getWebhookInfo", "getWebhookInfo", "deleteWebhook", "getUpdates"}, methods[:5])
+}
+
+func TestFallbackPollerBackoff(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	// newBot makes a bot, which webhook fails once at start+1s, telegram backs off the retries,
+	// so the error date stays the same, while the pending updates pile up
+	newBot := func(t *testing.T) (*FallbackPoller, *clocktest.Clock, chan bool, chan string, func() int64) {
+		infoCalls := atomic.Int64{}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:] {
+			case "getWebhookInfo":
+				_, _ = fmt.Fprintf(w, `{"ok":true,"result":{"url":"https://example.com","pending_update_count":%d,`+
+					`"last_error_date":%d,"last_error_message":"Connection refused"}}`, infoCalls.Add(1), start.Unix()+1)
+			case "getUpdates":
+				time.Sleep(5 * time.Millisecond)
+				_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+			default:
+				_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+			}
+		}))
+		t.Cleanup(srv.Close)
+
+		clk := clocktest.New(start)
+		switches := make(chan bool, 10)
+		poller := &FallbackPoller{
+			Webhook:  &Webhook{},
+			OnSwitch: func(webhook bool) { switches <- webhook },
+		}
+		b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Poller: poller, Clock: clk})
+		require.NoError(t, err)
+		handled := make(chan string, 10)
+		b.Handle(OnText, func(c Context) error {
+			handled <- c.Text()
+			return nil
+		})
+		go b.Start()
+		t.Cleanup(b.Stop)
+
+		// the ticker of the checks is started
+		clk.BlockUntil(1)
+		return poller, clk, switches, handled, infoCalls.Load
+	}
+	// check advances the clock to the next check, and waits for it
+	check := func(t *testing.T, clk *clocktest.Clock, infoCalls func() int64) {
+		calls := infoCalls()
+		clk.Advance(time.Minute)
+		require.Eventually(t, func() bool { return infoCalls() > calls }, time.Second, time.Millisecond)
+	}
+
+	t.Run("FailOver", func(t *testing.T) {
+		_, clk, switches, _, infoCalls := newBot(t)
+
+		check(t, clk, infoCalls)
+		check(t, clk, infoCalls)
+		assert.Empty(t, switches)
+		check(t, clk, infoCalls)
+		select {
+		case webhook := <-switches:
+			assert.False(t, webhook)
+		case <-time.After(time.Second):
+			t.Fatal("no fail over")
+		}
+	})
+
+	t.Run("Delivered", func(t *testing.T) {
+		poller, clk, switches, handled, infoCalls := newBot(t)
+
+		check(t, clk, infoCalls)
+		// the webhook receives an update after the error, so it's healthy
+		body := `{"update_id":4,"message":{"message_id":4,"chat":{"id":1},"text":"4"}}`
+		w := httptest.NewRecorder()
+		poller.Webhook.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "4", <-handled)
+
+		for i := 0; i < 5; i++ {
+			check(t, clk, infoCalls)
+		}
+		select {
+		case <-switches:
+			t.Fatal("fail over after a delivery")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,377 @@
+package tg
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxForwardMessages is the maximum number of messages forwarded or copied in a single request.
+const MaxForwardMessages = 100
+
+// MessageRef identifies a message by its chat and id.
+type MessageRef struct {
+	ChatID    int64
+	MessageID int
+}
+
+// Ref returns the MessageRef of the message.
+func (m *Message) Ref() MessageRef {
+	ref := MessageRef{MessageID: m.ID}
+	if m.Chat != nil {
+		ref.ChatID = m.Chat.ID
+	}
+	return ref
+}
+
+// ForwardResult is the outcome of Bot.ForwardBatch and Bot.CopyBatch.
+//
+// The API returns only ids of the resulting messages, omitting the skipped ones (i.e. deleted or protected),
+// so the results are matched to the sources by their order. If some messages were skipped,
+// the match is only possible with a MessageFetcher, otherwise the sources end up in Unresolved.
+type ForwardResult struct {
+	// Messages are the resulting messages in order they were sent: grouped by chats and sorted by ids.
+	// Unless a MessageFetcher is used,
+	// their contents are reconstructed from the sources, so don't trust them much.
+	Messages []Message
+
+	// IDs maps the sources to the ids of the resulting messages.
+	IDs map[MessageRef]int
+
+	// Skipped are the sources, that were not forwarded for sure.
+	Skipped []Message
+
+	// Unresolved are the sources, which could not be matched to the results.
+	Unresolved []Message
+
+	// Unmatched are the ids of the resulting messages, which could not be matched to the sources.
+	Unmatched []int
+}
+
+// ForwardError is returned by Bot.ForwardBatch and the alike, when some of the sources were skipped,
+// or could not be matched to the results. The complete result is returned along with it.
+type ForwardError struct {
+	Skipped    []Message
+	Unresolved []Message
+	Unmatched  []int
+}
+
+func (err *ForwardError) Error() string {
+	return fmt.Sprintf("telebot: %d message(s) skipped, %d unresolved, %d result(s) unmatched",
+		len(err.Skipped), len(err.Unresolved), len(err.Unmatched))
+}
+
+// MessageFetcher fetches the actual contents of messages by their ids, the Bot API has no method for that.
+type MessageFetcher interface {
+	FetchMessages(b *Bot, chat Recipient, ids []int) ([]Message, error)
+}
+
+// MessageFetcherFunc is a function, implementing MessageFetcher.
+type MessageFetcherFunc func(b *Bot, chat Recipient, ids []int) ([]Message, error)
+
+func (f MessageFetcherFunc) FetchMessages(b *Bot, chat Recipient, ids []int) ([]Message, error) {
+	return f(b, chat, ids)
+}
+
+// FetchViaScratch is a MessageFetcher, which forwards the messages one by one to the scratch chat,
+// reads their contents, and deletes the forwarded copies. The bot must be able to post to the scratch chat.
+// It costs two requests per message, so use it when precise results are worth it.
+func FetchViaScratch(scratch Recipient) MessageFetcher {
+	return MessageFetcherFunc(func(b *Bot, chat Recipient, ids []int) ([]Message, error) {
+		chatID, err := strconv.ParseInt(chat.Recipient(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("telebot: FetchViaScratch requires a numeric chat id: %v", err)
+		}
+
+		ret := make([]Message, 0, len(ids))
+		for _, id := range ids {
+			copied, err := b.Forward(scratch, &StoredMessage{MessageID: strconv.Itoa(id), ChatID: chatID})
+			if err != nil {
+				return ret, err
+			}
+			_ = b.Delete(copied)
+
+			msg := *copied
+			msg.ID = id
+			msg.Chat = &Chat{ID: chatID}
+			ret = append(ret, msg)
+		}
+		return ret, nil
+	})
+}
+
+// ForwardMessages forwards messages, see ForwardBatch. It returns the resulting messages only,
+// the sources, which didn't make it, are reported by *ForwardError.
+func (b *Bot) ForwardMessages(to Recipient, messages []Message, opts ...interface{}) ([]Message, error) {
+	result, err := b.ForwardBatch(to, messages, opts...)
+	if result == nil {
+		return nil, err
+	}
+	return result.Messages, err
+}
+
+// CopyMessages copies messages, see CopyBatch. It returns the resulting messages only,
+// the sources, which didn't make it, are reported by *ForwardError.
+func (b *Bot) CopyMessages(to Recipient, messages []Message, opts ...interface{}) ([]Message, error) {
+	result, err := b.CopyBatch(to, messages, opts...)
+	if result == nil {
+		return nil, err
+	}
+	return result.Messages, err
+}
+
+// ForwardBatch forwards messages from any number of chats. Messages are grouped by their chats,
+// sorted, and forwarded in requests of up to 100 messages, albums are never split between requests.
+// Pass a MessageFetcher among the options to get the actual contents of the forwarded messages.
+//
+// On error, the result so far is returned along with it. If some of the messages were skipped,
+// or could not be matched, *ForwardError is returned along with the complete result.
+func (b *Bot) ForwardBatch(to Recipient, messages []Message, opts ...interface{}) (*ForwardResult, error) {
+	return b.forwardBatch("forwardMessages", to, messages, opts)
+}
+
+// CopyBatch copies messages from any number of chats, see ForwardBatch.
+func (b *Bot) CopyBatch(to Recipient, messages []Message, opts ...interface{}) (*ForwardResult, error) {
+	return b.forwardBatch("copyMessages", to, messages, opts)
+}
+
+func (b *Bot) forwardBatch(method string, to Recipient, messages []Message, opts []interface{}) (*ForwardResult, error) {
+	if to == nil {
+		return nil, ErrBadRecipient
+	}
+	if len(messages) == 0 {
+		return nil, ErrEmptyMessage
+	}
+
+	fetcher, opts := extractMessageFetcher(opts)
+	sendOpts := extractOptions(opts)
+
+	toChat := Chat{}
+	if id, err := strconv.ParseInt(to.Recipient(), 10, 64); err == nil {
+		toChat.ID = id
+	} else {
+		toChat.Username = to.Recipient()
+	}
+
+	result := &ForwardResult{IDs: make(map[MessageRef]int)}
+	for _, chunk := range chunkForward(messages) {
+		_, chatID := chunk[0].MessageSig()
+		ids := make([]string, len(chunk))
+		for i, msg := range chunk {
+			ids[i] = strconv.Itoa(msg.ID)
+		}
+
+		params := map[string]string{
+			"chat_id":      to.Recipient(),
+			"from_chat_id": strconv.FormatInt(chatID, 10),
+			"message_ids":  "[" + strings.Join(ids, ",") + "]",
+		}
+		b.embedSendOptions(params, sendOpts)
+
+		data, err := b.Raw(method, params)
+		if err != nil {
+			return result, err
+		}
+
+		var resp struct {
+			Result []struct {
+				MessageID int `json:"message_id"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return result, wrapError(err)
+		}
+		destIDs := make([]int, len(resp.Result))
+		for i, r := range resp.Result {
+			destIDs[i] = r.MessageID
+		}
+
+		var fetched []Message
+		if fetcher != nil && len(destIDs) != len(chunk) && len(destIDs) > 0 {
+			fetched, err = fetcher.FetchMessages(b, to, destIDs)
+			if err != nil {
+				return result, err
+			}
+		}
+
+		for _, pair := range matchForwarded(chunk, destIDs, fetched, result) {
+			msg := pair.source
+			if pair.fetched != nil {
+				msg = *pair.fetched
+			} else {
+				msg = reconstructForwarded(b, method, msg, sendOpts)
+			}
+			msg.ID = pair.destID
+			toChat := toChat
+			msg.Chat = &toChat
+			if sendOpts.ThreadID != 0 {
+				msg.ThreadID = sendOpts.ThreadID
+			}
+
+			result.IDs[pair.source.Ref()] = pair.destID
+			result.Messages = append(result.Messages, msg)
+		}
+	}
+
+	if len(result.Skipped) > 0 || len(result.Unresolved) > 0 || len(result.Unmatched) > 0 {
+		return result, &ForwardError{Skipped: result.Skipped, Unresolved: result.Unresolved, Unmatched: result.Unmatched}
+	}
+	return result, nil
+}
+
+func extractMessageFetcher(how []interface{}) (MessageFetcher, []interface{}) {
+	var fetcher MessageFetcher
+	rest := make([]interface{}, 0, len(how))
+	for _, prop := range how {
+		if f, ok := prop.(MessageFetcher); ok {
+			fetcher = f
+			continue
+		}
+		rest = append(rest, prop)
+	}
+	return fetcher, rest
+}
+
+// chunkForward groups messages by their chats in order of appearance, sorts them by id, removes duplicates,
+// and splits them into chunks of up to MaxForwardMessages, keeping the albums whole.
+func chunkForward(messages []Message) [][]Message {
+	chats := []int64{}
+	byChat := make(map[int64][]Message)
+	for _, msg := range messages {
+		_, chatID := msg.MessageSig()
+		if _, ok := byChat[chatID]; !ok {
+			chats = append(chats, chatID)
+		}
+		byChat[chatID] = append(byChat[chatID], msg)
+	}
+
+	chunks := [][]Message{}
+	for _, chatID := range chats {
+		msgs := byChat[chatID]
+		sort.SliceStable(msgs, func(i, j int) bool { return msgs[i].ID < msgs[j].ID })
+
+		unique := msgs[:0]
+		for i, msg := range msgs {
+			if i == 0 || msg.ID != msgs[i-1].ID {
+				unique = append(unique, msg)
+			}
+		}
+
+		chunk := []Message{}
+		for i := 0; i < len(unique); {
+			// an album is a run of messages with the same AlbumID
+			j := i + 1
+			for unique[i].AlbumID != "" && j < len(unique) && unique[j].AlbumID == unique[i].AlbumID {
+				j++
+			}
+			if len(chunk)+(j-i) > MaxForwardMessages && len(chunk) > 0 {
+				chunks = append(chunks, chunk)
+				chunk = []Message{}
+			}
+			chunk = append(chunk, unique[i:j]...)
+			i = j
+		}
+		if len(chunk) > 0 {
+			chunks = append(chunks, chunk)
+		}
+	}
+	return chunks
+}
+
+type forwardedPair struct {
+	source  Message
+	destID  int
+	fetched *Message
+}
+
+// matchForwarded matches the resulting ids to the sources, filling Skipped, Unresolved and Unmatched of the result.
+// The results come in order of the sources, skipped ones are omitted,
+// so if the counts differ, the fetched contents are aligned to the sources.
+func matchForwarded(sources []Message, destIDs []int, fetched []Message, result *ForwardResult) []forwardedPair {
+	pairs := []forwardedPair{}
+	switch {
+	case len(destIDs) == len(sources):
+		for i, source := range sources {
+			pairs = append(pairs, forwardedPair{source: source, destID: destIDs[i]})
+		}
+		return pairs
+
+	case len(destIDs) == 0:
+		result.Skipped = append(result.Skipped, sources...)
+		return pairs
+	}
+
+	resolvable := len(fetched) == len(destIDs)
+	for _, source := range sources {
+		if messageSignature(&source) == "" {
+			resolvable = false
+		}
+	}
+	if !resolvable {
+		result.Unresolved = append(result.Unresolved, sources...)
+		result.Unmatched = append(result.Unmatched, destIDs...)
+		return pairs
+	}
+
+	next := 0
+	for i := range fetched {
+		signature := messageSignature(&fetched[i])
+		found := -1
+		for j := next; j < len(sources) && signature != ""; j++ {
+			if messageSignature(&sources[j]) == signature {
+				found = j
+				break
+			}
+		}
+		if found == -1 {
+			result.Unmatched = append(result.Unmatched, destIDs[i])
+			continue
+		}
+
+		result.Skipped = append(result.Skipped, sources[next:found]...)
+		pairs = append(pairs, forwardedPair{source: sources[found], destID: destIDs[i], fetched: &fetched[i]})
+		next = found + 1
+	}
+	result.Skipped = append(result.Skipped, sources[next:]...)
+	return pairs
+}
+
+// messageSignature identifies the content of a message, ignoring captions, which could be removed while copying.
+func messageSignature(msg *Message) string {
+	if media := msg.Media(); media != nil {
+		if file := media.MediaFile(); file != nil && file.UniqueID != "" {
+			return media.MediaType() + ":" + file.UniqueID
+		}
+	}
+	if msg.Text != "" {
+		return "text:" + msg.Text
+	}
+	return ""
+}
+
+// reconstructForwarded fabricates the resulting message from the source one.
+func reconstructForwarded(b *Bot, method string, msg Message, sendOpts *SendOptions) Message {
+	if method == "copyMessages" {
+		msg.Sender = b.Me
+		msg.ReplyTo = sendOpts.ReplyTo
+		if sendOpts.RemoveCaption {
+			msg.Caption = ""
+			msg.CaptionEntities = nil
+		}
+		return msg
+	}
+
+	msg.OriginalMessageID = msg.ID
+	msg.OriginalUnixtime = int(msg.Unixtime)
+	msg.OriginalSignature = msg.Signature
+	msg.OriginalChat = msg.Chat
+	msg.OriginalSender = msg.Sender
+
+	msg.Sender = b.Me
+	msg.ReplyTo = nil
+	msg.Unixtime = b.clock.Now().Unix()
+	msg.Signature = ""
+	return msg
+}
@@ -0,0 +1,144 @@
+package tg
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkForward(t *testing.T) {
+	chatA, chatB := &Chat{ID: 1}, &Chat{ID: 2}
+	messages := []Message{
+		{ID: 5, Chat: chatA},
+		{ID: 3, Chat: chatB},
+		{ID: 1, Chat: chatA},
+		{ID: 5, Chat: chatA},
+		{ID: 2, Chat: chatB},
+	}
+
+	chunks := chunkForward(messages)
+	if assert.Len(t, chunks, 2) {
+		assert.Equal(t, []int{1, 5}, forwardIDs(chunks[0]))
+		assert.Equal(t, []int{2, 3}, forwardIDs(chunks[1]))
+	}
+	assert.Equal(t, 5, messages[0].ID, "the input must not be reordered")
+
+	long := []Message{}
+	for id := 1; id <= 98; id++ {
+		long = append(long, Message{ID: id, Chat: chatA})
+	}
+	for id := 99; id <= 103; id++ {
+		long = append(long, Message{ID: id, Chat: chatA, AlbumID: "album"})
+	}
+	chunks = chunkForward(long)
+	if assert.Len(t, chunks, 2) {
+		assert.Len(t, chunks[0], 98)
+		assert.Len(t, chunks[1], 5)
+	}
+}
+
+func TestMatchForwarded(t *testing.T) {
+	text := func(id int, text string) Message {
+		return Message{ID: id, Chat: &Chat{ID: 1}, Text: text}
+	}
+	sources := []Message{text(1, "a"), text(2, "b"), text(3, "c"), text(4, "d")}
+
+	t.Run("all", func(t *testing.T) {
+		result := &ForwardResult{}
+		pairs := matchForwarded(sources, []int{11, 12, 13, 14}, nil, result)
+		assert.Len(t, pairs, 4)
+		assert.Equal(t, 13, pairs[2].destID)
+		assert.Empty(t, result.Skipped)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		result := &ForwardResult{}
+		pairs := matchForwarded(sources, nil, nil, result)
+		assert.Empty(t, pairs)
+		assert.Len(t, result.Skipped, 4)
+	})
+
+	t.Run("unresolved", func(t *testing.T) {
+		result := &ForwardResult{}
+		pairs := matchForwarded(sources, []int{11, 12}, nil, result)
+		assert.Empty(t, pairs)
+		assert.Len(t, result.Unresolved, 4)
+		assert.Equal(t, []int{11, 12}, result.Unmatched)
+	})
+
+	t.Run("fetched", func(t *testing.T) {
+		result := &ForwardResult{}
+		fetched := []Message{text(11, "b"), text(12, "d"), text(13, "z")}
+		pairs := matchForwarded(sources, []int{11, 12, 13}, fetched, result)
+		if assert.Len(t, pairs, 2) {
+			assert.Equal(t, 2, pairs[0].source.ID)
+			assert.Equal(t, 11, pairs[0].destID)
+			assert.Equal(t, 4, pairs[1].source.ID)
+			assert.Equal(t, 12, pairs[1].destID)
+		}
+		assert.Equal(t, []int{1, 3}, forwardIDs(result.Skipped))
+		assert.Equal(t, []int{13}, result.Unmatched)
+	})
+}
+
+func TestForwardBatch(t *testing.T) {
+	response := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(response))
+	}))
+	defer srv.Close()
+
+	clk := clocktest.New(time.Unix(1_700_000_000, 0))
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Clock: clk})
+	require.NoError(t, err)
+	sources := []Message{
+		{ID: 1, Chat: &Chat{ID: 1}, Text: "a", Unixtime: 1},
+		{ID: 2, Chat: &Chat{ID: 1}, Text: "b", Unixtime: 2},
+	}
+
+	t.Run("Forwarded", func(t *testing.T) {
+		response = `{"ok":true,"result":[{"message_id":11},{"message_id":12}]}`
+		result, err := b.ForwardBatch(&Chat{ID: 2}, sources)
+		require.NoError(t, err)
+		require.Len(t, result.Messages, 2)
+		assert.Equal(t, 12, result.IDs[sources[1].Ref()])
+		assert.Equal(t, 2, result.Messages[1].OriginalMessageID)
+		assert.Equal(t, clk.Now().Unix(), result.Messages[1].Unixtime)
+	})
+
+	t.Run("Unresolved", func(t *testing.T) {
+		response = `{"ok":true,"result":[{"message_id":11}]}`
+		result, err := b.ForwardBatch(&Chat{ID: 2}, sources)
+		var forwardErr *ForwardError
+		require.ErrorAs(t, err, &forwardErr)
+		assert.Equal(t, []int{1, 2}, forwardIDs(forwardErr.Unresolved))
+		assert.Equal(t, []int{11}, forwardErr.Unmatched)
+		assert.Equal(t, forwardErr.Unresolved, result.Unresolved)
+
+		messages, err := b.ForwardMessages(&Chat{ID: 2}, sources)
+		assert.ErrorAs(t, err, &forwardErr)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("Skipped", func(t *testing.T) {
+		response = `{"ok":true,"result":[]}`
+		_, err := b.CopyMessages(&Chat{ID: 2}, sources)
+		var forwardErr *ForwardError
+		require.ErrorAs(t, err, &forwardErr)
+		assert.Equal(t, []int{1, 2}, forwardIDs(forwardErr.Skipped))
+		assert.EqualError(t, err, "telebot: 2 message(s) skipped, 0 unresolved, 0 result(s) unmatched")
+	})
+}
+
+func forwardIDs(messages []Message) []int {
+	ids := []int{}
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+	return ids
+}
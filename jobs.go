@@ -0,0 +1,481 @@
+package tg
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/heilkit/tg/clock"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrJobNotFound is returned on cancelling a job, which is already done or never existed.
+	ErrJobNotFound = errors.New("telebot: job not found")
+	// ErrJobLocalFile is returned on scheduling a send of a local file, only FileID and FileURL could be stored.
+	ErrJobLocalFile = errors.New("telebot: local files can't be sent by a job")
+	// ErrJobSendable is returned on scheduling a send of a Sendable, which isn't of the package.
+	ErrJobSendable = errors.New("telebot: only the Sendables of the package could be sent by a job")
+
+	errJobRecorded = errors.New("telebot: job recorded")
+	errNoRequest   = errors.New("telebot: no request is made")
+)
+
+// JobKind is the action of a job.
+type JobKind string
+
+const (
+	JobSend   JobKind = "send"
+	JobEdit   JobKind = "edit"
+	JobDelete JobKind = "delete"
+	JobPin    JobKind = "pin"
+	JobUnpin  JobKind = "unpin"
+	JobUnban  JobKind = "unban"
+)
+
+// Job is a delayed action. It's stored as a ready Bot API request, so it survives restarts of the bot.
+type Job struct {
+	ID      string          `json:"id"`
+	Kind    JobKind         `json:"kind"`
+	At      time.Time       `json:"at"`
+	Method  string          `json:"method"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// JobStore keeps the pending jobs, see Settings.Jobs.
+type JobStore interface {
+	// Put adds the job, or replaces the one with the same ID.
+	Put(job Job) error
+	// Delete removes the job, returns ErrJobNotFound, if there is no such job.
+	Delete(id string) error
+	// List returns all the jobs.
+	List() ([]Job, error)
+}
+
+// NewMemoryJobStore keeps jobs in memory, they are lost on restart.
+func NewMemoryJobStore() JobStore {
+	return &memoryJobStore{jobs: make(map[string]Job)}
+}
+
+type memoryJobStore struct {
+	mu   sync.Mutex
+	jobs map[string]Job
+}
+
+func (store *memoryJobStore) Put(job Job) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.jobs[job.ID] = job
+	return nil
+}
+
+func (store *memoryJobStore) Delete(id string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if _, ok := store.jobs[id]; !ok {
+		return ErrJobNotFound
+	}
+	delete(store.jobs, id)
+	return nil
+}
+
+func (store *memoryJobStore) List() ([]Job, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return sortedJobs(store.jobs), nil
+}
+
+// NewFileJobStore keeps jobs in a JSON file, which is rewritten atomically on every change.
+func NewFileJobStore(path string) (JobStore, error) {
+	store := &fileJobStore{path: path, jobs: make(map[string]Job)}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return store, nil
+	case err != nil:
+		return nil, fmt.Errorf("telebot: NewFileJobStore: %v", err)
+	}
+
+	jobs := []Job{}
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, fmt.Errorf("telebot: NewFileJobStore: %v", err)
+	}
+	for _, job := range jobs {
+		store.jobs[job.ID] = job
+	}
+	return store, nil
+}
+
+type fileJobStore struct {
+	mu   sync.Mutex
+	path string
+	jobs map[string]Job
+}
+
+func (store *fileJobStore) Put(job Job) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	old, existed := store.jobs[job.ID]
+	store.jobs[job.ID] = job
+	if err := store.saveLocked(); err != nil {
+		if existed {
+			store.jobs[job.ID] = old
+		} else {
+			delete(store.jobs, job.ID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (store *fileJobStore) Delete(id string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	job, ok := store.jobs[id]
+	if !ok {
+		return ErrJobNotFound
+	}
+	delete(store.jobs, id)
+	if err := store.saveLocked(); err != nil {
+		store.jobs[id] = job
+		return err
+	}
+	return nil
+}
+
+func (store *fileJobStore) List() ([]Job, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return sortedJobs(store.jobs), nil
+}
+
+func (store *fileJobStore) saveLocked() error {
+	data, err := json.Marshal(sortedJobs(store.jobs))
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(store.path, data)
+}
+
+// writeFileAtomic writes the data to a temporary file and renames it to path.
+func writeFileAtomic(path string, data []byte) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func sortedJobs(jobs map[string]Job) []Job {
+	ret := make([]Job, 0, len(jobs))
+	for _, job := range jobs {
+		ret = append(ret, job)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if !ret[i].At.Equal(ret[j].At) {
+			return ret[i].At.Before(ret[j].At)
+		}
+		return ret[i].ID < ret[j].ID
+	})
+	return ret
+}
+
+// jobRunner arms timers for the stored jobs and executes them through Bot.Raw, so the Scheduler applies.
+type jobRunner struct {
+	bot   *Bot
+	store JobStore
+
+	mu     sync.Mutex
+	timers map[string]clock.Timer
+}
+
+func newJobRunner(b *Bot, store JobStore) *jobRunner {
+	if store == nil {
+		store = NewMemoryJobStore()
+	}
+	return &jobRunner{bot: b, store: store, timers: make(map[string]clock.Timer)}
+}
+
+func (runner *jobRunner) schedule(job Job) error {
+	if err := runner.store.Put(job); err != nil {
+		return err
+	}
+	runner.arm(job)
+	return nil
+}
+
+func (runner *jobRunner) arm(job Job) {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+
+	if timer, ok := runner.timers[job.ID]; ok {
+		timer.Stop()
+	}
+	clk := runner.bot.clock
+	runner.timers[job.ID] = clk.AfterFunc(job.At.Sub(clk.Now()), func() { runner.run(job) })
+}
+
+func (runner *jobRunner) run(job Job) {
+	runner.mu.Lock()
+	if _, ok := runner.timers[job.ID]; !ok {
+		runner.mu.Unlock()
+		return
+	}
+	delete(runner.timers, job.ID)
+	runner.mu.Unlock()
+
+	_, err := runner.bot.Raw(job.Method, job.Payload)
+	if err != nil {
+		runner.bot.OnError(fmt.Errorf("telebot: job %s (%s): %w", job.ID, job.Kind, err), nil)
+	}
+	if err := runner.store.Delete(job.ID); err != nil && !errors.Is(err, ErrJobNotFound) {
+		runner.bot.OnError(fmt.Errorf("telebot: job %s: %w", job.ID, err), nil)
+	}
+}
+
+func (runner *jobRunner) cancel(id string) error {
+	runner.mu.Lock()
+	if timer, ok := runner.timers[id]; ok {
+		timer.Stop()
+		delete(runner.timers, id)
+	}
+	runner.mu.Unlock()
+
+	return runner.store.Delete(id)
+}
+
+// recover arms the stored jobs, which are not armed yet, the overdue ones are executed right away.
+func (runner *jobRunner) recover() error {
+	jobs, err := runner.store.List()
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		runner.mu.Lock()
+		_, armed := runner.timers[job.ID]
+		runner.mu.Unlock()
+		if !armed {
+			runner.arm(job)
+		}
+	}
+	return nil
+}
+
+// disarm stops all the timers, the jobs are kept in the store.
+func (runner *jobRunner) disarm() {
+	runner.mu.Lock()
+	defer runner.mu.Unlock()
+	for id, timer := range runner.timers {
+		timer.Stop()
+		delete(runner.timers, id)
+	}
+}
+
+// jobRecorder captures the request of a bot method instead of sending it.
+// It's passed to the methods explicitly, which pass it on to raw and sendFilesCost, see Bot.send.
+type jobRecorder struct {
+	method  string
+	payload json.RawMessage
+}
+
+func (rec *jobRecorder) record(method string, payload interface{}) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	rec.method, rec.payload = method, data
+	return nil, errJobRecorded
+}
+
+func (rec *jobRecorder) recordFiles(method string, files map[string]File, params map[string]string) ([]byte, error) {
+	payload := make(map[string]string, len(params)+len(files))
+	for k, v := range params {
+		payload[k] = v
+	}
+	for name, f := range files {
+		switch {
+		case f.InCloud():
+			payload[name] = f.FileID
+		case f.FileURL != "":
+			payload[name] = f.FileURL
+		default:
+			return nil, ErrJobLocalFile
+		}
+	}
+	return rec.record(method, payload)
+}
+
+// recordable tells whether the Sendable passes the recorder of the options on, only the ones of the package do.
+func recordable(what Sendable) bool {
+	switch what.(type) {
+	case *Photo, *Audio, *Document, *Sticker, *Video, *Animation, *Voice, *VideoNote,
+		*Location, *Venue, *Invoice, *Poll, *Dice, *Game:
+		return true
+	}
+	return false
+}
+
+// record runs fn with a recorder, so the request of the bot method, fn calls, is captured instead of sending it.
+func (b *Bot) record(fn func(rec *jobRecorder) error) (string, json.RawMessage, error) {
+	rec := &jobRecorder{}
+	if err := fn(rec); !errors.Is(err, errJobRecorded) {
+		if err == nil {
+			err = errNoRequest
+		}
+		return "", nil, err
+	}
+	return rec.method, rec.payload, nil
+}
+
+// recordJob records the request of fn and schedules it.
+func (b *Bot) recordJob(kind JobKind, at time.Time, fn func(rec *jobRecorder) error) (Job, error) {
+	method, payload, err := b.record(fn)
+	if errors.Is(err, errNoRequest) {
+		return Job{}, fmt.Errorf("telebot: %s job made no request", kind)
+	}
+	if err != nil {
+		return Job{}, err
+	}
+
+	job := Job{ID: newJobID(), Kind: kind, At: at, Method: method, Payload: payload}
+	if err := b.jobs.schedule(job); err != nil {
+		return Job{}, err
+	}
+	return job, nil
+}
+
+func newJobID() string {
+	data := make([]byte, 8)
+	_, _ = rand.Read(data)
+	return hex.EncodeToString(data)
+}
+
+// SendLater schedules Send at the time, see Bot.Send. Only text and the Sendables of the package could be scheduled,
+// the media must have FileID or FileURL.
+// It returns the id of the job, see CancelJob.
+func (b *Bot) SendLater(at time.Time, to Recipient, what interface{}, opts ...interface{}) (string, error) {
+	if to == nil {
+		return "", ErrBadRecipient
+	}
+	job, err := b.recordJob(JobSend, at, func(rec *jobRecorder) error {
+		_, err := b.send(rec, to, what, opts...)
+		return err
+	})
+	return job.ID, err
+}
+
+// EditLater schedules Edit at the time, see Bot.Edit.
+func (b *Bot) EditLater(at time.Time, msg Editable, what interface{}, opts ...interface{}) (string, error) {
+	job, err := b.recordJob(JobEdit, at, func(rec *jobRecorder) error {
+		_, err := b.edit(rec, msg, what, opts...)
+		return err
+	})
+	return job.ID, err
+}
+
+// DeleteLater schedules Delete at the time, see Bot.Delete.
+func (b *Bot) DeleteLater(at time.Time, msg Editable) (string, error) {
+	job, err := b.recordJob(JobDelete, at, func(rec *jobRecorder) error {
+		return b.delete(rec, msg)
+	})
+	return job.ID, err
+}
+
+// PinLater schedules Pin at the time, see Bot.Pin.
+func (b *Bot) PinLater(at time.Time, msg Editable, opts ...interface{}) (string, error) {
+	job, err := b.recordJob(JobPin, at, func(rec *jobRecorder) error {
+		return b.pin(rec, msg, opts...)
+	})
+	return job.ID, err
+}
+
+// UnpinLater schedules Unpin at the time, see Bot.Unpin.
+func (b *Bot) UnpinLater(at time.Time, chat *Chat, messageID ...int) (string, error) {
+	job, err := b.recordJob(JobUnpin, at, func(rec *jobRecorder) error {
+		return b.unpin(rec, chat, messageID...)
+	})
+	return job.ID, err
+}
+
+// UnbanLater schedules Unban at the time, see Bot.Unban.
+func (b *Bot) UnbanLater(at time.Time, chat *Chat, user *User, forBanned ...bool) (string, error) {
+	job, err := b.recordJob(JobUnban, at, func(rec *jobRecorder) error {
+		return b.unban(rec, chat, user, forBanned...)
+	})
+	return job.ID, err
+}
+
+// CancelJob cancels the pending job, returns ErrJobNotFound, if it's already done.
+func (b *Bot) CancelJob(id string) error {
+	return b.jobs.cancel(id)
+}
+
+// Jobs returns the pending jobs, ordered by their time.
+func (b *Bot) Jobs() ([]Job, error) {
+	return b.jobs.store.List()
+}
+
+// jobTimer is a clock.Timer of jobs, it lets DeleteAfter be persistent.
+type jobTimer struct {
+	b    *Bot
+	jobs []Job
+}
+
+func (t *jobTimer) C() <-chan time.Time {
+	return nil
+}
+
+func (t *jobTimer) Stop() bool {
+	active := false
+	for _, job := range t.jobs {
+		if t.b.jobs.cancel(job.ID) == nil {
+			active = true
+		}
+	}
+	return active
+}
+
+func (t *jobTimer) Reset(d time.Duration) bool {
+	active := t.Stop()
+	at := t.b.clock.Now().Add(d)
+	for i := range t.jobs {
+		t.jobs[i].At = at
+		if err := t.b.jobs.schedule(t.jobs[i]); err != nil {
+			t.b.OnError(err, nil)
+		}
+	}
+	return active
+}
+
+// deleteAfter schedules the deletion of messages as a job, errors are reported via OnError.
+func (b *Bot) deleteAfter(d time.Duration, c Context, msgs ...Editable) clock.Timer {
+	timer := &jobTimer{b: b}
+	at := b.clock.Now().Add(d)
+	for _, msg := range msgs {
+		job, err := b.recordJob(JobDelete, at, func(rec *jobRecorder) error {
+			return b.delete(rec, msg)
+		})
+		if err != nil {
+			b.OnError(err, c)
+			continue
+		}
+		timer.jobs = append(timer.jobs, job)
+	}
+	return timer
+}
@@ -0,0 +1,161 @@
+package tg
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/heilkit/tg/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingScheduler records the chats of the scheduled requests.
+type countingScheduler struct {
+	mu    sync.Mutex
+	chats []string
+}
+
+func (sch *countingScheduler) SyncFunc(count int, chat string, fn scheduler.RawFunc) ([]byte, error) {
+	sch.mu.Lock()
+	sch.chats = append(sch.chats, chat)
+	sch.mu.Unlock()
+	return fn()
+}
+
+// rawSendable is a Sendable of another package, it makes its request by itself.
+type rawSendable struct{}
+
+func (rawSendable) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
+	_, err := b.Raw("sendMessage", map[string]string{"chat_id": to.Recipient(), "text": "raw"})
+	return nil, err
+}
+
+type jobRequest struct {
+	method string
+	params map[string]string
+}
+
+func newJobsTestBot(t *testing.T, store JobStore) (*Bot, *clocktest.Clock, func() []jobRequest) {
+	mu := sync.Mutex{}
+	requests := []jobRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		params := map[string]string{}
+		_ = json.Unmarshal(data, &params)
+
+		mu.Lock()
+		requests = append(requests, jobRequest{r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], params})
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	clk := clocktest.New(time.Unix(0, 0))
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Clock: clk, Jobs: store})
+	require.NoError(t, err)
+
+	return b, clk, func() []jobRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]jobRequest{}, requests...)
+	}
+}
+
+func TestJobs(t *testing.T) {
+	t.Run("SendLater", func(t *testing.T) {
+		b, clk, requests := newJobsTestBot(t, nil)
+
+		_, err := b.SendLater(clk.Now().Add(time.Minute), &Chat{ID: 1}, "later")
+		require.NoError(t, err)
+		cancelled, err := b.SendLater(clk.Now().Add(time.Minute), &Chat{ID: 1}, "never")
+		require.NoError(t, err)
+		require.NoError(t, b.CancelJob(cancelled))
+		assert.ErrorIs(t, b.CancelJob(cancelled), ErrJobNotFound)
+
+		_, err = b.SendLater(clk.Now(), &Chat{ID: 1}, &Photo{File: FromDisk("photo.jpg")})
+		assert.ErrorIs(t, err, ErrJobLocalFile)
+		_, err = b.SendLater(clk.Now(), &Chat{ID: 1}, rawSendable{})
+		assert.ErrorIs(t, err, ErrJobSendable)
+
+		jobs, err := b.Jobs()
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, JobSend, jobs[0].Kind)
+		assert.Empty(t, requests())
+
+		clk.Advance(time.Minute)
+		assert.Equal(t, []jobRequest{{"sendMessage", map[string]string{"chat_id": "1", "text": "later"}}}, requests())
+
+		_, err = b.SendLater(clk.Now().Add(time.Minute), &Chat{ID: 1}, &Photo{File: File{FileID: "photo"}}, Silent)
+		require.NoError(t, err)
+		clk.Advance(time.Minute)
+		assert.Equal(t, jobRequest{"sendPhoto", map[string]string{
+			"chat_id": "1", "caption": "", "photo": "photo", "disable_notification": "true",
+		}}, requests()[1])
+
+		jobs, err = b.Jobs()
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("DeleteAfter", func(t *testing.T) {
+		b, clk, requests := newJobsTestBot(t, nil)
+		c := b.NewContext(Update{Message: &Message{ID: 2, Chat: &Chat{ID: 1}}})
+
+		timer := c.DeleteAfter(time.Second)
+		assert.True(t, timer.Reset(time.Hour))
+		clk.Advance(time.Minute)
+		assert.Empty(t, requests())
+
+		clk.Advance(time.Hour)
+		assert.Equal(t, []jobRequest{{"deleteMessage", map[string]string{"chat_id": "1", "message_id": "2"}}}, requests())
+		assert.False(t, timer.Stop())
+	})
+
+	t.Run("Scheduled", func(t *testing.T) {
+		b, clk, requests := newJobsTestBot(t, nil)
+		sch := &countingScheduler{}
+		b.scheduler = sch
+
+		_, err := b.SendLater(clk.Now().Add(time.Minute), &Chat{ID: 1}, "later")
+		require.NoError(t, err)
+		assert.Empty(t, sch.chats)
+
+		clk.Advance(time.Minute)
+		assert.Len(t, requests(), 1)
+		assert.Equal(t, []string{"1"}, sch.chats)
+	})
+
+	t.Run("Recover", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "jobs.json")
+		store, err := NewFileJobStore(path)
+		require.NoError(t, err)
+
+		b, clk, requests := newJobsTestBot(t, store)
+		_, err = b.UnpinLater(clk.Now().Add(time.Minute), &Chat{ID: 1}, 3)
+		require.NoError(t, err)
+		b.jobs.disarm()
+
+		store, err = NewFileJobStore(path)
+		require.NoError(t, err)
+		b, clk, requests = newJobsTestBot(t, store)
+		require.NoError(t, b.jobs.recover())
+
+		clk.Advance(time.Minute)
+		assert.Equal(t, []jobRequest{{"unpinChatMessage", map[string]string{"chat_id": "1", "message_id": "3"}}}, requests())
+
+		store, err = NewFileJobStore(path)
+		require.NoError(t, err)
+		jobs, err := store.List()
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+}
@@ -0,0 +1,289 @@
+// Package journal records the incoming updates and the outgoing API calls of a bot into an append-only
+// JSONL file, so a production incident could be reproduced locally with Replay.
+//
+//	j, err := journal.Open("bot.jsonl", journal.Options{})
+//	b, err := tg.NewBot(tg.Settings{
+//		Poller: j.Poller(&tg.LongPoller{Timeout: 10 * time.Second}),
+//		Logger: j.Logger(tg.LoggerSlog()),
+//	})
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/clock"
+)
+
+// Kind of an entry.
+type Kind string
+
+const (
+	KindUpdate Kind = "update"
+	KindRaw    Kind = "raw"
+)
+
+// Entry is a line of the journal: either an incoming update, or an outgoing API call.
+type Entry struct {
+	Time time.Time `json:"time"`
+	Kind Kind      `json:"kind"`
+
+	Update *tg.Update `json:"update,omitempty"`
+
+	Method   string          `json:"method,omitempty"`
+	Request  json.RawMessage `json:"request,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Duration time.Duration   `json:"duration,omitempty"`
+}
+
+// Options of a journal.
+type Options struct {
+	// MaxSize is the size of the file in bytes, after which it is rotated, defaulted to 64 MiB.
+	MaxSize int64
+	// MaxFiles is the number of the rotated files to keep (path.1 is the newest), defaulted to 5.
+	MaxFiles int
+	// Clock stamps the entries, defaulted to clock.Real().
+	Clock clock.Clock
+	// OnError is called, if an entry can't be written, defaulted to log.Println.
+	OnError func(error)
+}
+
+// Journal is an append-only JSONL file with rotation.
+type Journal struct {
+	path string
+	opts Options
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// Open opens the journal at path, appending to the existing file.
+func Open(path string, opts Options) (*Journal, error) {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = 64 << 20
+	}
+	if opts.MaxFiles <= 0 {
+		opts.MaxFiles = 5
+	}
+	opts.Clock = clock.Or(opts.Clock)
+	if opts.OnError == nil {
+		opts.OnError = func(err error) { log.Println(err) }
+	}
+
+	j := &Journal{path: path, opts: opts}
+	if err := j.open(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *Journal) open() error {
+	file, err := os.OpenFile(j.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("journal: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("journal: %v", err)
+	}
+	j.file, j.size = file, info.Size()
+	return nil
+}
+
+// Write appends the entry, stamping it with the current time, unless it has one.
+func (j *Journal) Write(entry Entry) error {
+	if entry.Time.IsZero() {
+		entry.Time = j.opts.Clock.Now()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("journal: %v", err)
+	}
+	data = append(data, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		return errors.New("journal: closed")
+	}
+	if j.size > 0 && j.size+int64(len(data)) > j.opts.MaxSize {
+		if err := j.rotateLocked(); err != nil {
+			return err
+		}
+	}
+	n, err := j.file.Write(data)
+	j.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("journal: %v", err)
+	}
+	return nil
+}
+
+// rotateLocked shifts path.N to path.N+1, dropping the oldest one, and path to path.1.
+func (j *Journal) rotateLocked() error {
+	if err := j.file.Close(); err != nil {
+		return fmt.Errorf("journal: %v", err)
+	}
+	j.file = nil
+
+	_ = os.Remove(rotated(j.path, j.opts.MaxFiles))
+	for i := j.opts.MaxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(rotated(j.path, i), rotated(j.path, i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errors.Join(fmt.Errorf("journal: %v", err), j.open())
+		}
+	}
+	if err := os.Rename(j.path, rotated(j.path, 1)); err != nil {
+		return errors.Join(fmt.Errorf("journal: %v", err), j.open())
+	}
+	return j.open()
+}
+
+func rotated(path string, i int) string {
+	return path + "." + strconv.Itoa(i)
+}
+
+// Close closes the file, the following writes fail.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+func (j *Journal) write(entry Entry) {
+	if err := j.Write(entry); err != nil {
+		j.opts.OnError(err)
+	}
+}
+
+// Poller records the updates of the poller before the bot processes them.
+func (j *Journal) Poller(poller tg.Poller) tg.Poller {
+	return tg.NewMiddlewarePoller(poller, func(upd *tg.Update) bool {
+		update := *upd
+		j.write(Entry{Kind: KindUpdate, Update: &update})
+		return true
+	})
+}
+
+// Logger records the API calls, passing all the events to next, which could be nil.
+func (j *Journal) Logger(next tg.Logger) tg.Logger {
+	return &logger{journal: j, next: next}
+}
+
+type logger struct {
+	journal *Journal
+	next    tg.Logger
+}
+
+func (l *logger) OnHandle(end string, ctx tg.Context, duration time.Duration) {
+	if l.next != nil {
+		l.next.OnHandle(end, ctx, duration)
+	}
+}
+
+func (l *logger) OnError(err error, ctx tg.Context) {
+	if l.next != nil {
+		l.next.OnError(err, ctx)
+	}
+}
+
+func (l *logger) OnRaw(method string, payload []byte, response []byte, err error, duration time.Duration) {
+	entry := Entry{
+		Kind:     KindRaw,
+		Method:   method,
+		Request:  rawJSON(payload),
+		Response: rawJSON(response),
+		Duration: duration,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	l.journal.write(entry)
+
+	if l.next != nil {
+		l.next.OnRaw(method, payload, response, err, duration)
+	}
+}
+
+// rawJSON keeps valid JSON as is, the rest is stored as a string.
+func rawJSON(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+	if json.Valid(data) {
+		return data
+	}
+	quoted, _ := json.Marshal(string(data))
+	return quoted
+}
+
+// Load reads the journal at path, including its rotated files, from the oldest entry to the newest.
+func Load(path string) ([]Entry, error) {
+	paths := []string{}
+	for i := 1; ; i++ {
+		if _, err := os.Stat(rotated(path, i)); err != nil {
+			break
+		}
+		paths = append([]string{rotated(path, i)}, paths...)
+	}
+	paths = append(paths, path)
+
+	entries := []Entry{}
+	for _, path := range paths {
+		read, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, read...)
+	}
+	return entries, nil
+}
+
+func readFile(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("journal: %v", err)
+	}
+	defer file.Close()
+
+	// a crash could leave the last line incomplete, it's dropped
+	var broken error
+	entries := []Entry{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64<<10), 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if broken != nil {
+			return nil, broken
+		}
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		entry := Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			broken = fmt.Errorf("journal: %s:%d: %v", path, line, err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("journal: %s: %v", path, err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,110 @@
+package journal
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type slicePoller struct {
+	updates []tg.Update
+}
+
+func (p *slicePoller) Poll(b *tg.Bot, dest chan tg.Update, stop chan struct{}) {
+	for _, upd := range p.updates {
+		dest <- upd
+	}
+	<-stop
+}
+
+func TestJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bot.jsonl")
+	clk := clocktest.New(time.Unix(1000, 0))
+	j, err := Open(path, Options{Clock: clk})
+	require.NoError(t, err)
+
+	api := NewMockAPI([]Entry{{Kind: KindRaw, Method: "sendMessage", Response: json.RawMessage(
+		`{"ok":true,"result":{"message_id":10,"chat":{"id":1},"text":"echo: hi"}}`,
+	)}})
+	handled := make(chan struct{})
+	b, err := tg.NewBot(tg.Settings{
+		Offline: true,
+		Client:  &http.Client{Transport: api},
+		Poller:  j.Poller(&slicePoller{[]tg.Update{{ID: 1, Message: &tg.Message{ID: 1, Chat: &tg.Chat{ID: 1}, Text: "hi"}}}}),
+		Logger:  j.Logger(nil),
+	})
+	require.NoError(t, err)
+	b.Handle(tg.OnText, func(c tg.Context) error {
+		defer close(handled)
+		return c.Send("echo: " + c.Text())
+	})
+
+	go b.Start()
+	<-handled
+	b.Stop()
+	require.NoError(t, j.Close())
+
+	entries, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, KindUpdate, entries[0].Kind)
+	assert.Equal(t, "hi", entries[0].Update.Message.Text)
+	assert.Equal(t, time.Unix(1000, 0), entries[0].Time.Local())
+	assert.Equal(t, KindRaw, entries[1].Kind)
+	assert.Equal(t, "sendMessage", entries[1].Method)
+	assert.JSONEq(t, `{"chat_id":"1","text":"echo: hi"}`, string(entries[1].Request))
+
+	replayed := []string{}
+	api, err = Replay(entries, tg.Settings{}, func(b *tg.Bot) {
+		b.Handle(tg.OnText, func(c tg.Context) error {
+			replayed = append(replayed, c.Text())
+			return c.Send("echo: " + c.Text())
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hi"}, replayed)
+	calls := api.Calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "sendMessage", calls[0].Method)
+	assert.JSONEq(t, string(entries[1].Request), string(calls[0].Request))
+}
+
+func TestRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bot.jsonl")
+	j, err := Open(path, Options{MaxSize: 1, MaxFiles: 2})
+	require.NoError(t, err)
+
+	for id := 1; id <= 4; id++ {
+		require.NoError(t, j.Write(Entry{Kind: KindUpdate, Update: &tg.Update{ID: id}}))
+	}
+	require.NoError(t, j.Close())
+
+	_, err = os.Stat(path + ".3")
+	assert.ErrorIs(t, err, os.ErrNotExist, "the oldest file is dropped")
+
+	entries, err := Load(path)
+	require.NoError(t, err)
+	ids := []int{}
+	for _, entry := range entries {
+		ids = append(ids, entry.Update.ID)
+	}
+	assert.Equal(t, []int{2, 3, 4}, ids)
+
+	// an incomplete last line is dropped
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"kind":"upd`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	entries, err = Load(path)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
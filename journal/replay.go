@@ -0,0 +1,134 @@
+package journal
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/clock/clocktest"
+)
+
+// Call is an API call, received by MockAPI.
+type Call struct {
+	Method  string
+	Request []byte
+}
+
+// MockAPI is an http.RoundTripper, which answers the API calls with the responses from the journal:
+// every method gets its recorded responses in order, then `{"ok":true,"result":true}`.
+type MockAPI struct {
+	mu        sync.Mutex
+	responses map[string][]Entry
+	calls     []Call
+}
+
+// NewMockAPI makes a MockAPI of the recorded API calls of the entries.
+func NewMockAPI(entries []Entry) *MockAPI {
+	api := &MockAPI{responses: make(map[string][]Entry)}
+	for _, entry := range entries {
+		if entry.Kind == KindRaw {
+			api.responses[entry.Method] = append(api.responses[entry.Method], entry)
+		}
+	}
+	return api
+}
+
+// RoundTrip answers the call, the method is the last element of the URL path.
+func (api *MockAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	method := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+	body := []byte{}
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	api.mu.Lock()
+	api.calls = append(api.calls, Call{Method: method, Request: body})
+	response := []byte(`{"ok":true,"result":true}`)
+	if recorded := api.responses[method]; len(recorded) > 0 {
+		api.responses[method] = recorded[1:]
+		if len(recorded[0].Response) == 0 && recorded[0].Error != "" {
+			api.mu.Unlock()
+			return nil, errors.New(recorded[0].Error)
+		}
+		response = recorded[0].Response
+	}
+	api.mu.Unlock()
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(response)),
+		ContentLength: int64(len(response)),
+		Request:       req,
+	}, nil
+}
+
+// Calls returns the received API calls.
+func (api *MockAPI) Calls() []Call {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]Call{}, api.calls...)
+}
+
+// Replay processes the recorded updates with a synchronous offline bot, which calls MockAPI.
+// setup registers the handlers, as in production. Unless pref.Clock is set, the bot gets
+// a clocktest.Clock, which is set to the time of each update before it is processed,
+// and advanced by a day after the last one, so the buffered albums and timers fire.
+//
+// The Client, Offline, Synchronous, Dispatcher and Poller settings are overridden.
+func Replay(entries []Entry, pref tg.Settings, setup func(b *tg.Bot)) (*MockAPI, error) {
+	api := NewMockAPI(entries)
+	pref.Client = &http.Client{Transport: api}
+	pref.Offline = true
+	pref.Synchronous = true
+	pref.Dispatcher = nil
+	pref.Poller = nil
+
+	var clk *clocktest.Clock
+	if pref.Clock == nil {
+		start := time.Unix(0, 0)
+		for _, entry := range entries {
+			if entry.Kind == KindUpdate {
+				start = entry.Time
+				break
+			}
+		}
+		clk = clocktest.New(start)
+		pref.Clock = clk
+	}
+
+	b, err := tg.NewBot(pref)
+	if err != nil {
+		return nil, err
+	}
+	if setup != nil {
+		setup(b)
+	}
+
+	for _, entry := range entries {
+		if entry.Kind != KindUpdate || entry.Update == nil {
+			continue
+		}
+		if clk != nil && entry.Time.After(clk.Now()) {
+			clk.Set(entry.Time)
+		}
+		b.ProcessUpdate(*entry.Update)
+	}
+	if clk != nil {
+		clk.Advance(24 * time.Hour)
+	}
+	return api, nil
+}
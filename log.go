@@ -57,3 +57,14 @@ func (logger loggerSlog) OnError(err error, ctx Context) {
 	}
 	logger.logger.Info("error", args...)
 }
+
+func (logger loggerSlog) OnMedia(media Media, duration time.Duration, err error) {
+	args := []any{"time", duration.String(), "type", media.MediaType()}
+	if file := media.MediaFile(); file.OnDisk() {
+		args = append(args, "file", file.FileLocal)
+	}
+	if err != nil {
+		args = append(args, "error", err.Error())
+	}
+	logger.logger.Debug("media", args...)
+}
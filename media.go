@@ -68,8 +68,8 @@ func (p *Photo) WithCaption(text string) Inputtable {
 }
 
 // ImageModifier a simple modifier function, called when Photo is sent.
-// Returns temporary files, which shall be removed after the Photo is sent.
-type ImageModifier = func(photo *Photo) (temporaries []string, err error)
+// Temporary files shall be created within the Workspace, they are removed after the Photo is sent.
+type ImageModifier = func(photo *Photo, ws *Workspace) error
 
 type photoSize struct {
 	File
@@ -140,6 +140,18 @@ type Audio struct {
 	Performer string `json:"performer,omitempty"`
 	MIME      string `json:"mime_type,omitempty"`
 	FileName  string `json:"file_name,omitempty"`
+
+	// internal
+	Mods []AudioModifier `json:"-"`
+}
+
+// AudioModifier a simple modifier function, called when Audio is sent.
+// Temporary files shall be created within the Workspace, they are removed after the Audio is sent.
+type AudioModifier func(audio *Audio, ws *Workspace) error
+
+func (a Audio) With(mods ...AudioModifier) *Audio {
+	a.Mods = append(a.Mods, mods...)
+	return &a
 }
 
 func (a *Audio) WithCaption(text string) Inputtable {
@@ -202,8 +214,8 @@ func (d *Document) InputMedia() InputMedia {
 }
 
 // VideoModifier a simple modifier function, called when Video is sent.
-// Returns temporary files, which shall be removed after the Video is sent.
-type VideoModifier func(video *Video) (temporaries []string, err error)
+// Temporary files shall be created within the Workspace, they are removed after the Video is sent.
+type VideoModifier func(video *Video, ws *Workspace) error
 
 // Video object represents a video file.
 type Video struct {
@@ -248,6 +260,17 @@ func (v *Video) ToAnimation() *Animation {
 	return a
 }
 
+// ToVideoNote converts a video into a round video message, the video has to be a square already.
+func (v *Video) ToVideoNote() *VideoNote {
+	return &VideoNote{
+		File:      v.File,
+		Duration:  v.Duration,
+		Thumbnail: v.Thumbnail,
+		Length:    v.Width,
+		Mods:      v.Mods,
+	}
+}
+
 func (v *Video) MediaType() string {
 	return "video"
 }
@@ -339,6 +362,18 @@ type Voice struct {
 	// (Optional)
 	Caption string `json:"caption,omitempty"`
 	MIME    string `json:"mime_type,omitempty"`
+
+	// internal
+	Mods []VoiceModifier `json:"-"`
+}
+
+// VoiceModifier a simple modifier function, called when Voice is sent.
+// Temporary files shall be created within the Workspace, they are removed after the Voice is sent.
+type VoiceModifier func(voice *Voice, ws *Workspace) error
+
+func (v Voice) With(mods ...VoiceModifier) *Voice {
+	v.Mods = append(v.Mods, mods...)
+	return &v
 }
 
 func (v *Voice) MediaType() string {
@@ -358,6 +393,26 @@ type VideoNote struct {
 	// (Optional)
 	Thumbnail *Photo `json:"thumb,omitempty"`
 	Length    int    `json:"length,omitempty"`
+
+	// internal
+	Mods []VideoModifier `json:"-"`
+}
+
+func (v VideoNote) With(mods ...VideoModifier) *VideoNote {
+	v.Mods = append(v.Mods, mods...)
+	return &v
+}
+
+// ToVideo lets VideoNote reuse VideoModifier, Length is treated as both Width and Height.
+func (v *VideoNote) ToVideo() *Video {
+	return &Video{
+		File:      v.File,
+		Width:     v.Length,
+		Height:    v.Length,
+		Duration:  v.Duration,
+		Thumbnail: v.Thumbnail,
+		Mods:      v.Mods,
+	}
 }
 
 func (v *VideoNote) MediaType() string {
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+
+	tele "github.com/heilkit/tg"
+	"github.com/heilkit/tg/clock"
+)
+
+// DedupStore remembers the IDs of the handled updates for some time.
+type DedupStore interface {
+	// Seen tells whether the update ID is marked and not expired yet.
+	Seen(id int) (bool, error)
+	// Mark marks the update ID as seen for ttl, it's called once the update is handled.
+	Mark(id int, ttl time.Duration) error
+}
+
+// NewMemoryDedupStore keeps the IDs in memory, nil clk means the real clock.
+func NewMemoryDedupStore(clk clock.Clock) DedupStore {
+	return &memoryDedupStore{clock: clock.Or(clk), seen: make(map[int]time.Time)}
+}
+
+type memoryDedupStore struct {
+	clock clock.Clock
+
+	mu        sync.Mutex
+	seen      map[int]time.Time
+	nextSweep time.Time
+}
+
+func (store *memoryDedupStore) Seen(id int) (bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	expires, ok := store.seen[id]
+	return ok && expires.After(store.clock.Now()), nil
+}
+
+func (store *memoryDedupStore) Mark(id int, ttl time.Duration) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	now := store.clock.Now()
+	if now.After(store.nextSweep) {
+		for seenID, expires := range store.seen {
+			if !expires.After(now) {
+				delete(store.seen, seenID)
+			}
+		}
+		store.nextSweep = now.Add(ttl)
+	}
+	store.seen[id] = now.Add(ttl)
+	return nil
+}
+
+// Dedup returns a middleware that skips the updates, which IDs are seen within ttl,
+// i.e. redelivered by a webhook or by the at-least-once LongPoller. Nil store means
+// NewMemoryDedupStore on the clock of the bot. Updates without an ID are never skipped.
+//
+// The ID is marked only after the handler succeeds, so the update, which handler fails or is
+// interrupted by a crash, is handled again on redelivery. The redelivery, which comes while
+// the update is still handled, is handled too. If the store fails, the error is passed
+// to OnError and the update is handled.
+func Dedup(store DedupStore, ttl time.Duration) tele.MiddlewareFunc {
+	once := sync.Once{}
+	return func(next tele.HandlerFunc) tele.HandlerFunc {
+		return func(c tele.Context) error {
+			id := c.Update().ID
+			if id == 0 {
+				return next(c)
+			}
+
+			once.Do(func() {
+				if store == nil {
+					store = NewMemoryDedupStore(c.Bot().Clock())
+				}
+			})
+			seen, err := store.Seen(id)
+			if err != nil {
+				c.Bot().OnError(err, c)
+			}
+			if seen {
+				return nil
+			}
+
+			if err := next(c); err != nil {
+				return err
+			}
+			if err := store.Mark(id, ttl); err != nil {
+				c.Bot().OnError(err, c)
+			}
+			return nil
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package middleware
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	tele "github.com/heilkit/tg"
+	"github.com/heilkit/tg/clock/clocktest"
 )
 
 var b, _ = tele.NewBot(tele.Settings{Offline: true})
@@ -28,3 +31,35 @@ func TestRecover(t *testing.T) {
 		Recover(onError)(h)(nil)
 	})
 }
+
+func TestDedup(t *testing.T) {
+	clk := clocktest.New(time.Unix(0, 0))
+	handled := 0
+	var failure error
+	h := Dedup(NewMemoryDedupStore(clk), time.Minute)(func(c tele.Context) error {
+		handled++
+		return failure
+	})
+
+	update := tele.Update{ID: 1, Message: &tele.Message{Text: "hi"}}
+	require.NoError(t, h(b.NewContext(update)))
+	require.NoError(t, h(b.NewContext(update)))
+	assert.Equal(t, 1, handled)
+
+	require.NoError(t, h(b.NewContext(tele.Update{})))
+	require.NoError(t, h(b.NewContext(tele.Update{})))
+	assert.Equal(t, 3, handled, "updates without ID are not deduplicated")
+
+	clk.Advance(time.Minute)
+	require.NoError(t, h(b.NewContext(update)))
+	assert.Equal(t, 4, handled)
+
+	// the failed update is handled again on redelivery
+	failed := tele.Update{ID: 2, Message: &tele.Message{Text: "hi"}}
+	failure = errors.New("failed")
+	assert.Error(t, h(b.NewContext(failed)))
+	failure = nil
+	require.NoError(t, h(b.NewContext(failed)))
+	require.NoError(t, h(b.NewContext(failed)))
+	assert.Equal(t, 6, handled)
+}
@@ -0,0 +1,128 @@
+package tg
+
+import (
+	"errors"
+	"maps"
+	"strconv"
+	"sync"
+)
+
+// MigrationStore keeps the supergroups, the groups are migrated to, see Settings.Migrations.
+type MigrationStore interface {
+	// Lookup returns the ID of the supergroup, the group is migrated to, zero means it's not migrated.
+	Lookup(chatID int64) (int64, error)
+	// Save saves the migration.
+	Save(from, to int64) error
+}
+
+// NewMemoryMigrationStore keeps the migrations in memory, they are lost on restart,
+// so the first request to every migrated group fails and is retried once more.
+func NewMemoryMigrationStore() MigrationStore {
+	return &memoryMigrationStore{aliases: make(map[int64]int64)}
+}
+
+type memoryMigrationStore struct {
+	mu      sync.RWMutex
+	aliases map[int64]int64
+}
+
+func (store *memoryMigrationStore) Lookup(chatID int64) (int64, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return store.aliases[chatID], nil
+}
+
+func (store *memoryMigrationStore) Save(from, to int64) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.aliases[from] = to
+	return nil
+}
+
+// migratedChatFields are the params, which are rewritten to the supergroups.
+var migratedChatFields = []string{"chat_id", "from_chat_id"}
+
+// followMigrations rewrites the chat IDs of the migrated groups to the supergroups, and, if send fails
+// with GroupError, retries it with the supergroup once, saving the migration, see Settings.Migrations.
+// The error doesn't tell the chat, so it's followed only if one of the chat fields is a basic group,
+// i.e. forwardMessage from a group to a supergroup. Params are not modified, send tells whether they are rewritten.
+func (b *Bot) followMigrations(params map[string]string, send func(params map[string]string, migrated bool) ([]byte, error)) ([]byte, error) {
+	if b.migrations == nil {
+		return send(params, false)
+	}
+
+	migrated := false
+
+	for _, field := range migratedChatFields {
+		chatID, err := strconv.ParseInt(params[field], 10, 64)
+		if err != nil {
+			// usernames of the channels are never migrated
+			continue
+		}
+		to, err := b.migrations.Lookup(chatID)
+		if err != nil {
+			b.OnError(err, nil)
+			continue
+		}
+		if to != 0 {
+			params = maps.Clone(params)
+			params[field] = strconv.FormatInt(to, 10)
+			migrated = true
+		}
+	}
+
+	data, err := send(params, migrated)
+	var group GroupError
+	if !errors.As(err, &group) || group.MigratedTo == 0 {
+		return data, err
+	}
+
+	field, chatID := "", int64(0)
+	for _, f := range migratedChatFields {
+		id, parseErr := strconv.ParseInt(params[f], 10, 64)
+		if parseErr != nil || !isBasicGroup(id) {
+			continue
+		}
+		if field != "" {
+			// both of the chats could be migrated
+			return data, err
+		}
+		field, chatID = f, id
+	}
+	if field == "" {
+		return data, err
+	}
+
+	b.migrated(chatID, group.MigratedTo)
+	params = maps.Clone(params)
+	params[field] = strconv.FormatInt(group.MigratedTo, 10)
+	return send(params, true)
+}
+
+// isBasicGroup tells whether the chat ID is of a basic group, only they are migrated to supergroups.
+// The IDs of the supergroups and the channels are -100 followed by 10 or more digits.
+func isBasicGroup(chatID int64) bool {
+	return chatID < 0 && chatID > -1_000_000_000_000
+}
+
+// migrated saves the migration and calls Settings.OnMigrated, unless it's known already.
+func (b *Bot) migrated(from, to int64) {
+	if b.migrations == nil {
+		return
+	}
+	b.migrateMu.Lock()
+	defer b.migrateMu.Unlock()
+
+	if known, err := b.migrations.Lookup(from); err != nil {
+		b.OnError(err, nil)
+	} else if known == to {
+		return
+	}
+
+	if err := b.migrations.Save(from, to); err != nil {
+		b.OnError(err, nil)
+	}
+	if b.onMigrated != nil {
+		b.onMigrated(from, to)
+	}
+}
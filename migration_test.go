@@ -0,0 +1,112 @@
+package tg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotMigrations(t *testing.T) {
+	mu := sync.Mutex{}
+	chats := []string{}
+	migrated := map[string]bool{"-1": true, "-5": true, "-6": true}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&params)
+		chat := params["chat_id"]
+		if from := params["from_chat_id"]; from != "" {
+			chat += " from " + from
+		}
+		mu.Lock()
+		chats = append(chats, chat)
+		mu.Unlock()
+
+		if migrated[params["chat_id"]] || migrated[params["from_chat_id"]] {
+			_, _ = w.Write([]byte(`{"ok":false,"error_code":400,` +
+				`"description":"Bad Request: group chat was upgraded to a supergroup chat",` +
+				`"parameters":{"migrate_to_chat_id":-100123}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":` + params["chat_id"] + `}}}`))
+	}))
+	defer srv.Close()
+
+	migrations := [][2]int64{}
+	b, err := NewBot(Settings{
+		Offline:    true,
+		URL:        srv.URL,
+		Client:     srv.Client(),
+		Migrations: NewMemoryMigrationStore(),
+		OnMigrated: func(from, to int64) { migrations = append(migrations, [2]int64{from, to}) },
+	})
+	require.NoError(t, err)
+
+	msg, err := b.Send(ChatID(-1), "hi")
+	require.NoError(t, err)
+	assert.EqualValues(t, -100123, msg.Chat.ID)
+
+	_, err = b.Send(ChatID(-1), "hi again")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-1", "-100123", "-100123"}, chats)
+
+	// the migration is known already
+	b.ProcessUpdate(Update{Message: &Message{Chat: &Chat{ID: -1}, MigrateTo: -100123}})
+	b.ProcessUpdate(Update{Message: &Message{Chat: &Chat{ID: -2}, MigrateTo: -100456}})
+	assert.Equal(t, [][2]int64{{-1, -100123}, {-2, -100456}}, migrations)
+
+	// the payloads of the jobs and the webhook replies
+	_, err = b.Raw("sendMessage", json.RawMessage(`{"chat_id":"-2","text":"raw"}`))
+	require.NoError(t, err)
+	mu.Lock()
+	assert.Equal(t, "-100456", chats[len(chats)-1])
+	mu.Unlock()
+
+	t.Run("Forward", func(t *testing.T) {
+		mu.Lock()
+		chats = nil
+		mu.Unlock()
+		b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Migrations: NewMemoryMigrationStore()})
+		require.NoError(t, err)
+
+		// the supergroup could be only the destination
+		msg := &Message{ID: 1, Chat: &Chat{ID: -1}}
+		_, err = b.Forward(ChatID(-1000000000500), msg)
+		require.NoError(t, err)
+
+		to, err := b.migrations.Lookup(-1)
+		require.NoError(t, err)
+		assert.EqualValues(t, -100123, to)
+		to, err = b.migrations.Lookup(-1000000000500)
+		require.NoError(t, err)
+		assert.Zero(t, to)
+
+		// either of the basic groups could be migrated
+		_, err = b.Forward(ChatID(-5), &Message{ID: 1, Chat: &Chat{ID: -6}})
+		var group GroupError
+		require.ErrorAs(t, err, &group)
+		for _, id := range []int64{-5, -6} {
+			to, err = b.migrations.Lookup(id)
+			require.NoError(t, err)
+			assert.Zero(t, to)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []string{"-1000000000500 from -1", "-1000000000500 from -100123", "-5 from -6"}, chats)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client()})
+		require.NoError(t, err)
+
+		_, err = b.Send(ChatID(-1), "hi")
+		var group GroupError
+		require.ErrorAs(t, err, &group)
+		assert.EqualValues(t, -100123, group.MigratedTo)
+	})
+}
@@ -0,0 +1,367 @@
+// Package multibot hosts many bots in one process: they share a webhook listener, an HTTP client
+// and handler definitions, and could be added and removed at runtime.
+//
+//	m := multibot.New(multibot.Settings{
+//		Listen:    ":8443",
+//		PublicURL: "https://bots.example.com",
+//		Setup: func(b *multibot.Bot) error {
+//			b.Handle("/start", func(c tg.Context) error {
+//				return c.Send(b.Layout.Text(c, "start"))
+//			})
+//			return nil
+//		},
+//	})
+//	go m.Start()
+//	m.Add(multibot.BotConfig{Name: "shop", Token: token, Layout: shopLayout})
+package multibot
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/layout"
+	"github.com/heilkit/tg/scheduler"
+)
+
+var (
+	// ErrDuplicate is returned by Add, if there is a bot with the same name, token or secret.
+	ErrDuplicate = errors.New("multibot: duplicate bot")
+	// ErrNotFound is returned by Remove, if there is no bot with the name.
+	ErrNotFound = errors.New("multibot: bot not found")
+)
+
+// Route tells, how the webhook requests are routed to the bots.
+type Route int
+
+const (
+	// RouteByPath serves every bot at PathPrefix + name.
+	RouteByPath Route = iota
+	// RouteBySecret serves all the bots at PathPrefix, telling them by the secret token.
+	RouteBySecret
+)
+
+// Settings of a Manager.
+type Settings struct {
+	// Listen is the address of the shared server, see Start. Leave it empty to serve the Manager
+	// as an http.Handler on your own server.
+	Listen string
+	// TLS is the key and the cert of the listener.
+	TLS *tg.WebhookTLS
+	// PublicURL is the public base URL of the server, the webhook paths are appended to it.
+	PublicURL string
+	// PathPrefix is the path of the webhooks, defaulted to "/".
+	PathPrefix string
+	// Route tells, how the requests are routed to the bots.
+	Route Route
+
+	// Bot is the template of the settings of every bot, Token and Poller are overwritten.
+	// Leave Scheduler and Jobs empty, the limits of telegram and the jobs are per bot, see Scheduler and Jobs.
+	Bot tg.Settings
+	// Client is the HTTP client, shared by all the bots, defaulted to Bot.Client or http.DefaultClient.
+	Client *http.Client
+	// Scheduler makes the scheduler of each bot, i.e. scheduler.Default.
+	Scheduler func() scheduler.Scheduler
+	// Jobs makes the job store of each bot, i.e. a tg.NewFileJobStore per name, defaulted to the memory ones.
+	// A shared store would make every bot run the jobs of the others with its token.
+	Jobs func(name string) (tg.JobStore, error)
+	// Webhook makes the template of the webhook of each bot, i.e. to set AllowedIPs or ReplyInResponse.
+	// Listen, Path, Endpoint, SecretToken and TLS are overwritten.
+	Webhook func(name string) *tg.Webhook
+
+	// Setup defines the handlers, shared by all the bots, it's called by Add before the bot is started.
+	Setup func(b *Bot) error
+}
+
+// BotConfig describes a hosted bot.
+type BotConfig struct {
+	// Name identifies the bot in the Manager and in the webhook path, i.e. "shop".
+	Name string
+	// Token of the bot.
+	Token string
+	// Secret is the secret token of the webhook, generated if empty.
+	Secret string
+	// Layout of the bot, passed to Setup, could be nil.
+	Layout *layout.Layout
+}
+
+// Bot is a hosted bot.
+type Bot struct {
+	*tg.Bot
+	Name   string
+	Layout *layout.Layout
+
+	secret  string
+	path    string
+	webhook *tg.Webhook
+}
+
+// Manager hosts the bots, it's an http.Handler, which routes the webhook requests to them,
+// and serves 200 at /healthz, while it's started.
+type Manager struct {
+	settings Settings
+
+	mu       sync.RWMutex
+	bots     map[string]*Bot
+	byPath   map[string]*Bot
+	bySecret map[string]*Bot
+	server   *http.Server
+	started  bool
+}
+
+// New makes a Manager without bots.
+func New(settings Settings) *Manager {
+	if settings.PathPrefix == "" {
+		settings.PathPrefix = "/"
+	}
+	if !strings.HasSuffix(settings.PathPrefix, "/") && settings.Route == RouteByPath {
+		settings.PathPrefix += "/"
+	}
+	if settings.Client == nil {
+		settings.Client = settings.Bot.Client
+	}
+	if settings.Client == nil {
+		settings.Client = http.DefaultClient
+	}
+
+	return &Manager{
+		settings: settings,
+		bots:     make(map[string]*Bot),
+		byPath:   make(map[string]*Bot),
+		bySecret: make(map[string]*Bot),
+	}
+}
+
+// Add makes the bot, calls Setup and starts it. The webhook is set by the bot, its errors are passed to OnError.
+func (m *Manager) Add(config BotConfig) (*Bot, error) {
+	if config.Name == "" || strings.Contains(config.Name, "/") {
+		return nil, fmt.Errorf("multibot: bad name %q", config.Name)
+	}
+	if config.Secret == "" {
+		config.Secret = newSecret()
+	}
+	if m.settings.Bot.Jobs != nil {
+		return nil, errors.New("multibot: Bot.Jobs would be shared by the bots, set Jobs instead")
+	}
+
+	path := m.settings.PathPrefix
+	if m.settings.Route == RouteByPath {
+		path += config.Name
+	}
+
+	m.mu.RLock()
+	err := m.duplicateLocked(config)
+	m.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	hook := &tg.Webhook{}
+	if m.settings.Webhook != nil {
+		hook = m.settings.Webhook(config.Name)
+	}
+	hook.Listen = ""
+	hook.TLS = nil
+	hook.Path = path
+	hook.SecretToken = config.Secret
+	hook.Endpoint = &tg.WebhookEndpoint{PublicURL: strings.TrimSuffix(m.settings.PublicURL, "/") + path}
+	if m.settings.TLS != nil && m.settings.TLS.Cert != "" {
+		hook.Endpoint.Cert = m.settings.TLS.Cert
+	}
+
+	pref := m.settings.Bot
+	pref.Token = config.Token
+	pref.Poller = hook
+	pref.Client = m.settings.Client
+	if m.settings.Scheduler != nil {
+		pref.Scheduler = m.settings.Scheduler()
+	}
+	if m.settings.Jobs != nil {
+		jobs, err := m.settings.Jobs(config.Name)
+		if err != nil {
+			return nil, fmt.Errorf("multibot: %s: %w", config.Name, err)
+		}
+		pref.Jobs = jobs
+	}
+	tb, err := tg.NewBot(pref)
+	if err != nil {
+		return nil, fmt.Errorf("multibot: %s: %w", config.Name, err)
+	}
+
+	b := &Bot{Bot: tb, Name: config.Name, Layout: config.Layout, secret: config.Secret, path: path, webhook: hook}
+	if m.settings.Setup != nil {
+		if err := m.settings.Setup(b); err != nil {
+			return nil, fmt.Errorf("multibot: %s: %w", config.Name, err)
+		}
+	}
+
+	m.mu.Lock()
+	if err := m.duplicateLocked(config); err != nil {
+		m.mu.Unlock()
+		return nil, err
+	}
+	m.bots[b.Name] = b
+	if m.settings.Route == RouteByPath {
+		m.byPath[path] = b
+	}
+	m.bySecret[b.secret] = b
+	m.mu.Unlock()
+
+	go b.Start()
+	return b, nil
+}
+
+func (m *Manager) duplicateLocked(config BotConfig) error {
+	_, dup := m.bots[config.Name]
+	if _, ok := m.bySecret[config.Secret]; ok {
+		dup = true
+	}
+	for _, b := range m.bots {
+		dup = dup || b.Token == config.Token
+	}
+	if dup {
+		return fmt.Errorf("%w: %s", ErrDuplicate, config.Name)
+	}
+	return nil
+}
+
+// Remove stops routing to the bot, deletes its webhook and stops it, see tg.Bot.StopContext.
+func (m *Manager) Remove(ctx context.Context, name string) error {
+	m.mu.Lock()
+	b, ok := m.bots[name]
+	if ok {
+		delete(m.bots, name)
+		delete(m.byPath, b.path)
+		delete(m.bySecret, b.secret)
+	}
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	removeErr := b.RemoveWebhook()
+	_, stopErr := b.StopContext(ctx)
+	return errors.Join(removeErr, stopErr)
+}
+
+// Bot returns the bot by name, or nil.
+func (m *Manager) Bot(name string) *Bot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.bots[name]
+}
+
+// Bots returns all the bots.
+func (m *Manager) Bots() []*Bot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	bots := make([]*Bot, 0, len(m.bots))
+	for _, b := range m.bots {
+		bots = append(bots, b)
+	}
+	return bots
+}
+
+// ServeHTTP routes the webhook request to the bot, replying 404, if there is none.
+func (m *Manager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/healthz" {
+		m.mu.RLock()
+		started := m.started || m.settings.Listen == ""
+		m.mu.RUnlock()
+		if !started {
+			http.Error(w, "not started", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+		return
+	}
+
+	m.mu.RLock()
+	var b *Bot
+	switch m.settings.Route {
+	case RouteByPath:
+		b = m.byPath[r.URL.Path]
+	case RouteBySecret:
+		if r.URL.Path == m.settings.PathPrefix {
+			b = m.bySecret[r.Header.Get("X-Telegram-Bot-Api-Secret-Token")]
+		}
+	}
+	m.mu.RUnlock()
+
+	if b == nil {
+		http.NotFound(w, r)
+		return
+	}
+	b.webhook.ServeHTTP(w, r)
+}
+
+// Start listens on Settings.Listen, blocking until Stop.
+func (m *Manager) Start() error {
+	m.mu.Lock()
+	if m.server != nil {
+		m.mu.Unlock()
+		return errors.New("multibot: already started")
+	}
+	server := &http.Server{Addr: m.settings.Listen, Handler: m}
+	m.server = server
+	m.started = true
+	m.mu.Unlock()
+
+	var err error
+	if m.settings.TLS != nil {
+		err = server.ListenAndServeTLS(m.settings.TLS.Cert, m.settings.TLS.Key)
+	} else {
+		err = server.ListenAndServe()
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Stop shuts the server down and stops all the bots, keeping their webhooks, so the updates
+// are delivered after the restart.
+func (m *Manager) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	server := m.server
+	m.server = nil
+	m.started = false
+	bots := make([]*Bot, 0, len(m.bots))
+	for _, b := range m.bots {
+		bots = append(bots, b)
+	}
+	m.mu.Unlock()
+
+	errs := []error{}
+	if server != nil {
+		errs = append(errs, server.Shutdown(ctx))
+	}
+
+	wg := sync.WaitGroup{}
+	mu := sync.Mutex{}
+	for _, b := range bots {
+		wg.Add(1)
+		go func(b *Bot) {
+			defer wg.Done()
+			_, err := b.StopContext(ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			errs = append(errs, err)
+		}(b)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func newSecret() string {
+	data := make([]byte, 16)
+	_, _ = rand.Read(data)
+	return hex.EncodeToString(data)
+}
@@ -0,0 +1,164 @@
+package multibot
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type apiCall struct {
+	token, method string
+	params        map[string]string
+}
+
+func newTestAPI(t *testing.T) (*httptest.Server, func() []apiCall) {
+	mu := sync.Mutex{}
+	calls := []apiCall{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// /bot<token>/<method>
+		token, method, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/bot"), "/")
+		params := map[string]string{}
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+			_ = r.ParseMultipartForm(1 << 20)
+			for k, v := range r.MultipartForm.Value {
+				params[k] = v[0]
+			}
+		} else {
+			data, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(data, &params)
+		}
+
+		mu.Lock()
+		calls = append(calls, apiCall{token, method, params})
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, func() []apiCall {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]apiCall{}, calls...)
+	}
+}
+
+func TestManager(t *testing.T) {
+	for _, route := range []Route{RouteByPath, RouteBySecret} {
+		srv, calls := newTestAPI(t)
+
+		mu := sync.Mutex{}
+		handled := []string{}
+		m := New(Settings{
+			PublicURL:  "https://bots.example.com",
+			PathPrefix: "/bots",
+			Route:      route,
+			Bot:        tg.Settings{URL: srv.URL, Offline: true},
+			Client:     srv.Client(),
+			Setup: func(b *Bot) error {
+				b.Handle(tg.OnText, func(c tg.Context) error {
+					mu.Lock()
+					defer mu.Unlock()
+					handled = append(handled, b.Name+": "+c.Text())
+					return nil
+				})
+				return nil
+			},
+		})
+
+		shop, err := m.Add(BotConfig{Name: "shop", Token: "1:shop", Secret: "s1"})
+		require.NoError(t, err)
+		_, err = m.Add(BotConfig{Name: "news", Token: "2:news", Secret: "s2"})
+		require.NoError(t, err)
+		_, err = m.Add(BotConfig{Name: "shop", Token: "3:other"})
+		assert.ErrorIs(t, err, ErrDuplicate)
+
+		wantURL := "https://bots.example.com/bots/shop"
+		if route == RouteBySecret {
+			wantURL = "https://bots.example.com/bots"
+		}
+		assert.Eventually(t, func() bool {
+			for _, call := range calls() {
+				if call.token == "1:shop" && call.method == "setWebhook" {
+					return call.params["url"] == wantURL && call.params["secret_token"] == "s1"
+				}
+			}
+			return false
+		}, time.Second, time.Millisecond)
+
+		post := func(name, secret, text string) int {
+			path := "/bots"
+			if route == RouteByPath {
+				path += "/" + name
+			}
+			body := `{"update_id":1,"message":{"message_id":1,"chat":{"id":1},"text":"` + text + `"}}`
+			r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+			r.Header.Set("X-Telegram-Bot-Api-Secret-Token", secret)
+			w := httptest.NewRecorder()
+			m.ServeHTTP(w, r)
+			return w.Code
+		}
+
+		assert.Eventually(t, func() bool { return post("shop", "s1", "hi shop") == http.StatusOK }, time.Second, time.Millisecond)
+		assert.Eventually(t, func() bool { return post("news", "s2", "hi news") == http.StatusOK }, time.Second, time.Millisecond)
+		assert.Equal(t, http.StatusNotFound, post("nope", "s3", "hi"))
+
+		require.NoError(t, m.Remove(context.Background(), shop.Name))
+		assert.Equal(t, http.StatusNotFound, post("shop", "s1", "hi again"))
+		assert.Nil(t, m.Bot("shop"))
+		assert.Len(t, m.Bots(), 1)
+
+		require.NoError(t, m.Stop(context.Background()))
+		mu.Lock()
+		assert.ElementsMatch(t, []string{"shop: hi shop", "news: hi news"}, handled)
+		mu.Unlock()
+
+		removed := false
+		for _, call := range calls() {
+			removed = removed || call.token == "1:shop" && call.method == "deleteWebhook"
+		}
+		assert.True(t, removed)
+	}
+}
+
+func TestManagerJobs(t *testing.T) {
+	srv, _ := newTestAPI(t)
+
+	shared := New(Settings{Bot: tg.Settings{URL: srv.URL, Offline: true, Jobs: tg.NewMemoryJobStore()}, Client: srv.Client()})
+	_, err := shared.Add(BotConfig{Name: "shop", Token: "1:shop"})
+	assert.Error(t, err)
+
+	stores := map[string]tg.JobStore{}
+	m := New(Settings{
+		Bot:    tg.Settings{URL: srv.URL, Offline: true},
+		Client: srv.Client(),
+		Jobs: func(name string) (tg.JobStore, error) {
+			stores[name] = tg.NewMemoryJobStore()
+			return stores[name], nil
+		},
+	})
+	defer m.Stop(context.Background())
+
+	shop, err := m.Add(BotConfig{Name: "shop", Token: "1:shop"})
+	require.NoError(t, err)
+	_, err = m.Add(BotConfig{Name: "news", Token: "2:news"})
+	require.NoError(t, err)
+
+	_, err = shop.SendLater(time.Now().Add(time.Hour), tg.ChatID(1), "later")
+	require.NoError(t, err)
+	shopJobs, err := stores["shop"].List()
+	require.NoError(t, err)
+	newsJobs, err := stores["news"].List()
+	require.NoError(t, err)
+	assert.Len(t, shopJobs, 1)
+	assert.Empty(t, newsJobs)
+}
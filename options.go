@@ -86,6 +86,17 @@ type SendOptions struct {
 
 	// RemoveCaption for copyMessages.
 	RemoveCaption bool
+
+	// recorder records the request instead of sending it, see Bot.record.
+	recorder *jobRecorder
+}
+
+// recording returns the recorder of the options, it's nil, unless the request is recorded.
+func (og *SendOptions) recording() *jobRecorder {
+	if og == nil {
+		return nil
+	}
+	return og.recorder
 }
 
 func (og *SendOptions) copy() *SendOptions {
@@ -1,6 +1,9 @@
 package tg
 
-import "time"
+import (
+	"github.com/heilkit/tg/clock"
+	"time"
+)
 
 // Poller is a provider of Updates.
 //
@@ -12,8 +15,9 @@ type Poller interface {
 	// subscription channel and start polling
 	// for Updates immediately.
 	//
-	// Poller must listen for stop constantly and close
-	// it as soon as it's done polling.
+	// Poller must listen for stop constantly and return
+	// as soon as it's done polling. The stop channel is
+	// owned by the bot, the poller must not close it.
 	Poll(b *Bot, updates chan Update, stop chan struct{})
 }
 
@@ -24,8 +28,13 @@ type LongPoller struct {
 	PollTimeout  time.Duration
 	LastUpdateID int
 
+	// startID is LastUpdateID, the poller was started with the first time, see pollAtLeastOnce.
+	startID int
+	started bool
+
 	// AllowedUpdates contains the update types
 	// you want your bot to receive.
+	// If empty, they are the ones of the handlers, see Bot.AllowedUpdates.
 	//
 	// Possible values:
 	//		message
@@ -39,15 +48,35 @@ type LongPoller struct {
 	// 		pre_checkout_query
 	// 		poll
 	// 		poll_answer
+	// 		my_chat_member
+	// 		chat_member
+	// 		chat_join_request
 	//
 	AllowedUpdates []string `yaml:"allowed_updates"`
+
+	// Offsets enables the at-least-once mode: an update is confirmed to telegram and saved to the store
+	// only after the handlers of it and of all the previous updates finish, so the updates,
+	// which were in flight on a crash, are received again. Handlers should be idempotent, see middleware.Dedup.
+	//
+	// The updates, which are in flight, are requested again and skipped, so Limit of slow updates
+	// holds the polling until one of them is handled.
+	Offsets OffsetStore `yaml:"-"`
 }
 
+// ackPollInterval is the longest wait for the in-flight updates of the at-least-once LongPoller,
+// before new updates are requested.
+const ackPollInterval = time.Second
+
 // Poll does long polling.
 func (p *LongPoller) Poll(b *Bot, dest chan Update, stop chan struct{}) {
-	var ticker *time.Ticker
+	if p.Offsets != nil {
+		p.pollAtLeastOnce(b, dest, stop)
+		return
+	}
+
+	var ticker clock.Ticker
 	if p.PollTimeout != 0 {
-		ticker = time.NewTicker(p.PollTimeout)
+		ticker = b.clock.NewTicker(p.PollTimeout)
 		defer func() {
 			if ticker != nil {
 				ticker.Stop()
@@ -62,11 +91,11 @@ func (p *LongPoller) Poll(b *Bot, dest chan Update, stop chan struct{}) {
 		default:
 		}
 
-		updates, err := b.getUpdates(p.LastUpdateID+1, p.Limit, p.Timeout, p.AllowedUpdates)
+		updates, err := b.getUpdates(p.LastUpdateID+1, p.Limit, p.Timeout, p.allowedUpdates(b))
 		if err != nil {
 			b.debug(err)
 			if ticker != nil {
-				_ = <-ticker.C
+				_ = <-ticker.C()
 			}
 			continue
 		}
@@ -76,11 +105,98 @@ func (p *LongPoller) Poll(b *Bot, dest chan Update, stop chan struct{}) {
 			dest <- update
 		}
 		if ticker != nil {
-			_ = <-ticker.C
+			_ = <-ticker.C()
 		}
 	}
 }
 
+func (p *LongPoller) pollAtLeastOnce(b *Bot, dest chan Update, stop chan struct{}) {
+	if !p.started {
+		p.started = true
+		p.startID = p.LastUpdateID
+	}
+	confirmed, err := p.Offsets.Load()
+	if err != nil {
+		b.OnError(err, nil)
+	}
+	// on restart, the updates, which were in flight on stop, are received again
+	p.LastUpdateID = max(confirmed, p.startID)
+	confirmed = p.LastUpdateID
+
+	var ticker clock.Ticker
+	if p.PollTimeout != 0 {
+		ticker = b.clock.NewTicker(p.PollTimeout)
+		defer ticker.Stop()
+	}
+
+	// inFlight are the IDs of the pushed updates, which are not confirmed yet
+	inFlight := []int{}
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		updates, err := b.getUpdates(confirmed+1, p.Limit, p.Timeout, p.allowedUpdates(b))
+		if err != nil {
+			b.debug(err)
+			if ticker != nil {
+				_ = <-ticker.C()
+			}
+			continue
+		}
+
+		fresh := 0
+		for _, update := range updates {
+			if update.ID <= p.LastUpdateID {
+				continue
+			}
+			b.acks.track(update.ID)
+			p.LastUpdateID = update.ID
+			inFlight = append(inFlight, update.ID)
+			fresh++
+			dest <- update
+		}
+
+		next := b.acks.next()
+		confirmed, inFlight = p.confirm(b, confirmed, inFlight)
+		if fresh == 0 && len(inFlight) > 0 {
+			// telegram returns the in-flight updates right away, so wait for one of them
+			timer := b.clock.NewTimer(ackPollInterval)
+			select {
+			case <-next:
+			case <-timer.C():
+			case <-stop:
+				timer.Stop()
+				return
+			}
+			timer.Stop()
+			confirmed, inFlight = p.confirm(b, confirmed, inFlight)
+		}
+		if ticker != nil {
+			_ = <-ticker.C()
+		}
+	}
+}
+
+// confirm moves the offset past the handled updates in a row, saving it to the store.
+func (p *LongPoller) confirm(b *Bot, confirmed int, inFlight []int) (int, []int) {
+	handled := 0
+	for handled < len(inFlight) && !b.acks.busy(inFlight[handled]) {
+		handled++
+	}
+	if handled == 0 {
+		return confirmed, inFlight
+	}
+
+	confirmed = inFlight[handled-1]
+	if err := p.Offsets.Save(confirmed); err != nil {
+		b.OnError(err, nil)
+	}
+	return confirmed, inFlight[handled:]
+}
+
 // MiddlewarePoller is a special kind of poller that acts
 // like a filter for updates. It could be used for spam
 // handling, banning or whatever.
@@ -124,6 +240,8 @@ func (p *MiddlewarePoller) Poll(b *Bot, dest chan Update, stop chan struct{}) {
 		case upd := <-middle:
 			if p.Filter(&upd) {
 				dest <- upd
+			} else {
+				b.acks.done(upd.ID)
 			}
 		}
 	}
@@ -0,0 +1,176 @@
+package tg
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// MediaLogger is an optional extension of Logger, which is notified about media preprocessing.
+// If Settings.Logger implements it, OnMedia is called after the modifiers of each media item are run.
+type MediaLogger interface {
+	OnMedia(media Media, duration time.Duration, err error)
+}
+
+// PrepareMedia runs the modifiers of local media concurrently, at most Settings.MediaWorkers at a time.
+// Modifiers of the prepared media are cleared, so sending them afterward does not run them again.
+//
+// If any item fails, no new items are started, the context of the ones in progress is cancelled
+// (see Workspace.Context) and they are waited for, all the workspaces are cleaned up
+// and the error of the first failed item is returned.
+// Otherwise, call cleanup after the media is sent to remove the temporaries.
+func (b *Bot) PrepareMedia(media ...Media) (cleanup func(), err error) {
+	return b.PrepareMediaContext(context.Background(), media...)
+}
+
+// PrepareMediaContext is PrepareMedia, which modifiers are stopped, once ctx is done.
+func (b *Bot) PrepareMediaContext(ctx context.Context, media ...Media) (cleanup func(), err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := b.mediaWorkers
+	if workers == nil {
+		workers = make(chan struct{}, runtime.NumCPU())
+	}
+
+	workspaces := make([]*Workspace, len(media))
+	cleanup = func() {
+		for _, ws := range workspaces {
+			if ws != nil {
+				ws.Cleanup()
+			}
+		}
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		first error
+	)
+	// the items in progress fail too, once the context is cancelled, so only the first error is kept
+	fail := func(err error) {
+		mu.Lock()
+		if first == nil {
+			first = err
+		}
+		mu.Unlock()
+		cancel()
+	}
+
+	for i, med := range media {
+		if !hasMods(med) {
+			continue
+		}
+
+		acquired := false
+		select {
+		case workers <- struct{}{}:
+			acquired = true
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			if acquired {
+				<-workers
+			}
+			fail(err)
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, med Media) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			start := b.clock.Now()
+			workspaces[i] = b.NewWorkspace().WithContext(ctx)
+			err := prepareMedia(med, workspaces[i])
+			if err != nil {
+				fail(err)
+			}
+			if logger, ok := b.logger.(MediaLogger); ok {
+				logger.OnMedia(med, b.clock.Since(start), err)
+			}
+		}(i, med)
+	}
+	wg.Wait()
+
+	if first != nil {
+		cleanup()
+		return func() {}, first
+	}
+	return cleanup, nil
+}
+
+// hasMods reports whether the media is local and has modifiers to run.
+func hasMods(med Media) bool {
+	if file := med.MediaFile(); !file.OnDisk() && file.FileReader == nil {
+		return false
+	}
+
+	switch media := med.(type) {
+	case *Photo:
+		return len(media.Mods) > 0
+	case *Video:
+		return len(media.Mods) > 0
+	case *Animation:
+		return len(media.Mods) > 0
+	case *VideoNote:
+		return len(media.Mods) > 0
+	case *Audio:
+		return len(media.Mods) > 0
+	case *Voice:
+		return len(media.Mods) > 0
+	default:
+		return false
+	}
+}
+
+// prepareMedia runs and clears the modifiers of the media.
+func prepareMedia(med Media, ws *Workspace) error {
+	switch media := med.(type) {
+	case *Photo:
+		if err := applyMods(ws, media, media.Mods); err != nil {
+			return err
+		}
+		media.Mods = nil
+
+	case *Video:
+		if err := applyMods(ws, media, media.Mods); err != nil {
+			return err
+		}
+		media.Mods = nil
+
+	case *Animation:
+		v := media.ToVideo()
+		if err := applyMods(ws, v, v.Mods); err != nil {
+			return err
+		}
+		*media = *v.ToAnimation()
+		media.Mods = nil
+
+	case *VideoNote:
+		v := media.ToVideo()
+		if err := applyMods(ws, v, v.Mods); err != nil {
+			return err
+		}
+		*media = *v.ToVideoNote()
+		media.Mods = nil
+
+	case *Audio:
+		if err := applyMods(ws, media, media.Mods); err != nil {
+			return err
+		}
+		media.Mods = nil
+
+	case *Voice:
+		if err := applyMods(ws, media, media.Mods); err != nil {
+			return err
+		}
+		media.Mods = nil
+	}
+
+	return nil
+}
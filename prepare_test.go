@@ -0,0 +1,154 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mediaLogger records the durations of the media.
+type mediaLogger struct {
+	Logger
+	durations chan time.Duration
+}
+
+func (logger *mediaLogger) OnMedia(media Media, duration time.Duration, err error) {
+	logger.durations <- duration
+}
+
+func TestBotPrepareMedia(t *testing.T) {
+	b, err := NewBot(Settings{Offline: true, MediaWorkers: 2})
+	require.NoError(t, err)
+
+	input := filepath.Join(t.TempDir(), "input.jpg")
+	require.NoError(t, os.WriteFile(input, []byte("jpeg"), 0o644))
+	local := FromDisk(input)
+	dir := t.TempDir()
+
+	t.Run("concurrency", func(t *testing.T) {
+		var running, peak atomic.Int32
+		mod := func(photo *Photo, ws *Workspace) error {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				old := peak.Load()
+				if n <= old || peak.CompareAndSwap(old, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			tmp, err := ws.CreateTemp(dir, "*.jpg")
+			if err != nil {
+				return err
+			}
+			return tmp.Close()
+		}
+
+		media := []Media{}
+		for i := 0; i < 6; i++ {
+			media = append(media, (&Photo{File: local}).With(mod))
+		}
+		media = append(media, &Photo{File: FromURL("https://example.com/photo.jpg"), Mods: []ImageModifier{mod}})
+
+		cleanup, err := b.PrepareMedia(media...)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, peak.Load(), int32(2))
+		for _, med := range media[:6] {
+			assert.Empty(t, med.(*Photo).Mods)
+		}
+		assert.Len(t, media[6].(*Photo).Mods, 1)
+
+		entries, _ := os.ReadDir(dir)
+		assert.Len(t, entries, 6)
+		cleanup()
+		entries, _ = os.ReadDir(dir)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		errMod := errors.New("mod failed")
+		var started atomic.Int32
+		mod := func(photo *Photo, ws *Workspace) error {
+			started.Add(1)
+			tmp, err := ws.CreateTemp(dir, "*.jpg")
+			if err != nil {
+				return err
+			}
+			tmp.Close()
+			if photo.Caption == "fail" {
+				return errMod
+			}
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		}
+
+		media := []Media{(&Photo{File: local, Caption: "fail"}).With(mod)}
+		for i := 0; i < 10; i++ {
+			media = append(media, (&Photo{File: local}).With(mod))
+		}
+
+		_, err := b.PrepareMedia(media...)
+		assert.ErrorIs(t, err, errMod)
+		assert.Less(t, started.Load(), int32(len(media)))
+
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		errMod := errors.New("mod failed")
+		cancelled := make(chan error, 1)
+		mod := func(photo *Photo, ws *Workspace) error {
+			if photo.Caption == "fail" {
+				time.Sleep(10 * time.Millisecond)
+				return errMod
+			}
+			// i.e. a long ffmpeg run, which is killed
+			select {
+			case <-ws.Context().Done():
+				cancelled <- ws.Context().Err()
+				return ws.Context().Err()
+			case <-time.After(10 * time.Second):
+				return nil
+			}
+		}
+
+		media := []Media{(&Photo{File: local}).With(mod), (&Photo{File: local, Caption: "fail"}).With(mod)}
+		start := time.Now()
+		_, err := b.PrepareMedia(media...)
+		assert.ErrorIs(t, err, errMod)
+		assert.ErrorIs(t, <-cancelled, context.Canceled)
+		assert.Less(t, time.Since(start), 5*time.Second)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = b.PrepareMediaContext(ctx, (&Photo{File: local}).With(mod))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("logger", func(t *testing.T) {
+		clk := clocktest.New(time.Unix(0, 0))
+		logger := &mediaLogger{Logger: LoggerSlog(), durations: make(chan time.Duration, 1)}
+		b, err := NewBot(Settings{Offline: true, Clock: clk, Logger: logger})
+		require.NoError(t, err)
+
+		// the modifiers are timed by the clock of the bot
+		mod := func(photo *Photo, ws *Workspace) error {
+			clk.Advance(2 * time.Second)
+			return nil
+		}
+		cleanup, err := b.PrepareMedia((&Photo{File: local}).With(mod))
+		require.NoError(t, err)
+		defer cleanup()
+		assert.Equal(t, 2*time.Second, <-logger.durations)
+	})
+}
@@ -1,9 +1,10 @@
 package tg
 
 import (
-	"fmt"
 	"strconv"
-	"unicode/utf8"
+	"strings"
+
+	"github.com/heilkit/tg/botapi"
 )
 
 const (
@@ -19,26 +20,37 @@ const (
 
 // React sets emoji/custom reaction to the give message. They are like 72 emojis, tg.Reaction* are the preset ones.
 func (b *Bot) React(to *Message, reaction string, isBig ...bool) error {
-	params := map[string]string{
-		"chat_id":    strconv.FormatInt(to.Chat.ID, 10),
-		"message_id": strconv.Itoa(to.ID),
-		"reaction":   fmt.Sprintf("[{\"type\": \"%s\", \"emoji\": \"%s\"}]", reactionType(reaction), reaction),
+	return b.setReactions(to, []string{reaction}, len(isBig) > 0 && isBig[0])
+}
+
+// SetReactions replaces the reactions of the bot to the message, no reactions remove them.
+// Emojis and custom emoji IDs could be mixed, see React.
+func (b *Bot) SetReactions(to *Message, reactions ...string) error {
+	return b.setReactions(to, reactions, false)
+}
+
+func (b *Bot) setReactions(to *Message, reactions []string, isBig bool) error {
+	params := botapi.SetMessageReactionParams{
+		ChatID:    strconv.FormatInt(to.Chat.ID, 10),
+		MessageID: int64(to.ID),
+		Reaction:  []botapi.ReactionType{},
+		IsBig:     isBig,
 	}
-	if len(isBig) > 0 && isBig[0] {
-		params["is_big"] = "true"
+	for _, reaction := range reactions {
+		params.Reaction = append(params.Reaction, newReactionType(reaction))
 	}
 
-	_, err := b.Raw("setMessageReaction", params)
-	if err != nil {
+	if _, err := botapi.SetMessageReaction(b, params); err != nil {
 		return wrapError(err)
 	}
-
 	return nil
 }
 
-func reactionType(reaction string) string {
-	if utf8.RuneCountInString(reaction) <= 4 {
-		return "emoji"
+// newReactionType tells the custom emoji IDs, which are numeric, from the emojis.
+func newReactionType(reaction string) botapi.ReactionType {
+	isID := reaction != "" && strings.Trim(reaction, "0123456789") == ""
+	if !isID {
+		return botapi.ReactionType{Type: botapi.ReactionTypeEmoji, Emoji: reaction}
 	}
-	return "custom_emoji_id"
+	return botapi.ReactionType{Type: botapi.ReactionTypeCustomEmoji, CustomEmojiID: reaction}
 }
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"sync"
 	"time"
+
+	"github.com/heilkit/tg/clock"
 )
 
 type scheduler struct {
@@ -17,6 +19,9 @@ type scheduler struct {
 	sync        *sync.RWMutex
 	events      []event
 	pollingRate time.Duration
+	clock       clock.Clock
+	// ownClock tells whether the clock is set by CustomClock, see UseClock
+	ownClock bool
 }
 
 var _ Scheduler = &scheduler{}
@@ -27,9 +32,13 @@ func (sch *scheduler) SyncFunc(count int, chat string, fn RawFunc) (ret []byte,
 		return
 	}
 
-	ticker := time.NewTicker(sch.pollingRate)
+	sch.sync.RLock()
+	clk := clock.Or(sch.clock)
+	sch.sync.RUnlock()
+
+	ticker := clk.NewTicker(sch.pollingRate)
 	defer ticker.Stop()
-	for now := time.Now(); true; now = <-ticker.C {
+	for now := clk.Now(); true; now = <-ticker.C() {
 		sch.sync.Lock()
 		sch.handleEvents(now)
 
@@ -39,7 +48,7 @@ func (sch *scheduler) SyncFunc(count int, chat string, fn RawFunc) (ret []byte,
 		}
 
 		ret, err = fn()
-		sch.add(count, chat)
+		sch.add(clk.Now(), count, chat)
 
 		sch.sync.Unlock()
 		break
@@ -71,9 +80,7 @@ func (sch *scheduler) order() {
 	})
 }
 
-func (sch *scheduler) add(count int, chat string) {
-	now := time.Now()
-
+func (sch *scheduler) add(now time.Time, count int, chat string) {
 	sch.global += count
 	sch.events = append(sch.events, event{
 		time:  now.Add(ApiRequestQuotaTimeout),
@@ -1,8 +1,11 @@
 package scheduler
 
 import (
+	"errors"
 	"sync"
 	"time"
+
+	"github.com/heilkit/tg/clock"
 )
 
 const (
@@ -40,7 +43,14 @@ func ExtraConservative() Scheduler {
 }
 
 // Custom Telegram API limits, global -- per second, perChat -- per minute.
+// The time is measured with the clock of the bot, see UseClock.
 func Custom(global int, perChat int, pollingRate time.Duration) Scheduler {
+	return CustomClock(global, perChat, pollingRate, nil)
+}
+
+// CustomClock is Custom, which measures the time with clk, i.e. a fake one in tests.
+// If clk is nil, it's the one of UseClock, or clock.Real().
+func CustomClock(global int, perChat int, pollingRate time.Duration, clk clock.Clock) Scheduler {
 	return &scheduler{
 		globalLimit:  global,
 		global:       0,
@@ -49,7 +59,31 @@ func Custom(global int, perChat int, pollingRate time.Duration) Scheduler {
 		sync:         &sync.RWMutex{},
 		events:       []event{},
 		pollingRate:  pollingRate,
+		clock:        clk,
+		ownClock:     clk != nil,
+	}
+}
+
+// UseClock binds the scheduler of this package to clk, nil is clock.Real(). It's called by tg.NewBot
+// with Settings.Clock, so the scheduler is bound to the clock of the first bot, it's given to.
+// Sharing it with a bot of another clock is an error. The schedulers of CustomClock keep their clock,
+// and the other schedulers are left as is.
+func UseClock(sch Scheduler, clk clock.Clock) error {
+	s, ok := sch.(*scheduler)
+	if !ok || s == nil || s.ownClock {
+		return nil
+	}
+	clk = clock.Or(clk)
+
+	s.sync.Lock()
+	defer s.sync.Unlock()
+	switch {
+	case s.clock == nil:
+		s.clock = clk
+	case s.clock != clk:
+		return errors.New("scheduler: the scheduler is bound to another clock")
 	}
+	return nil
 }
 
 // Nil scheduler does nothing, performing all functions ASAP.
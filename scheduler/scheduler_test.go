@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/heilkit/tg/clock/clocktest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+
+// syncAt calls SyncFunc in the background, advancing the clock by step, while it waits,
+// and returns the time, fn is called at.
+func syncAt(t *testing.T, clk *clocktest.Clock, sch Scheduler, chat string, step time.Duration) time.Time {
+	called := make(chan time.Time, 1)
+	go func() {
+		_, _ = sch.SyncFunc(1, chat, func() ([]byte, error) {
+			called <- clk.Now()
+			return nil, nil
+		})
+	}()
+
+	for i := 0; i < 1000; i++ {
+		select {
+		case at := <-called:
+			return at
+		case <-time.After(time.Millisecond):
+		}
+		if clk.Waiters() > 0 {
+			clk.Advance(step)
+		}
+	}
+	require.FailNow(t, "SyncFunc is not called")
+	return time.Time{}
+}
+
+func TestScheduler(t *testing.T) {
+	t.Run("Global", func(t *testing.T) {
+		clk := clocktest.New(start)
+		sch := CustomClock(2, ApiRequestQuotaPerChat, DefaultPollingRate, clk)
+
+		for _, chat := range []string{"1", "2"} {
+			_, err := sch.SyncFunc(1, chat, func() ([]byte, error) { return nil, nil })
+			require.NoError(t, err)
+		}
+		at := syncAt(t, clk, sch, "3", DefaultPollingRate)
+		assert.False(t, at.Before(start.Add(ApiRequestQuotaTimeout)), at)
+		assert.True(t, at.Before(start.Add(ApiRequestQuotaTimeout+time.Second)), at)
+	})
+
+	t.Run("PerChat", func(t *testing.T) {
+		clk := clocktest.New(start)
+		sch := CustomClock(ApiRequestQuota, 1, DefaultPollingRate, clk)
+
+		for _, chat := range []string{"-1", "1", "1"} {
+			// the private chats have no per chat quota
+			_, err := sch.SyncFunc(1, chat, func() ([]byte, error) {
+				assert.Equal(t, start, clk.Now())
+				return nil, nil
+			})
+			require.NoError(t, err)
+		}
+
+		at := syncAt(t, clk, sch, "-1", time.Second)
+		assert.False(t, at.Before(start.Add(ApiRequestQuotaPerChatTimeout)), at)
+	})
+
+	t.Run("UseClock", func(t *testing.T) {
+		clk := clocktest.New(start)
+		sch := Custom(1, ApiRequestQuotaPerChat, DefaultPollingRate)
+		require.NoError(t, UseClock(sch, clk))
+
+		_, err := sch.SyncFunc(1, "1", func() ([]byte, error) { return nil, nil })
+		require.NoError(t, err)
+		at := syncAt(t, clk, sch, "1", DefaultPollingRate)
+		assert.False(t, at.Before(start.Add(ApiRequestQuotaTimeout)), at)
+
+		// the scheduler is bound to the first clock
+		assert.NoError(t, UseClock(sch, clk))
+		assert.Error(t, UseClock(sch, clocktest.New(start)))
+		assert.Error(t, UseClock(sch, nil))
+		assert.NoError(t, UseClock(Default(), nil))
+		assert.NoError(t, UseClock(Nil(), clk))
+
+		// the clock of CustomClock is kept
+		own := clocktest.New(start)
+		sch = CustomClock(1, ApiRequestQuotaPerChat, DefaultPollingRate, own)
+		require.NoError(t, UseClock(sch, clk))
+		_, err = sch.SyncFunc(1, "1", func() ([]byte, error) { return nil, nil })
+		require.NoError(t, err)
+		at = syncAt(t, own, sch, "1", DefaultPollingRate)
+		assert.False(t, at.Before(start.Add(ApiRequestQuotaTimeout)), at)
+	})
+}
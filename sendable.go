@@ -3,7 +3,6 @@ package tg
 import (
 	"encoding/json"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strconv"
 )
@@ -25,16 +24,10 @@ type Sendable interface {
 
 // Send delivers media through bot b to recipient.
 func (p *Photo) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
-	for _, mod := range p.Mods {
-		temporaries, err := mod(p)
-		for _, tmp := range temporaries {
-			if tmp != "" {
-				defer os.Remove(tmp)
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, p, p.Mods); err != nil {
+		return nil, err
 	}
 
 	params := map[string]string{
@@ -43,7 +36,7 @@ func (p *Photo) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 	}
 	b.embedSendOptions(params, opt)
 
-	msg, err := b.sendMedia(p, params, nil)
+	msg, err := b.sendMedia(opt.recording(), p, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +50,12 @@ func (p *Photo) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 
 // Send delivers media through bot b to recipient.
 func (a *Audio) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, a, a.Mods); err != nil {
+		return nil, err
+	}
+
 	params := map[string]string{
 		"chat_id":   to.Recipient(),
 		"caption":   a.Caption,
@@ -70,7 +69,7 @@ func (a *Audio) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 		params["duration"] = strconv.Itoa(a.Duration)
 	}
 
-	msg, err := b.sendMedia(a, params, thumbnailToFilemap(a.Thumbnail))
+	msg, err := b.sendMedia(opt.recording(), a, params, thumbnailToFilemap(a.Thumbnail))
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +104,7 @@ func (d *Document) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error
 		params["disable_content_type_detection"] = "true"
 	}
 
-	msg, err := b.sendMedia(d, params, thumbnailToFilemap(d.Thumbnail))
+	msg, err := b.sendMedia(opt.recording(), d, params, thumbnailToFilemap(d.Thumbnail))
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +130,7 @@ func (s *Sticker) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error)
 	}
 	b.embedSendOptions(params, opt)
 
-	msg, err := b.sendMedia(s, params, nil)
+	msg, err := b.sendMedia(opt.recording(), s, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -144,16 +143,10 @@ func (s *Sticker) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error)
 
 // Send delivers media through bot b to recipient.
 func (v *Video) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
-	for _, mod := range v.Mods {
-		temporaries, err := mod(v)
-		for _, tmp := range temporaries {
-			if tmp != "" {
-				defer os.Remove(tmp)
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, v, v.Mods); err != nil {
+		return nil, err
 	}
 
 	params := map[string]string{
@@ -176,7 +169,7 @@ func (v *Video) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 		params["supports_streaming"] = "true"
 	}
 
-	msg, err := b.sendMedia(v, params, thumbnailToFilemap(v.Thumbnail))
+	msg, err := b.sendMedia(opt.recording(), v, params, thumbnailToFilemap(v.Thumbnail))
 	if err != nil {
 		return nil, err
 	}
@@ -200,16 +193,10 @@ func (v *Video) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 // Send delivers animation through bot b to recipient.
 func (a *Animation) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 	v := a.ToVideo()
-	for _, mod := range v.Mods {
-		temporaries, err := mod(v)
-		for _, tmp := range temporaries {
-			if tmp != "" {
-				defer os.Remove(tmp)
-			}
-		}
-		if err != nil {
-			return nil, err
-		}
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, v, v.Mods); err != nil {
+		return nil, err
 	}
 	a = v.ToAnimation()
 
@@ -235,7 +222,7 @@ func (a *Animation) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, erro
 		params["file_name"] = filepath.Base(a.File.FileLocal)
 	}
 
-	msg, err := b.sendMedia(a, params, thumbnailToFilemap(a.Thumbnail))
+	msg, err := b.sendMedia(opt.recording(), a, params, thumbnailToFilemap(a.Thumbnail))
 	if err != nil {
 		return nil, err
 	}
@@ -258,6 +245,12 @@ func (a *Animation) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, erro
 
 // Send delivers media through bot b to recipient.
 func (v *Voice) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, v, v.Mods); err != nil {
+		return nil, err
+	}
+
 	params := map[string]string{
 		"chat_id": to.Recipient(),
 		"caption": v.Caption,
@@ -268,7 +261,7 @@ func (v *Voice) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 		params["duration"] = strconv.Itoa(v.Duration)
 	}
 
-	msg, err := b.sendMedia(v, params, nil)
+	msg, err := b.sendMedia(opt.recording(), v, params, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -281,24 +274,32 @@ func (v *Voice) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 
 // Send delivers media through bot b to recipient.
 func (v *VideoNote) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
+	video := v.ToVideo()
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, video, video.Mods); err != nil {
+		return nil, err
+	}
+	note := video.ToVideoNote()
+
 	params := map[string]string{
 		"chat_id": to.Recipient(),
 	}
 	b.embedSendOptions(params, opt)
 
-	if v.Duration != 0 {
-		params["duration"] = strconv.Itoa(v.Duration)
+	if note.Duration != 0 {
+		params["duration"] = strconv.Itoa(note.Duration)
 	}
-	if v.Length != 0 {
-		params["length"] = strconv.Itoa(v.Length)
+	if note.Length != 0 {
+		params["length"] = strconv.Itoa(note.Length)
 	}
 
-	msg, err := b.sendMedia(v, params, thumbnailToFilemap(v.Thumbnail))
+	msg, err := b.sendMedia(opt.recording(), note, params, thumbnailToFilemap(note.Thumbnail))
 	if err != nil {
 		return nil, err
 	}
 
-	msg.VideoNote.File.stealRef(&v.File)
+	msg.VideoNote.File.stealRef(&note.File)
 	*v = *msg.VideoNote
 
 	return msg, nil
@@ -323,7 +324,7 @@ func (x *Location) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error
 	}
 	b.embedSendOptions(params, opt)
 
-	data, err := b.Raw("sendLocation", params)
+	data, err := b.raw(opt.recording(), "sendLocation", params)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +347,7 @@ func (v *Venue) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 	}
 	b.embedSendOptions(params, opt)
 
-	data, err := b.Raw("sendVenue", params)
+	data, err := b.raw(opt.recording(), "sendVenue", params)
 	if err != nil {
 		return nil, err
 	}
@@ -360,7 +361,7 @@ func (i *Invoice) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error)
 	params["chat_id"] = to.Recipient()
 	b.embedSendOptions(params, opt)
 
-	data, err := b.Raw("sendInvoice", params)
+	data, err := b.raw(opt.recording(), "sendInvoice", params)
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +399,7 @@ func (p *Poll) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 	opts, _ := json.Marshal(options)
 	params["options"] = string(opts)
 
-	data, err := b.Raw("sendPoll", params)
+	data, err := b.raw(opt.recording(), "sendPoll", params)
 	if err != nil {
 		return nil, err
 	}
@@ -414,7 +415,7 @@ func (d *Dice) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 	}
 	b.embedSendOptions(params, opt)
 
-	data, err := b.Raw("sendDice", params)
+	data, err := b.raw(opt.recording(), "sendDice", params)
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +431,7 @@ func (g *Game) Send(b *Bot, to Recipient, opt *SendOptions) (*Message, error) {
 	}
 	b.embedSendOptions(params, opt)
 
-	data, err := b.Raw("sendGame", params)
+	data, err := b.raw(opt.recording(), "sendGame", params)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,46 @@
+package tg
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVideoNoteSend(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "sendVideoNote", filepath.Base(r.URL.Path))
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		// the modified note is sent
+		assert.Equal(t, "3", r.FormValue("duration"))
+		assert.Equal(t, "240", r.FormValue("length"))
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"message_id":1,"chat":{"id":1},` +
+			`"video_note":{"file_id":"note","file_unique_id":"unique","file_size":4,"length":240,"duration":3}}}`))
+	}))
+	defer srv.Close()
+
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client()})
+	require.NoError(t, err)
+
+	input := filepath.Join(t.TempDir(), "note.mp4")
+	require.NoError(t, os.WriteFile(input, []byte("note"), 0o644))
+	note := &VideoNote{File: FromDisk(input), Length: 240}
+	note.Mods = []VideoModifier{func(video *Video, ws *Workspace) error {
+		video.Duration = 3
+		return nil
+	}}
+
+	msg, err := b.Send(&Chat{ID: 1}, note)
+	require.NoError(t, err)
+	assert.Equal(t, "note", msg.VideoNote.FileID)
+
+	// the sent note is filled by the response
+	assert.Equal(t, "note", note.FileID)
+	assert.EqualValues(t, 4, note.FileSize)
+	assert.Equal(t, 3, note.Duration)
+	assert.Equal(t, input, note.FileLocal)
+}
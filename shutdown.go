@@ -0,0 +1,174 @@
+package tg
+
+import (
+	"context"
+	"sync"
+)
+
+// ShutdownReport tells, what was done and what was abandoned on stop, see Bot.StopContext.
+type ShutdownReport struct {
+	// Drained is the number of buffered updates, processed after the poller was stopped.
+	Drained int
+	// Abandoned are the buffered updates, which were not processed before the deadline.
+	// Persist them, if they matter, i.e. to process them on the next start.
+	Abandoned []Update
+	// Albums is the number of buffered albums, which were handled on stop.
+	Albums int
+	// Unfinished is the number of handlers, which were still running at the deadline.
+	// Their requests are cancelled.
+	Unfinished int
+	// PendingJobs is the number of jobs left in the store, see Settings.Jobs.
+	PendingJobs int
+}
+
+// stopRequest is sent to the Start loop by StopContext.
+type stopRequest struct {
+	ctx    context.Context
+	report chan *ShutdownReport
+}
+
+// Stop gracefully shuts the bot down, waiting for the handlers without a deadline, see StopContext.
+func (b *Bot) Stop() {
+	_, _ = b.StopContext(context.Background())
+}
+
+// StopContext gracefully shuts the bot down:
+//   - the poller is stopped, so no new updates are accepted;
+//   - the updates, buffered in Bot.Updates, are processed;
+//   - the buffered albums are handled, see AlbumPolicy;
+//   - in-flight handlers, including cron jobs, are waited for;
+//   - the timers of jobs are stopped, the jobs stay in the store.
+//
+// If ctx is done before that, the rest of the updates is abandoned, requests of the unfinished handlers
+// are cancelled, and ctx.Err() is returned along with the report.
+func (b *Bot) StopContext(ctx context.Context) (*ShutdownReport, error) {
+	req := stopRequest{ctx: ctx, report: make(chan *ShutdownReport, 1)}
+	b.stop <- req
+	report := <-req.report
+	return report, ctx.Err()
+}
+
+// shutdown is run by the Start loop after the poller is stopped, pending are the updates, received meanwhile.
+func (b *Bot) shutdown(ctx context.Context, pending []Update) *ShutdownReport {
+	report := &ShutdownReport{}
+
+	for len(b.Updates) > 0 {
+		pending = append(pending, <-b.Updates)
+	}
+	if b.dispatcher != nil {
+		// a dispatch, blocked by the full queue, returns at the deadline, dropping the update
+		stopAbort := context.AfterFunc(ctx, b.dispatcher.aborter())
+		defer b.dispatcher.close()
+		defer stopAbort()
+	}
+
+	for i, upd := range pending {
+		if ctx.Err() != nil {
+			report.Abandoned = pending[i:]
+			break
+		}
+		var rejected uint64
+		if b.dispatcher != nil {
+			rejected = b.dispatcher.rejected()
+		}
+		b.ProcessUpdate(upd)
+		b.acks.done(upd.ID)
+		if b.dispatcher != nil && b.dispatcher.rejected() != rejected {
+			report.Abandoned = pending[i:]
+			break
+		}
+		report.Drained++
+	}
+
+	b.crons.stop()
+
+	// handlers could add messages to the albums, so they are waited for before and after the flush
+	_, err := b.inflight.wait(ctx)
+	if err == nil {
+		report.Albums = b.aggregators.flush()
+		_, err = b.inflight.wait(ctx)
+	}
+	if err != nil {
+		report.Unfinished, _ = b.inflight.wait(ctx)
+		close(b.stopClient)
+	}
+
+	b.jobs.disarm()
+	if jobs, err := b.jobs.store.List(); err == nil {
+		report.PendingJobs = len(jobs)
+	} else {
+		b.OnError(err, nil)
+	}
+	return report
+}
+
+// inflight counts the running handlers.
+type inflight struct {
+	mu   sync.Mutex
+	n    int
+	idle chan struct{}
+}
+
+func (f *inflight) add() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.n++
+}
+
+func (f *inflight) done() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.n--
+	if f.n == 0 && f.idle != nil {
+		close(f.idle)
+		f.idle = nil
+	}
+}
+
+// wait blocks until there are no running handlers, or ctx is done, returning the number of the running ones.
+func (f *inflight) wait(ctx context.Context) (int, error) {
+	f.mu.Lock()
+	if f.n == 0 {
+		f.mu.Unlock()
+		return 0, nil
+	}
+	if f.idle == nil {
+		f.idle = make(chan struct{})
+	}
+	idle := f.idle
+	f.mu.Unlock()
+
+	select {
+	case <-idle:
+		return 0, nil
+	case <-ctx.Done():
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		return f.n, ctx.Err()
+	}
+}
+
+// albumAggregators are the aggregators of HandleAlbum, they are flushed on stop.
+type albumAggregators struct {
+	mu   sync.Mutex
+	list []*albumAggregator
+}
+
+func (aggrs *albumAggregators) add(aggr *albumAggregator) {
+	aggrs.mu.Lock()
+	defer aggrs.mu.Unlock()
+	aggrs.list = append(aggrs.list, aggr)
+}
+
+// flush handles all the buffered albums, returning their number.
+func (aggrs *albumAggregators) flush() int {
+	aggrs.mu.Lock()
+	list := append([]*albumAggregator{}, aggrs.list...)
+	aggrs.mu.Unlock()
+
+	count := 0
+	for _, aggr := range list {
+		count += aggr.flush()
+	}
+	return count
+}
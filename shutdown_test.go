@@ -0,0 +1,86 @@
+package tg
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stopPoller delivers its updates only when it's stopped, like a getUpdates request finished meanwhile.
+type stopPoller struct {
+	updates []Update
+}
+
+func (p *stopPoller) Poll(b *Bot, dest chan Update, stop chan struct{}) {
+	<-stop
+	for _, upd := range p.updates {
+		dest <- upd
+	}
+}
+
+func TestStopContext(t *testing.T) {
+	updates := []Update{
+		{ID: 1, Message: &Message{ID: 1, Chat: &Chat{ID: 1}, Text: "one"}},
+		{ID: 2, Message: &Message{ID: 2, Chat: &Chat{ID: 1}, Text: "two"}},
+		{ID: 3, Message: &Message{ID: 3, Chat: &Chat{ID: 1}, AlbumID: "a", Photo: &Photo{}}},
+	}
+
+	t.Run("Drain", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true, Updates: 1, Poller: &stopPoller{updates}})
+		require.NoError(t, err)
+
+		texts := atomic.Int32{}
+		albums := atomic.Int32{}
+		b.Handle(OnText, func(c Context) error {
+			time.Sleep(10 * time.Millisecond)
+			texts.Add(1)
+			return nil
+		})
+		b.HandleAlbum(func(cs Contexts) error {
+			albums.Add(1)
+			return nil
+		}, time.Hour)
+
+		go b.Start()
+		report, err := b.StopContext(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, &ShutdownReport{Drained: 3, Albums: 1}, report)
+		assert.EqualValues(t, 2, texts.Load())
+		assert.EqualValues(t, 1, albums.Load())
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true, Poller: &stopPoller{updates[:2]}})
+		require.NoError(t, err)
+
+		release := make(chan struct{})
+		defer close(release)
+		b.Handle(OnText, func(c Context) error {
+			<-release
+			return nil
+		})
+
+		go b.Start()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		report, err := b.StopContext(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, &ShutdownReport{Drained: 2, Unfinished: 2}, report)
+	})
+
+	t.Run("Abandon", func(t *testing.T) {
+		b, err := NewBot(Settings{Offline: true, Poller: &stopPoller{updates[:2]}})
+		require.NoError(t, err)
+
+		go b.Start()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		report, err := b.StopContext(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, &ShutdownReport{Abandoned: updates[:2]}, report)
+	})
+}
@@ -28,6 +28,18 @@ type StickerSet struct {
 	Emojis        string         `json:"emojis"`
 	ContainsMasks bool           `json:"contains_masks"` // FIXME: can be removed
 	MaskPosition  *MaskPosition  `json:"mask_position"`
+
+	// internal
+	Mods []StickerModifier `json:"-"`
+}
+
+// StickerModifier a simple modifier function, called before sticker files (PNG, TGS, WebM) are uploaded.
+// Temporary files shall be created within the Workspace, they are removed after the upload.
+type StickerModifier func(set *StickerSet, ws *Workspace) error
+
+func (s StickerSet) With(mods ...StickerModifier) *StickerSet {
+	s.Mods = append(s.Mods, mods...)
+	return &s
 }
 
 // MaskPosition describes the position on faces where
@@ -50,7 +62,18 @@ const (
 )
 
 // UploadSticker uploads a PNG file with a sticker for later use.
-func (b *Bot) UploadSticker(to Recipient, png *File) (*File, error) {
+// Modifiers (i.e. tgsticker.Static) are applied to the file before uploading.
+func (b *Bot) UploadSticker(to Recipient, png *File, mods ...StickerModifier) (*File, error) {
+	if len(mods) > 0 {
+		set := &StickerSet{PNG: png, Mods: mods}
+		ws := b.NewWorkspace()
+		defer ws.Cleanup()
+		if err := applyMods(ws, set, set.Mods); err != nil {
+			return nil, err
+		}
+		png = set.PNG
+	}
+
 	files := map[string]File{
 		"png_sticker": *png,
 	}
@@ -89,7 +112,14 @@ func (b *Bot) StickerSet(name string) (*StickerSet, error) {
 }
 
 // CreateStickerSet creates a new sticker set.
+// StickerSet.Mods are applied to the sticker files before uploading.
 func (b *Bot) CreateStickerSet(to Recipient, s StickerSet) error {
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, &s, s.Mods); err != nil {
+		return err
+	}
+
 	files := make(map[string]File)
 	if s.PNG != nil {
 		files["png_sticker"] = *s.PNG
@@ -120,7 +150,14 @@ func (b *Bot) CreateStickerSet(to Recipient, s StickerSet) error {
 }
 
 // AddSticker adds a new sticker to the existing sticker set.
+// StickerSet.Mods are applied to the sticker file before uploading.
 func (b *Bot) AddSticker(to Recipient, s StickerSet) error {
+	ws := b.NewWorkspace()
+	defer ws.Cleanup()
+	if err := applyMods(ws, &s, s.Mods); err != nil {
+		return err
+	}
+
 	files := make(map[string]File)
 	if s.PNG != nil {
 		files["png_sticker"] = *s.PNG
@@ -0,0 +1,101 @@
+package tg
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStickerMods(t *testing.T) {
+	mu := sync.Mutex{}
+	uploads := map[string]map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		// the files go without names, so they are parsed as values
+		files := map[string]string{}
+		for _, field := range []string{"png_sticker", "tgs_sticker", "webm_sticker"} {
+			if value := r.FormValue(field); value != "" {
+				files[field] = value
+			}
+		}
+		mu.Lock()
+		uploads[filepath.Base(r.URL.Path)] = files
+		mu.Unlock()
+
+		if filepath.Base(r.URL.Path) == "uploadStickerFile" {
+			_, _ = w.Write([]byte(`{"ok":true,"result":{"file_id":"sticker","file_unique_id":"unique"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer srv.Close()
+
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client()})
+	require.NoError(t, err)
+
+	input := filepath.Join(t.TempDir(), "sticker.jpg")
+	require.NoError(t, os.WriteFile(input, []byte("jpeg"), 0o644))
+	// static replaces the image with the converted one, which lives in the workspace
+	converted := ""
+	static := func(set *StickerSet, ws *Workspace) error {
+		tmp, err := ws.CreateTemp(t.TempDir(), "*.png")
+		if err != nil {
+			return err
+		}
+		defer tmp.Close()
+		if _, err := tmp.WriteString("png"); err != nil {
+			return err
+		}
+		converted = tmp.Name()
+		file := FromDisk(tmp.Name())
+		set.PNG = &file
+		return nil
+	}
+
+	t.Run("UploadSticker", func(t *testing.T) {
+		file, err := b.UploadSticker(&User{ID: 1}, &File{FileLocal: input}, static)
+		require.NoError(t, err)
+		assert.Equal(t, "sticker", file.FileID)
+		assert.Equal(t, map[string]string{"png_sticker": "png"}, uploads["uploadStickerFile"])
+
+		// the converted file is cleaned up with the workspace
+		assert.NoFileExists(t, converted)
+		assert.FileExists(t, input)
+
+		_, err = b.UploadSticker(&User{ID: 1}, &File{FileLocal: input}, func(set *StickerSet, ws *Workspace) error {
+			return errors.New("failed")
+		})
+		assert.ErrorContains(t, err, "failed")
+	})
+
+	t.Run("CreateStickerSet", func(t *testing.T) {
+		webm := filepath.Join(t.TempDir(), "sticker.webm")
+		require.NoError(t, os.WriteFile(webm, []byte("webm"), 0o644))
+		video := func(set *StickerSet, ws *Workspace) error {
+			set.Video = true
+			return nil
+		}
+
+		set := StickerSet{Name: "set_by_bot", Title: "Set", Emojis: "🙂", PNG: &File{FileLocal: input}, WebM: &File{FileLocal: webm}}
+		require.NoError(t, b.CreateStickerSet(&User{ID: 1}, *set.With(static, video)))
+		assert.Equal(t, map[string]string{"png_sticker": "png", "webm_sticker": "webm"}, uploads["createNewStickerSet"])
+		assert.NoFileExists(t, converted)
+		// the set of the caller is left as it is
+		assert.Equal(t, input, set.PNG.FileLocal)
+		assert.False(t, set.Video)
+
+		delete(uploads, "createNewStickerSet")
+		err := b.CreateStickerSet(&User{ID: 1}, *set.With(func(set *StickerSet, ws *Workspace) error {
+			return errors.New("failed")
+		}))
+		assert.ErrorContains(t, err, "failed")
+		assert.NotContains(t, uploads, "createNewStickerSet")
+	})
+}
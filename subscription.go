@@ -0,0 +1,214 @@
+package tg
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// updateTypes are the types of the updates, the bot handles, in the order of the Update fields.
+var updateTypes = []string{
+	"message",
+	"edited_message",
+	"channel_post",
+	"edited_channel_post",
+	"callback_query",
+	"inline_query",
+	"chosen_inline_result",
+	"shipping_query",
+	"pre_checkout_query",
+	"poll",
+	"poll_answer",
+	"my_chat_member",
+	"chat_member",
+	"chat_join_request",
+}
+
+// endpointUpdates are the update types of the endpoints, which are not fired by messages.
+var endpointUpdates = map[string][]string{
+	OnEdited:            {"edited_message"},
+	OnChannelPost:       {"channel_post"},
+	OnEditedChannelPost: {"edited_channel_post"},
+	OnPinned:            {"message", "channel_post"},
+	OnCallback:          {"callback_query"},
+	OnQuery:             {"inline_query"},
+	OnInlineResult:      {"chosen_inline_result"},
+	OnShipping:          {"shipping_query"},
+	OnCheckout:          {"pre_checkout_query"},
+	OnPoll:              {"poll"},
+	OnPollAnswer:        {"poll_answer"},
+	OnMyChatMember:      {"my_chat_member"},
+	OnChatMember:        {"chat_member"},
+	OnChatJoinRequest:   {"chat_join_request"},
+}
+
+// messageEndpoints are the endpoints, fired by messages.
+var messageEndpoints = map[string]bool{
+	OnText: true, OnPhoto: true, OnAudio: true, OnAnimation: true, OnDocument: true, OnSticker: true,
+	OnVideo: true, OnVoice: true, OnVideoNote: true, OnContact: true, OnLocation: true, OnVenue: true,
+	OnDice: true, OnInvoice: true, OnPayment: true, OnGame: true, OnMedia: true,
+	OnTopicCreated: true, OnTopicReopened: true, OnTopicClosed: true, OnTopicEdited: true,
+	OnGeneralTopicHidden: true, OnGeneralTopicUnhidden: true, OnWriteAccessAllowed: true,
+	OnAddedToGroup: true, OnUserJoined: true, OnUserLeft: true, OnUserShared: true, OnChatShared: true,
+	OnNewGroupTitle: true, OnNewGroupPhoto: true, OnGroupPhotoDeleted: true,
+	OnGroupCreated: true, OnSuperGroupCreated: true, OnChannelCreated: true, OnMigration: true,
+	OnProximityAlert: true, OnAutoDeleteTimer: true, OnWebApp: true,
+	OnVideoChatStarted: true, OnVideoChatEnded: true, OnVideoChatParticipants: true, OnVideoChatScheduled: true,
+}
+
+// endpointUpdateTypes returns the update types, which fire the endpoint, or nil, if it's not a known event.
+func endpointUpdateTypes(end string) []string {
+	switch {
+	case strings.HasPrefix(end, "\f"):
+		return []string{"callback_query"}
+	case !strings.HasPrefix(end, "\a"), messageEndpoints[end]:
+		// commands and texts
+		return []string{"message"}
+	}
+	return endpointUpdates[end]
+}
+
+// AllowedUpdates returns the update types, the handlers of the bot are fired by, see LongPoller.AllowedUpdates.
+// It's nil without handlers.
+func (b *Bot) AllowedUpdates() []string {
+	b.handlersMu.RLock()
+	defer b.handlersMu.RUnlock()
+	return b.allowedUpdates
+}
+
+// updateAllowedLocked recomputes the allowed updates, returning whether they are changed.
+func (b *Bot) updateAllowedLocked() bool {
+	allowed := map[string]bool{}
+	for end := range b.handlers {
+		for _, kind := range endpointUpdateTypes(end) {
+			allowed[kind] = true
+		}
+	}
+
+	var types []string
+	for _, kind := range updateTypes {
+		if allowed[kind] {
+			types = append(types, kind)
+		}
+	}
+	if slices.Equal(types, b.allowedUpdates) {
+		return false
+	}
+	b.allowedUpdates = types
+	return true
+}
+
+// subscriber is a poller, which chooses the update types telegram sends.
+type subscriber interface {
+	// subscribed returns the update types, set explicitly, or nil, if they are the ones of the handlers.
+	subscribed() []string
+	// resubscribe is called, when the handlers of a started bot fire on new update types.
+	resubscribe(b *Bot)
+}
+
+func (p *LongPoller) subscribed() []string {
+	return p.AllowedUpdates
+}
+
+// resubscribe does nothing, the update types are passed with every request.
+func (p *LongPoller) resubscribe(*Bot) {}
+
+// allowedUpdates returns the update types to request.
+func (p *LongPoller) allowedUpdates(b *Bot) []string {
+	if len(p.AllowedUpdates) > 0 {
+		return p.AllowedUpdates
+	}
+	return b.AllowedUpdates()
+}
+
+func (h *Webhook) subscribed() []string {
+	return h.AllowedUpdates
+}
+
+// resubscribe sets the webhook again with the new update types, if it's attached to the bot.
+func (h *Webhook) resubscribe(b *Bot) {
+	if len(h.AllowedUpdates) > 0 {
+		return
+	}
+	if bot, _ := h.attached(); bot != b {
+		return
+	}
+	if err := b.SetWebhook(h); err != nil {
+		b.OnError(err, nil)
+	}
+}
+
+func (p *MiddlewarePoller) subscribed() []string {
+	if s, ok := p.Poller.(subscriber); ok {
+		return s.subscribed()
+	}
+	return nil
+}
+
+func (p *MiddlewarePoller) resubscribe(b *Bot) {
+	if s, ok := p.Poller.(subscriber); ok {
+		s.resubscribe(b)
+	}
+}
+
+// subscribed returns the update types of both of the pollers, or nil, if either of them uses the ones of the handlers.
+func (p *FallbackPoller) subscribed() []string {
+	if p.Webhook == nil || p.LongPoller == nil {
+		return nil
+	}
+	webhook, long := p.Webhook.subscribed(), p.LongPoller.subscribed()
+	if len(webhook) == 0 || len(long) == 0 {
+		return nil
+	}
+	return append(slices.Clone(webhook), long...)
+}
+
+func (p *FallbackPoller) resubscribe(b *Bot) {
+	if p.Webhook != nil {
+		p.Webhook.resubscribe(b)
+	}
+}
+
+// resubscribe passes the new update types to the poller of a started bot, the request is made in background.
+func (b *Bot) resubscribe() {
+	s, ok := b.Poller.(subscriber)
+	if !ok || !b.started() {
+		return
+	}
+	go func() {
+		b.subscribeMu.Lock()
+		defer b.subscribeMu.Unlock()
+		s.resubscribe(b)
+	}()
+}
+
+// checkHandlers reports the handlers, which can never fire, to OnError.
+// Without endpoints, all of them are checked.
+func (b *Bot) checkHandlers(endpoints ...string) {
+	var explicit []string
+	if s, ok := b.Poller.(subscriber); ok {
+		explicit = s.subscribed()
+	}
+
+	if len(endpoints) == 0 {
+		b.handlersMu.RLock()
+		for end := range b.handlers {
+			endpoints = append(endpoints, end)
+		}
+		b.handlersMu.RUnlock()
+		slices.Sort(endpoints)
+	}
+
+	for _, end := range endpoints {
+		types := endpointUpdateTypes(end)
+		if types == nil {
+			b.OnError(fmt.Errorf("telebot: %q handler can never fire, it's not a known event", end), nil)
+			continue
+		}
+		if len(explicit) == 0 || slices.ContainsFunc(types, func(kind string) bool { return slices.Contains(explicit, kind) }) {
+			continue
+		}
+		b.OnError(fmt.Errorf("telebot: %q handler can never fire, %s updates are not allowed by the poller",
+			end, strings.Join(types, ", ")), nil)
+	}
+}
@@ -0,0 +1,93 @@
+package tg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotAllowedUpdates(t *testing.T) {
+	b, err := NewBot(Settings{Offline: true})
+	require.NoError(t, err)
+	assert.Nil(t, b.AllowedUpdates())
+
+	b.Handle("/start", func(Context) error { return nil })
+	b.Handle(&Btn{Unique: "buy"}, func(Context) error { return nil })
+	b.Handle(OnChatMember, func(Context) error { return nil })
+	b.Handle(OnPinned, func(Context) error { return nil })
+	assert.Equal(t, []string{"message", "channel_post", "callback_query", "chat_member"}, b.AllowedUpdates())
+}
+
+func TestBotCheckHandlers(t *testing.T) {
+	errs := []string{}
+	b, err := NewBot(Settings{
+		Offline: true,
+		Poller:  &LongPoller{AllowedUpdates: []string{"message"}},
+		OnError: func(err error, _ Context) { errs = append(errs, err.Error()) },
+	})
+	require.NoError(t, err)
+
+	b.Handle(OnText, func(Context) error { return nil })
+	b.Handle(OnPinned, func(Context) error { return nil })
+	b.Handle(OnCallback, func(Context) error { return nil })
+	b.Handle("\atypo", func(Context) error { return nil })
+	b.checkHandlers()
+
+	assert.Equal(t, []string{
+		`telebot: "\acallback" handler can never fire, callback_query updates are not allowed by the poller`,
+		`telebot: "\atypo" handler can never fire, it's not a known event`,
+	}, errs)
+}
+
+func TestWebhookResubscribe(t *testing.T) {
+	mu := sync.Mutex{}
+	allowed := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/setWebhook") {
+			params := map[string]string{}
+			_ = json.NewDecoder(r.Body).Decode(&params)
+			mu.Lock()
+			allowed = append(allowed, params["allowed_updates"])
+			mu.Unlock()
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer srv.Close()
+	last := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(allowed) == 0 {
+			return ""
+		}
+		return allowed[len(allowed)-1]
+	}
+
+	b, err := NewBot(Settings{Offline: true, URL: srv.URL, Client: srv.Client(), Poller: &Webhook{}})
+	require.NoError(t, err)
+	b.Handle(OnText, func(Context) error { return nil })
+	go b.Start()
+	defer b.Stop()
+
+	assert.Eventually(t, func() bool { return last() == `["message"]` }, time.Second, time.Millisecond)
+
+	b.Handle(OnChatMember, func(Context) error { return nil })
+	assert.Eventually(t, func() bool { return last() == `["message","chat_member"]` }, time.Second, time.Millisecond)
+
+	// the same update types
+	b.Handle("/start", func(Context) error { return nil })
+	time.Sleep(10 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, allowed, 2)
+
+	var types []string
+	require.NoError(t, json.Unmarshal([]byte(allowed[1]), &types))
+	assert.Equal(t, b.AllowedUpdates(), types)
+}
@@ -0,0 +1,48 @@
+// Package tgaudio provides modifiers for tg.Audio and tg.Voice.
+package tgaudio
+
+import "github.com/heilkit/tg/tgproc"
+
+const (
+	ffmpeg       = "ffmpeg"
+	ffprobe      = "ffprobe"
+	voiceBitrate = "48k"
+	audioBitrate = "192k"
+)
+
+// Opt for modifiers. Not all of them are used every time.
+type Opt struct {
+	Ffmpeg  string
+	Ffprobe string
+	TempDir string
+
+	// VoiceBitrate is an ffmpeg bitrate for OGG/Opus voice notes, i.e. "48k".
+	VoiceBitrate string
+	// AudioBitrate is an ffmpeg bitrate for MP3 conversion, i.e. "192k".
+	AudioBitrate string
+
+	// Processor runs the actual commands, defaulted to tgproc.Exec with Ffmpeg and Ffprobe binaries.
+	Processor tgproc.MediaProcessor
+}
+
+func (opts *Opt) Defaults() *Opt {
+	if opts == nil {
+		opts = &Opt{}
+	}
+	if opts.Ffmpeg == "" {
+		opts.Ffmpeg = ffmpeg
+	}
+	if opts.Ffprobe == "" {
+		opts.Ffprobe = ffprobe
+	}
+	if opts.VoiceBitrate == "" {
+		opts.VoiceBitrate = voiceBitrate
+	}
+	if opts.AudioBitrate == "" {
+		opts.AudioBitrate = audioBitrate
+	}
+	if opts.Processor == nil {
+		opts.Processor = tgproc.Exec(&tgproc.ExecOpt{Ffmpeg: opts.Ffmpeg, Ffprobe: opts.Ffprobe})
+	}
+	return opts
+}
@@ -0,0 +1,82 @@
+package tgaudio
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc"
+	"strconv"
+	"strings"
+)
+
+type fileMetadata struct {
+	Streams []struct {
+		CodecType   string `json:"codec_type"`
+		Disposition struct {
+			AttachedPic int `json:"attached_pic"`
+		} `json:"disposition"`
+	} `json:"streams"`
+	Format struct {
+		Duration string            `json:"duration"`
+		Tags     map[string]string `json:"tags"`
+	} `json:"format"`
+}
+
+func getFileMetadata(proc tgproc.MediaProcessor, filename string) (*fileMetadata, error) {
+	output, err := proc.Ffprobe("-v", "error", "-show_entries", "stream=codec_type:stream_disposition=attached_pic",
+		"-of", "json", "-show_format", filename)
+	if err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, string(output))
+	}
+
+	var metadata fileMetadata
+	if err := json.Unmarshal(output, &metadata); err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, string(output))
+	}
+	return &metadata, nil
+}
+
+func (meta *fileMetadata) duration() float64 {
+	duration, err := strconv.ParseFloat(meta.Format.Duration, 64)
+	if err != nil {
+		return 0
+	}
+	return duration
+}
+
+// tag looks the key up case-insensitively, since the case depends on the container.
+func (meta *fileMetadata) tag(keys ...string) string {
+	for _, key := range keys {
+		for k, v := range meta.Format.Tags {
+			if strings.EqualFold(k, key) && v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+// hasCover tells, if there is a cover art, i.e. an attached picture, not a video stream of a video file.
+func (meta *fileMetadata) hasCover() bool {
+	for _, stream := range meta.Streams {
+		if stream.CodecType == "video" && stream.Disposition.AttachedPic == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func parseOpts(opts ...*Opt) *Opt {
+	options := &Opt{}
+	if len(opts) != 0 {
+		options = opts[0]
+	}
+	return options.Defaults()
+}
+
+// bound returns a copy of the options, which processor is stopped with the workspace, see tg.Workspace.Context.
+func (opts *Opt) bound(ws *tg.Workspace) *Opt {
+	bound := *opts
+	bound.Processor = tgproc.WithContext(ws.Context(), opts.Processor)
+	return &bound
+}
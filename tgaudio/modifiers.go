@@ -0,0 +1,268 @@
+package tgaudio
+
+import (
+	"fmt"
+	"github.com/heilkit/tg"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Duration probes the media duration in seconds.
+// REQUIRES `ffprobe` on the system, which could be passed via Opt.Ffprobe.
+func Duration(filename string, opts ...*Opt) (float64, error) {
+	meta, err := getFileMetadata(parseOpts(opts...).Processor, filename)
+	if err != nil {
+		return 0, fmt.Errorf("tgaudio.Duration: %v", err)
+	}
+	return meta.duration(), nil
+}
+
+// ToVoice converts any audio (or video) file into OGG/Opus, so it's displayed as a voice note.
+// REQUIRES `ffmpeg`, `ffprobe` on the system, which could be passed via Opt.
+func ToVoice(opts ...*Opt) tg.VoiceModifier {
+	options := parseOpts(opts...)
+
+	return func(voice *tg.Voice, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgaudio.ToVoice: %v", err)
+			}
+		}()
+
+		if voice == nil || voice.FileLocal == "" {
+			return nil
+		}
+
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*.ogg")
+		if err != nil {
+			return err
+		}
+		_ = tmpFile.Close()
+
+		_, err = options.Processor.Ffmpeg("-y", "-i", voice.FileLocal,
+			"-vn", "-ac", "1",
+			"-c:a", "libopus", "-b:a", options.VoiceBitrate, "-application", "voip",
+			tmpFile.Name())
+		if err != nil {
+			return err
+		}
+
+		voice.FileLocal = tmpFile.Name()
+		voice.MIME = "audio/ogg"
+		return setVoiceMeta(voice, options)
+	}
+}
+
+// VoiceMeta sets Voice.Duration and Voice.FileSize.
+// REQUIRES `ffprobe` on the system, which could be passed via Opt.Ffprobe.
+func VoiceMeta(opts ...*Opt) tg.VoiceModifier {
+	options := parseOpts(opts...)
+
+	return func(voice *tg.Voice, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		if voice == nil || voice.FileLocal == "" {
+			return nil
+		}
+		if err := setVoiceMeta(voice, options); err != nil {
+			return fmt.Errorf("tgaudio.VoiceMeta: %v", err)
+		}
+		return nil
+	}
+}
+
+// EnsureMeta sets Audio.Duration, Audio.FileSize and Audio.FileName, filling Title and Performer from tags, if empty.
+// REQUIRES `ffprobe` on the system, which could be passed via Opt.Ffprobe.
+func EnsureMeta(opts ...*Opt) tg.AudioModifier {
+	options := parseOpts(opts...)
+
+	return func(audio *tg.Audio, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		if audio == nil || audio.FileLocal == "" {
+			return nil
+		}
+		if _, err := setAudioMeta(audio, options); err != nil {
+			return fmt.Errorf("tgaudio.EnsureMeta: %v", err)
+		}
+		return nil
+	}
+}
+
+// ExtractTags is EnsureMeta, that also extracts the embedded cover art into Audio.Thumbnail.
+// REQUIRES `ffmpeg`, `ffprobe` on the system, which could be passed via Opt.
+func ExtractTags(opts ...*Opt) tg.AudioModifier {
+	options := parseOpts(opts...)
+
+	return func(audio *tg.Audio, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgaudio.ExtractTags: %v", err)
+			}
+		}()
+
+		if audio == nil || audio.FileLocal == "" {
+			return nil
+		}
+
+		meta, err := setAudioMeta(audio, options)
+		if err != nil || !meta.hasCover() || audio.Thumbnail != nil {
+			return err
+		}
+
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*_cover.jpg")
+		if err != nil {
+			return err
+		}
+		_ = tmpFile.Close()
+
+		_, err = options.Processor.Ffmpeg("-y", "-i", audio.FileLocal,
+			"-an", "-frames:v", "1",
+			"-vf", "scale=if(gte(iw\\,ih)\\,min(320\\,iw)\\,-2):if(lt(iw\\,ih)\\,min(320\\,ih)\\,-2)",
+			tmpFile.Name())
+		if err != nil {
+			return err
+		}
+
+		audio.Thumbnail = &tg.Photo{File: tg.FromDisk(tmpFile.Name())}
+		return nil
+	}
+}
+
+// ToMP3 converts an audio file into MP3, keeping its tags and cover art.
+// REQUIRES `ffmpeg`, `ffprobe` on the system, which could be passed via Opt.
+func ToMP3(opts ...*Opt) tg.AudioModifier {
+	options := parseOpts(opts...)
+
+	return func(audio *tg.Audio, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgaudio.ToMP3: %v", err)
+			}
+		}()
+
+		if audio == nil || audio.FileLocal == "" {
+			return nil
+		}
+
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*.mp3")
+		if err != nil {
+			return err
+		}
+		_ = tmpFile.Close()
+
+		_, err = options.Processor.Ffmpeg("-y", "-i", audio.FileLocal,
+			"-map", "0:a", "-map", "0:v?", "-c:v", "copy",
+			"-c:a", "libmp3lame", "-b:a", options.AudioBitrate,
+			"-id3v2_version", "3", "-map_metadata", "0",
+			tmpFile.Name())
+		if err != nil {
+			return err
+		}
+
+		audio.FileLocal = tmpFile.Name()
+		audio.MIME = "audio/mpeg"
+		if audio.FileName != "" {
+			audio.FileName = strings.TrimSuffix(audio.FileName, filepath.Ext(audio.FileName)) + ".mp3"
+		}
+		_, err = setAudioMeta(audio, options)
+		return err
+	}
+}
+
+// Tag writes ID3 title and performer into an MP3 file, cover is an optional path to the cover art image.
+// Audio.Title and Audio.Performer are set as well.
+// REQUIRES `ffmpeg` on the system, which could be passed via Opt.Ffmpeg.
+func Tag(title, performer string, cover string, opts ...*Opt) tg.AudioModifier {
+	options := parseOpts(opts...)
+
+	return func(audio *tg.Audio, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgaudio.Tag: %v", err)
+			}
+		}()
+
+		if audio == nil || audio.FileLocal == "" {
+			return nil
+		}
+
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*.mp3")
+		if err != nil {
+			return err
+		}
+		_ = tmpFile.Close()
+
+		args := []string{"-y", "-i", audio.FileLocal}
+		if cover != "" {
+			args = append(args, "-i", cover, "-map", "0:a", "-map", "1:v",
+				"-metadata:s:v", "title=Album cover", "-metadata:s:v", "comment=Cover (front)")
+		} else {
+			args = append(args, "-map", "0")
+		}
+		args = append(args, "-c", "copy", "-id3v2_version", "3",
+			"-metadata", "title="+title, "-metadata", "artist="+performer,
+			tmpFile.Name())
+
+		if _, err := options.Processor.Ffmpeg(args...); err != nil {
+			return err
+		}
+
+		audio.FileLocal = tmpFile.Name()
+		audio.Title = title
+		audio.Performer = performer
+		if cover != "" && audio.Thumbnail == nil {
+			audio.Thumbnail = &tg.Photo{File: tg.FromDisk(cover)}
+		}
+		return nil
+	}
+}
+
+// OnError allows set action on error for the wrapped tg.AudioModifier. If wrapper returns nil, the error is muted.
+// Temporaries of the failed modifier are still owned by the workspace.
+func OnError(mod tg.AudioModifier, fn func(err error) error) tg.AudioModifier {
+	return func(audio *tg.Audio, ws *tg.Workspace) error {
+		if err := mod(audio, ws); err != nil {
+			return fn(err)
+		}
+		return nil
+	}
+}
+
+func setAudioMeta(audio *tg.Audio, options *Opt) (*fileMetadata, error) {
+	meta, err := getFileMetadata(options.Processor, audio.FileLocal)
+	if err != nil {
+		return nil, err
+	}
+
+	audio.Duration = int(meta.duration())
+	if audio.Title == "" {
+		audio.Title = meta.tag("title")
+	}
+	if audio.Performer == "" {
+		audio.Performer = meta.tag("artist", "album_artist", "performer")
+	}
+	if audio.FileName == "" {
+		audio.FileName = filepath.Base(audio.FileLocal)
+	}
+	if stat, err := os.Stat(audio.FileLocal); err == nil {
+		audio.FileSize = stat.Size()
+	}
+	return meta, nil
+}
+
+func setVoiceMeta(voice *tg.Voice, options *Opt) error {
+	meta, err := getFileMetadata(options.Processor, voice.FileLocal)
+	if err != nil {
+		return err
+	}
+
+	voice.Duration = int(meta.duration())
+	if stat, err := os.Stat(voice.FileLocal); err == nil {
+		voice.FileSize = stat.Size()
+	}
+	return nil
+}
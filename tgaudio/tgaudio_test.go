@@ -0,0 +1,57 @@
+package tgaudio
+
+import (
+	"testing"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc/tgproctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAudio(t *testing.T) *tg.Audio {
+	return &tg.Audio{File: tg.FromDisk(tgproctest.WriteFile(t, "song.mp3", "ID3\x03\x00"))}
+}
+
+func TestFileMetadata(t *testing.T) {
+	meta, err := getFileMetadata(tgproctest.New("song.json"), "song.mp3")
+	require.NoError(t, err)
+	assert.Equal(t, 215.484082, meta.duration())
+	assert.Equal(t, "Song", meta.tag("title"))
+	assert.Equal(t, "Band", meta.tag("artist", "album_artist"))
+	assert.Empty(t, meta.tag("comment"))
+	assert.True(t, meta.hasCover())
+
+	// a video stream isn't a cover art
+	meta, err = getFileMetadata(tgproctest.New("clip.json"), "clip.mp4")
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, meta.duration())
+	assert.False(t, meta.hasCover())
+
+	_, err = getFileMetadata(tgproctest.New("missing.json"), "song.mp3")
+	assert.Error(t, err)
+}
+
+func TestExtractTags(t *testing.T) {
+	ws := tg.NewWorkspace(0)
+	defer ws.Cleanup()
+
+	proc := tgproctest.New("song.json")
+	audio := newAudio(t)
+	require.NoError(t, ExtractTags(&Opt{Processor: proc, TempDir: t.TempDir()})(audio, ws))
+	assert.Equal(t, 215, audio.Duration)
+	assert.Equal(t, "Song", audio.Title)
+	assert.Equal(t, "Band", audio.Performer)
+	assert.Equal(t, "song.mp3", audio.FileName)
+	assert.EqualValues(t, 5, audio.FileSize)
+	require.NotNil(t, audio.Thumbnail)
+	require.Len(t, proc.FfmpegCalls(), 1)
+	assert.Contains(t, proc.FfmpegCalls()[0], "-an")
+
+	proc = tgproctest.New("clip.json")
+	audio = newAudio(t)
+	require.NoError(t, ExtractTags(&Opt{Processor: proc, TempDir: t.TempDir()})(audio, ws))
+	assert.Equal(t, "Clip", audio.Title)
+	assert.Nil(t, audio.Thumbnail)
+	assert.Empty(t, proc.FfmpegCalls())
+}
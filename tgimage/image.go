@@ -1,5 +1,10 @@
 package tgimage
 
+import (
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc"
+)
+
 const (
 	convert = "convert"
 )
@@ -11,6 +16,10 @@ type Opt struct {
 	Convert    string
 	TempDir    string
 	Quality    int
+
+	// Processor does the conversion, defaulted to tgproc.Exec with Convert binary.
+	// Use tgproc.Native() if there is no ImageMagick on the system.
+	Processor tgproc.MediaProcessor
 }
 
 func parseOpts(opts ...*Opt) Opt {
@@ -22,10 +31,10 @@ func parseOpts(opts ...*Opt) Opt {
 		TempDir:    "",
 		Quality:    95,
 	}
-	if len(opts) == 0 {
-		return opt
+	opts_ := &Opt{}
+	if len(opts) != 0 && opts[0] != nil {
+		opts_ = opts[0]
 	}
-	opts_ := opts[0]
 
 	if opts_.Width != 0 {
 		opt.Width = opts_.Width
@@ -43,5 +52,15 @@ func parseOpts(opts ...*Opt) Opt {
 		opt.Quality = opts_.Quality
 	}
 	opt.HardResize = opts_.HardResize
+	opt.Processor = opts_.Processor
+	if opt.Processor == nil {
+		opt.Processor = tgproc.Exec(&tgproc.ExecOpt{Convert: opt.Convert})
+	}
+	return opt
+}
+
+// bound returns a copy of the options, which processor is stopped with the workspace, see tg.Workspace.Context.
+func (opt Opt) bound(ws *tg.Workspace) Opt {
+	opt.Processor = tgproc.WithContext(ws.Context(), opt.Processor)
 	return opt
 }
@@ -3,66 +3,64 @@ package tgimage
 import (
 	"fmt"
 	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc"
 	"os"
-	"os/exec"
 )
 
 // Convert image to suit Telegram's standards.
+// Uses ImageMagick `convert` by default, pass tgproc.Native() as Opt.Processor to do it in pure Go.
 func Convert(opts ...*Opt) tg.ImageModifier {
 	opt := parseOpts(opts...)
-	resizeArg := ""
+	imageOpt := tgproc.ImageOpt{Width: opt.Width, Height: opt.Height, Mode: tgproc.ResizeShrink, Quality: opt.Quality}
 	if opt.HardResize {
-		resizeArg = fmt.Sprintf("%dx%d!", opt.Height, opt.Width)
-	} else {
-		resizeArg = fmt.Sprintf("%dx%d>", opt.Height, opt.Width)
+		imageOpt.Mode = tgproc.ResizeExact
 	}
-	return func(photo *tg.Photo) (temporaries []string, err error) {
+	return func(photo *tg.Photo, ws *tg.Workspace) (err error) {
+		opt := opt.bound(ws)
 		defer func() {
 			if err != nil {
 				err = fmt.Errorf("tgimage.Convert: %v", err)
 			}
 		}()
 
-		tmp, err := os.CreateTemp(opt.TempDir, "*.jpg")
+		tmp, err := ws.CreateTemp(opt.TempDir, "*.jpg")
 		if err != nil {
-			return nil, err
+			return err
 		}
 		_ = tmp.Close()
 
-		ret := []string{tmp.Name()}
-		output, err := exec.Command(opt.Convert, photo.File.FileLocal, "-resize", resizeArg, tmp.Name()).CombinedOutput()
-		if err != nil {
-			return ret, wrapExecError(err, output)
+		if err := opt.Processor.Image(photo.File.FileLocal, tmp.Name(), imageOpt); err != nil {
+			return err
 		}
 
 		photo.FileLocal = tmp.Name()
-		return ret, nil
+		return nil
 	}
 }
 
 // ConvertIfNeeded image only if Telegram does not support its type.
 func ConvertIfNeeded(opts ...*Opt) tg.ImageModifier {
 	convert := Convert(opts...)
-	return func(photo *tg.Photo) (temporaries []string, err error) {
+	return func(photo *tg.Photo, ws *tg.Workspace) error {
 		filename := photo.FileLocal
 		if !isTypeSupported(filename) {
-			return convert(photo)
+			return convert(photo, ws)
 		}
 		if stat, err := os.Stat(filename); err != nil || stat.Size() > int64(10*(1<<20)) {
-			return convert(photo)
+			return convert(photo, ws)
 		}
-		return nil, nil
+		return nil
 	}
 }
 
 // ConvertIfTooBig tgimage only if it's too big to be a Telegram photo (10MB).
 func ConvertIfTooBig(opts ...*Opt) tg.ImageModifier {
 	convert := Convert(opts...)
-	return func(photo *tg.Photo) (temporaries []string, err error) {
+	return func(photo *tg.Photo, ws *tg.Workspace) error {
 		filename := photo.FileLocal
 		if stat, err := os.Stat(filename); err != nil || stat.Size() > int64(10*(1<<20)) {
-			return convert(photo)
+			return convert(photo, ws)
 		}
-		return nil, nil
+		return nil
 	}
 }
@@ -0,0 +1,91 @@
+package tgmedia
+
+import (
+	"github.com/heilkit/tg/tgproc"
+	"strings"
+)
+
+// Kind is a Telegram media type, a file is sent as.
+type Kind string
+
+const (
+	KindPhoto     Kind = "photo"
+	KindVideo     Kind = "video"
+	KindAnimation Kind = "animation"
+	KindAudio     Kind = "audio"
+	KindVoice     Kind = "voice"
+	KindDocument  Kind = "document"
+)
+
+// Conversion required before uploading the file as Kind.
+type Conversion int
+
+const (
+	ConvertNone Conversion = iota
+	ConvertImage
+	ConvertVideo
+	ConvertVideoByCopy
+	ConvertAudio
+)
+
+// Decision of Detect, Reason is meant for logging.
+type Decision struct {
+	Kind       Kind
+	Conversion Conversion
+	MIME       string
+	Reason     string
+}
+
+// Detect chooses the Telegram media type for a file by its content (magic bytes, then ffprobe if available).
+// If the file could not be read, its extension is used. If convert is false, types that require a conversion
+// are sent as documents.
+func Detect(filename string, convert bool, proc ...tgproc.MediaProcessor) Decision {
+	var processor tgproc.MediaProcessor
+	if len(proc) > 0 {
+		processor = proc[0]
+	}
+
+	sniffed, err := tgproc.SniffWith(processor, filename)
+	if err != nil {
+		sniffed = tgproc.ByExtension(filename)
+		sniffed.Reason = err.Error() + ", fallback to " + sniffed.Reason
+	}
+
+	decision := decide(sniffed)
+	if decision.Conversion != ConvertNone && !convert {
+		decision.Reason += ", conversion is disabled"
+		decision.Kind, decision.Conversion = KindDocument, ConvertNone
+	}
+	return decision
+}
+
+func decide(sniffed tgproc.Type) Decision {
+	decision := func(kind Kind, conversion Conversion) Decision {
+		return Decision{Kind: kind, Conversion: conversion, MIME: sniffed.MIME, Reason: sniffed.Reason}
+	}
+
+	switch {
+	case sniffed.Is("image/jpeg", "image/png"):
+		return decision(KindPhoto, ConvertNone)
+	case sniffed.Is("image/gif"):
+		return decision(KindAnimation, ConvertNone)
+	case sniffed.Is("image/webp", "image/heic", "image/avif", "image/jxl", "image/bmp", "image/tiff"):
+		return decision(KindPhoto, ConvertImage)
+
+	case sniffed.Is("video/mp4", "video/quicktime"):
+		return decision(KindVideo, ConvertNone)
+	case sniffed.Is("video/webm", "video/x-m4v"):
+		return decision(KindVideo, ConvertVideoByCopy)
+	case strings.HasPrefix(sniffed.MIME, "video/"):
+		return decision(KindVideo, ConvertVideo)
+
+	case sniffed.Is("audio/ogg"):
+		return decision(KindVoice, ConvertNone)
+	case sniffed.Is("audio/mpeg", "audio/mp4"):
+		return decision(KindAudio, ConvertNone)
+	case strings.HasPrefix(sniffed.MIME, "audio/"):
+		return decision(KindAudio, ConvertAudio)
+	}
+
+	return decision(KindDocument, ConvertNone)
+}
@@ -2,24 +2,54 @@ package tgmedia
 
 import (
 	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgaudio"
 	"github.com/heilkit/tg/tgimage"
+	"github.com/heilkit/tg/tgproc"
 	"github.com/heilkit/tg/tgvideo"
 	"path/filepath"
 	"strings"
 )
 
-// FromDisk tries to guess telegram file type based of filename.
+// FromDisk tries to guess telegram file type based of its content, see Detect.
 // The function has okay defaults, but I encourage you to handle everything yourself if you want to be sure.
+//
+// Opts could be: tg.ImageModifier, tg.VideoModifier, tg.AudioModifier, tg.VoiceModifier,
+// bool -- whether to convert files, tgproc.MediaProcessor -- used for probing,
+// func(Decision) -- called with the decision, i.e. for logging.
+//
+// Voice notes could not be a part of an album, thus FromDisk returns them as tg.Audio, use SendableFromDisk
+// to get a tg.Voice.
 func FromDisk(filename string, opts ...interface{}) tg.Inputtable {
+	return fromDisk(filename, false, opts...).(tg.Inputtable)
+}
+
+// SendableFromDisk is FromDisk, which returns voice notes as tg.Voice.
+func SendableFromDisk(filename string, opts ...interface{}) tg.Sendable {
+	return fromDisk(filename, true, opts...)
+}
+
+func fromDisk(filename string, allowVoice bool, opts ...interface{}) tg.Sendable {
 	convert := true
 	imageMods := []tg.ImageModifier{}
 	videoMods := []tg.VideoModifier{}
+	audioMods := []tg.AudioModifier{}
+	voiceMods := []tg.VoiceModifier{}
+	var processor tgproc.MediaProcessor
+	var onDecision func(Decision)
 	for _, opt := range opts {
 		switch val := opt.(type) {
 		case tg.ImageModifier:
 			imageMods = append(imageMods, val)
 		case tg.VideoModifier:
 			videoMods = append(videoMods, val)
+		case tg.AudioModifier:
+			audioMods = append(audioMods, val)
+		case tg.VoiceModifier:
+			voiceMods = append(voiceMods, val)
+		case tgproc.MediaProcessor:
+			processor = val
+		case func(Decision):
+			onDecision = val
 		case bool:
 			convert = val
 		}
@@ -31,16 +61,56 @@ func FromDisk(filename string, opts ...interface{}) tg.Inputtable {
 		if len(videoMods) == 0 {
 			videoMods = append(videoMods, tgvideo.ThumbnailAt(0.05))
 		}
+		if len(audioMods) == 0 {
+			audioMods = append(audioMods, tgaudio.EnsureMeta())
+		}
+		if len(voiceMods) == 0 {
+			voiceMods = append(voiceMods, tgaudio.VoiceMeta())
+		}
 	}
-	nativeImage, nativeVideo := []string{".jpg", ".jpeg", ".png"}, []string{".mp4", ".mov", ".mpeg4"}
-	convertImage, convertVideo, convertByCopyVideo := []string{}, []string{}, []string{}
-	if convert {
-		convertImage = append(convertImage, ".webp", ".heic", ".jxl")
-		convertVideo = append(convertVideo, ".avi", ".gif", ".wmv", ".amv", ".qt")
-		convertByCopyVideo = append(convertByCopyVideo, ".webm", ".m4v")
+
+	decision := Detect(filename, convert, processor)
+	if onDecision != nil {
+		onDecision(decision)
+	}
+
+	file, name := tg.FromDisk(filename), filepath.Base(filename)
+	switch decision.Kind {
+	case KindPhoto:
+		photo := tg.Photo{File: file}.With(imageMods...)
+		if decision.Conversion == ConvertImage {
+			photo = photo.With(tgimage.Convert())
+		}
+		return photo
+
+	case KindVideo:
+		video := tg.Video{File: file, FileName: name}.With(videoMods...)
+		switch decision.Conversion {
+		case ConvertVideo:
+			video = video.With(tgvideo.Convert())
+		case ConvertVideoByCopy:
+			video = video.With(tgvideo.ConvertByCopy())
+		}
+		return video
+
+	case KindAnimation:
+		return &tg.Animation{File: file, FileName: name}
+
+	case KindAudio:
+		audio := tg.Audio{File: file, FileName: name}.With(audioMods...)
+		if decision.Conversion == ConvertAudio {
+			audio = audio.With(tgaudio.ToMP3())
+		}
+		return audio
+
+	case KindVoice:
+		if allowVoice {
+			return tg.Voice{File: file, MIME: decision.MIME}.With(voiceMods...)
+		}
+		return tg.Audio{File: file, FileName: name, MIME: decision.MIME}.With(audioMods...)
 	}
 
-	return FromDiskVerbose(filename, imageMods, videoMods, nativeImage, convertImage, nativeVideo, convertVideo, convertByCopyVideo)
+	return &tg.Document{File: file, FileName: name, MIME: decision.MIME}
 }
 
 // FromDiskVerbose uploads media from file.
@@ -0,0 +1,59 @@
+package tgmedia
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc/tgproctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	cases := []struct {
+		name, head string
+		kind       Kind
+		conversion Conversion
+	}{
+		{"photo.png", "\xFF\xD8\xFF\xE0\x00\x10JFIF", KindPhoto, ConvertNone},
+		{"photo.jpg", "RIFF\x00\x00\x00\x00WEBPVP8 ", KindPhoto, ConvertImage},
+		{"animation.gif", "GIF89a\x01\x00", KindAnimation, ConvertNone},
+		{"video.mp4", "\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00", KindVideo, ConvertNone},
+		{"video.webm", "\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x84webm", KindVideo, ConvertVideoByCopy},
+		{"voice.ogg", "OggS\x00\x02" + string(make([]byte, 22)) + "\x01\x13OpusHead", KindVoice, ConvertNone},
+		{"song.flac", "fLaC\x00\x00", KindAudio, ConvertAudio},
+		// the kind is told by ffprobe
+		{"video.flv", "FLV\x01\x05\x00\x00\x00\x09", KindVideo, ConvertVideo},
+	}
+	proc := tgproctest.New("probe.json")
+	for _, c := range cases {
+		decision := Detect(tgproctest.WriteFile(t, c.name, c.head), true, proc)
+		assert.Equal(t, c.kind, decision.Kind, c.name)
+		assert.Equal(t, c.conversion, decision.Conversion, c.name)
+		assert.NotEmpty(t, decision.Reason, c.name)
+	}
+	// only the unknown magic bytes are told by ffprobe
+	require.Len(t, proc.FfprobeCalls(), 1)
+	assert.Contains(t, proc.FfprobeCalls()[0], "format=format_name:stream=codec_type")
+
+	decision := Detect(tgproctest.WriteFile(t, "video.flv", "FLV\x01\x05\x00\x00\x00\x09"), false, proc)
+	assert.Equal(t, KindDocument, decision.Kind)
+	assert.Equal(t, ConvertNone, decision.Conversion)
+
+	// the extension is the fallback
+	decision = Detect(filepath.Join(t.TempDir(), "missing.mp4"), true)
+	assert.Equal(t, KindVideo, decision.Kind)
+}
+
+func TestFromDisk(t *testing.T) {
+	ogg := tgproctest.WriteFile(t, "voice.ogg", "OggS\x00\x02"+string(make([]byte, 22))+"\x01\x13OpusHead")
+	decisions := []Decision{}
+	onDecision := func(d Decision) { decisions = append(decisions, d) }
+
+	assert.IsType(t, &tg.Audio{}, FromDisk(ogg, false, onDecision))
+	assert.IsType(t, &tg.Voice{}, SendableFromDisk(ogg, false, onDecision))
+	assert.IsType(t, &tg.Document{}, FromDisk(tgproctest.WriteFile(t, "data.bin", "\x00\x01\x02\x03"), onDecision))
+	require.Len(t, decisions, 3)
+	assert.Equal(t, "audio/ogg", decisions[0].MIME)
+}
@@ -0,0 +1,109 @@
+package tgproc
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+const (
+	ffmpeg  = "ffmpeg"
+	ffprobe = "ffprobe"
+	convert = "convert"
+)
+
+// ExecOpt are the binaries used by Exec, empty values are defaulted.
+type ExecOpt struct {
+	Ffmpeg  string
+	Ffprobe string
+	Convert string
+}
+
+// Exec processor shells out to `ffmpeg`, `ffprobe` and ImageMagick `convert`.
+func Exec(opts ...*ExecOpt) MediaProcessor {
+	proc := &execProcessor{ffmpeg: ffmpeg, ffprobe: ffprobe, convert: convert}
+	if len(opts) == 0 || opts[0] == nil {
+		return proc
+	}
+	opt := opts[0]
+	if opt.Ffmpeg != "" {
+		proc.ffmpeg = opt.Ffmpeg
+	}
+	if opt.Ffprobe != "" {
+		proc.ffprobe = opt.Ffprobe
+	}
+	if opt.Convert != "" {
+		proc.convert = opt.Convert
+	}
+	return proc
+}
+
+type execProcessor struct {
+	ffmpeg  string
+	ffprobe string
+	convert string
+	ctx     context.Context
+}
+
+var _ ContextProcessor = &execProcessor{}
+
+// WithContext returns the processor, which kills its commands, once ctx is done.
+func (proc *execProcessor) WithContext(ctx context.Context) MediaProcessor {
+	bound := *proc
+	bound.ctx = ctx
+	return &bound
+}
+
+func (proc *execProcessor) command(name string, args ...string) *exec.Cmd {
+	if proc.ctx == nil {
+		return exec.Command(name, args...)
+	}
+	return exec.CommandContext(proc.ctx, name, args...)
+}
+
+// contextError tells, the command is killed, since the context is done.
+func (proc *execProcessor) contextError(err error) error {
+	if err != nil && proc.ctx != nil && proc.ctx.Err() != nil {
+		return fmt.Errorf("%w: %v", proc.ctx.Err(), err)
+	}
+	return err
+}
+
+func (proc *execProcessor) Image(src string, dst string, opt ImageOpt) error {
+	resizeArg := fmt.Sprintf("%dx%d", opt.Width, opt.Height)
+	switch opt.Mode {
+	case ResizeShrink:
+		resizeArg += ">"
+	case ResizeExact:
+		resizeArg += "!"
+	}
+
+	args := []string{src, "-resize", resizeArg}
+	if opt.Quality > 0 {
+		args = append(args, "-quality", fmt.Sprintf("%d", opt.Quality))
+	}
+	args = append(args, dst)
+
+	output, err := proc.command(proc.convert, args...).CombinedOutput()
+	return wrapExecError(proc.contextError(err), output)
+}
+
+func (proc *execProcessor) Ffmpeg(args ...string) ([]byte, error) {
+	output, err := proc.command(proc.ffmpeg, args...).CombinedOutput()
+	return output, wrapExecError(proc.contextError(err), output)
+}
+
+func (proc *execProcessor) Ffprobe(args ...string) ([]byte, error) {
+	output, err := proc.command(proc.ffprobe, args...).Output()
+	if err = proc.contextError(err); err != nil {
+		return output, fmt.Errorf("while executing %s: %v", proc.ffprobe, err)
+	}
+	return output, nil
+}
+
+func wrapExecError(err error, output []byte) error {
+	if err == nil || len(output) == 0 {
+		return err
+	}
+	return fmt.Errorf("err: %s\nout: %s", err.Error(), string(output))
+}
@@ -0,0 +1,176 @@
+package tgproc
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Native processor re-encodes JPEG, PNG and GIF images in pure Go, without ImageMagick installed.
+// Video operations are passed to fallback, if there's one, otherwise ErrUnsupported is returned.
+func Native(fallback ...MediaProcessor) MediaProcessor {
+	proc := &nativeProcessor{}
+	if len(fallback) > 0 {
+		proc.fallback = fallback[0]
+	}
+	return proc
+}
+
+type nativeProcessor struct {
+	fallback MediaProcessor
+	ctx      context.Context
+}
+
+var _ ContextProcessor = &nativeProcessor{}
+
+// WithContext returns the processor, which doesn't start new images, once ctx is done,
+// the fallback is bound to ctx too.
+func (proc *nativeProcessor) WithContext(ctx context.Context) MediaProcessor {
+	return &nativeProcessor{fallback: WithContext(ctx, proc.fallback), ctx: ctx}
+}
+
+func (proc *nativeProcessor) Image(src string, dst string, opt ImageOpt) error {
+	if proc.ctx != nil && proc.ctx.Err() != nil {
+		return proc.ctx.Err()
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	img, _, err := image.Decode(in)
+	if err != nil {
+		return fmt.Errorf("tgproc.Native: %v", err)
+	}
+
+	width, height := fitSize(img.Bounds().Dx(), img.Bounds().Dy(), opt)
+	if width != img.Bounds().Dx() || height != img.Bounds().Dy() {
+		img = resize(img, width, height)
+	}
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	switch strings.ToLower(filepath.Ext(dst)) {
+	case ".jpg", ".jpeg":
+		quality := opt.Quality
+		if quality <= 0 || quality > 100 {
+			quality = jpeg.DefaultQuality
+		}
+		err = jpeg.Encode(out, img, &jpeg.Options{Quality: quality})
+	case ".png":
+		err = png.Encode(out, img)
+	case ".gif":
+		err = gif.Encode(out, img, nil)
+	default:
+		return fmt.Errorf("tgproc.Native: %s output: %w", filepath.Ext(dst), ErrUnsupported)
+	}
+	return err
+}
+
+func (proc *nativeProcessor) Ffmpeg(args ...string) ([]byte, error) {
+	if proc.fallback == nil {
+		return nil, ErrUnsupported
+	}
+	return proc.fallback.Ffmpeg(args...)
+}
+
+func (proc *nativeProcessor) Ffprobe(args ...string) ([]byte, error) {
+	if proc.fallback == nil {
+		return nil, ErrUnsupported
+	}
+	return proc.fallback.Ffprobe(args...)
+}
+
+// fitSize calculates the output dimensions the same way ImageMagick geometry does.
+func fitSize(width, height int, opt ImageOpt) (int, int) {
+	if opt.Width <= 0 || opt.Height <= 0 || width == 0 || height == 0 {
+		return width, height
+	}
+	if opt.Mode == ResizeExact {
+		return opt.Width, opt.Height
+	}
+	if opt.Mode == ResizeShrink && width <= opt.Width && height <= opt.Height {
+		return width, height
+	}
+
+	scale := min(float64(opt.Width)/float64(width), float64(opt.Height)/float64(height))
+	return max(1, int(float64(width)*scale+0.5)), max(1, int(float64(height)*scale+0.5))
+}
+
+// resize does area-averaging when shrinking and bilinear interpolation when enlarging.
+func resize(src image.Image, width, height int) image.Image {
+	rgba := image.NewRGBA(image.Rect(0, 0, src.Bounds().Dx(), src.Bounds().Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, src.Bounds().Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	srcW, srcH := rgba.Bounds().Dx(), rgba.Bounds().Dy()
+	scaleX, scaleY := float64(srcW)/float64(width), float64(srcH)/float64(height)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var px [4]float64
+			if scaleX > 1 || scaleY > 1 {
+				px = areaSample(rgba, float64(x)*scaleX, float64(y)*scaleY, max(scaleX, 1), max(scaleY, 1))
+			} else {
+				px = bilinearSample(rgba, (float64(x)+0.5)*scaleX-0.5, (float64(y)+0.5)*scaleY-0.5)
+			}
+			offset := dst.PixOffset(x, y)
+			for i := 0; i < 4; i++ {
+				dst.Pix[offset+i] = uint8(min(max(px[i]+0.5, 0), 255))
+			}
+		}
+	}
+	return dst
+}
+
+func areaSample(img *image.RGBA, x0, y0, w, h float64) [4]float64 {
+	var sum [4]float64
+	weight := 0.0
+	bounds := img.Bounds()
+	for y := int(y0); float64(y) < y0+h && y < bounds.Dy(); y++ {
+		wy := min(float64(y+1), y0+h) - max(float64(y), y0)
+		for x := int(x0); float64(x) < x0+w && x < bounds.Dx(); x++ {
+			wx := min(float64(x+1), x0+w) - max(float64(x), x0)
+			offset := img.PixOffset(x, y)
+			for i := 0; i < 4; i++ {
+				sum[i] += float64(img.Pix[offset+i]) * wx * wy
+			}
+			weight += wx * wy
+		}
+	}
+	if weight > 0 {
+		for i := range sum {
+			sum[i] /= weight
+		}
+	}
+	return sum
+}
+
+func bilinearSample(img *image.RGBA, x, y float64) [4]float64 {
+	maxX, maxY := img.Bounds().Dx()-1, img.Bounds().Dy()-1
+	x, y = min(max(x, 0), float64(maxX)), min(max(y, 0), float64(maxY))
+	x0, y0 := int(x), int(y)
+	x1, y1 := min(x0+1, maxX), min(y0+1, maxY)
+	fx, fy := x-float64(x0), y-float64(y0)
+
+	var ret [4]float64
+	for i := 0; i < 4; i++ {
+		top := float64(img.Pix[img.PixOffset(x0, y0)+i])*(1-fx) + float64(img.Pix[img.PixOffset(x1, y0)+i])*fx
+		bottom := float64(img.Pix[img.PixOffset(x0, y1)+i])*(1-fx) + float64(img.Pix[img.PixOffset(x1, y1)+i])*fx
+		ret[i] = top*(1-fy) + bottom*fy
+	}
+	return ret
+}
@@ -0,0 +1,64 @@
+package tgproc
+
+import (
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFitSize(t *testing.T) {
+	w, h := fitSize(1000, 500, ImageOpt{Width: 320, Height: 320, Mode: ResizeShrink})
+	assert.Equal(t, 320, w)
+	assert.Equal(t, 160, h)
+
+	w, h = fitSize(100, 50, ImageOpt{Width: 320, Height: 320, Mode: ResizeShrink})
+	assert.Equal(t, 100, w)
+	assert.Equal(t, 50, h)
+
+	w, h = fitSize(100, 50, ImageOpt{Width: 512, Height: 512, Mode: ResizeFit})
+	assert.Equal(t, 512, w)
+	assert.Equal(t, 256, h)
+
+	w, h = fitSize(100, 50, ImageOpt{Width: 30, Height: 40, Mode: ResizeExact})
+	assert.Equal(t, 30, w)
+	assert.Equal(t, 40, h)
+}
+
+func TestNativeImage(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.png")
+
+	img := image.NewRGBA(image.Rect(0, 0, 200, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 200; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	f, err := os.Create(src)
+	require.NoError(t, err)
+	require.NoError(t, png.Encode(f, img))
+	require.NoError(t, f.Close())
+
+	proc := Native()
+	dst := filepath.Join(dir, "dst.jpg")
+	require.NoError(t, proc.Image(src, dst, ImageOpt{Width: 50, Height: 50, Quality: 90}))
+
+	f, err = os.Open(dst)
+	require.NoError(t, err)
+	defer f.Close()
+	out, err := jpeg.Decode(f)
+	require.NoError(t, err)
+	assert.Equal(t, 50, out.Bounds().Dx())
+	assert.Equal(t, 25, out.Bounds().Dy())
+
+	assert.ErrorIs(t, proc.Image(src, filepath.Join(dir, "dst.heic"), ImageOpt{}), ErrUnsupported)
+	_, err = proc.Ffmpeg("-version")
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
@@ -0,0 +1,95 @@
+// Package tgproc provides media processing backends used by tgimage, tgvideo
+// and other modifier packages.
+package tgproc
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnsupported is returned by a MediaProcessor, which is not capable of the requested operation.
+var ErrUnsupported = errors.New("tgproc: operation is not supported by the processor")
+
+// ResizeMode defines how an image is fit into ImageOpt.Width x ImageOpt.Height box.
+type ResizeMode int
+
+const (
+	// ResizeShrink only makes images smaller, preserving the aspect ratio.
+	ResizeShrink ResizeMode = iota
+
+	// ResizeFit scales images up or down, so the bigger side matches the box, preserving the aspect ratio.
+	ResizeFit
+
+	// ResizeExact forces the exact dimensions, ignoring the aspect ratio.
+	ResizeExact
+)
+
+// ImageOpt describes the image re-encoding. Output format is chosen by the dst extension.
+type ImageOpt struct {
+	Width   int
+	Height  int
+	Mode    ResizeMode
+	Quality int
+}
+
+// MediaProcessor is a backend, which does the heavy lifting for media modifiers.
+type MediaProcessor interface {
+	// Image resizes and re-encodes an image from src to dst.
+	Image(src string, dst string, opt ImageOpt) error
+
+	// Ffmpeg runs a transcoding with ffmpeg-compatible arguments, returning combined output.
+	Ffmpeg(args ...string) ([]byte, error)
+
+	// Ffprobe runs a probing with ffprobe-compatible arguments, returning stdout.
+	Ffprobe(args ...string) ([]byte, error)
+}
+
+// ContextProcessor is a MediaProcessor, which stops its commands, once the context is done.
+type ContextProcessor interface {
+	MediaProcessor
+
+	// WithContext returns the processor, which commands are bound to ctx.
+	WithContext(ctx context.Context) MediaProcessor
+}
+
+// WithContext binds the commands of the processor to ctx, i.e. the one of tg.Workspace.
+// The commands of a ContextProcessor are stopped, once ctx is done, the other processors
+// only don't start new ones.
+func WithContext(ctx context.Context, proc MediaProcessor) MediaProcessor {
+	switch proc := proc.(type) {
+	case nil:
+		return nil
+	case ContextProcessor:
+		return proc.WithContext(ctx)
+	default:
+		return &contextProcessor{ctx: ctx, proc: proc}
+	}
+}
+
+type contextProcessor struct {
+	ctx  context.Context
+	proc MediaProcessor
+}
+
+var _ MediaProcessor = &contextProcessor{}
+
+func (proc *contextProcessor) Image(src string, dst string, opt ImageOpt) error {
+	if err := proc.ctx.Err(); err != nil {
+		return err
+	}
+	return proc.proc.Image(src, dst, opt)
+}
+
+func (proc *contextProcessor) Ffmpeg(args ...string) ([]byte, error) {
+	if err := proc.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return proc.proc.Ffmpeg(args...)
+}
+
+func (proc *contextProcessor) Ffprobe(args ...string) ([]byte, error) {
+	if err := proc.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return proc.proc.Ffprobe(args...)
+}
@@ -0,0 +1,68 @@
+package tgproc
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingProcessor struct {
+	calls int
+}
+
+func (proc *countingProcessor) Image(src string, dst string, opt ImageOpt) error {
+	proc.calls++
+	return nil
+}
+
+func (proc *countingProcessor) Ffmpeg(args ...string) ([]byte, error) {
+	proc.calls++
+	return nil, nil
+}
+
+func (proc *countingProcessor) Ffprobe(args ...string) ([]byte, error) {
+	proc.calls++
+	return nil, nil
+}
+
+func TestWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	counting := &countingProcessor{}
+	proc := WithContext(ctx, counting)
+	_, err := proc.Ffprobe("-version")
+	require.NoError(t, err)
+
+	// the commands in progress could not be stopped, the new ones aren't started
+	cancel()
+	_, err = proc.Ffmpeg("-version")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, proc.Image("src.png", "dst.png", ImageOpt{}), context.Canceled)
+	assert.Equal(t, 1, counting.calls)
+
+	_, err = WithContext(ctx, Native(counting)).Ffprobe("-version")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, WithContext(ctx, Native()).Image("src.png", "dst.png", ImageOpt{}), context.Canceled)
+	assert.Nil(t, WithContext(ctx, nil))
+}
+
+func TestExecWithContext(t *testing.T) {
+	sleep, err := exec.LookPath("sleep")
+	if err != nil {
+		t.Skip("no sleep on the system")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = WithContext(ctx, Exec(&ExecOpt{Ffmpeg: sleep})).Ffmpeg("10")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// the processor itself is not bound
+	_, err = Exec(&ExecOpt{Ffmpeg: sleep}).Ffmpeg("0")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,236 @@
+package tgproc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sniffLen is enough for all the signatures below, including Ogg codec headers.
+const sniffLen = 512
+
+// Type is the result of a content sniffing.
+type Type struct {
+	MIME   string // i.e. "video/mp4", "application/octet-stream" if unknown
+	Ext    string // canonical extension, i.e. ".mp4", empty if unknown
+	Reason string // human-readable explanation of the decision, useful for logging
+}
+
+// Is reports whether the type has one of the given MIME types.
+func (t Type) Is(mimes ...string) bool {
+	for _, mime := range mimes {
+		if t.MIME == mime {
+			return true
+		}
+	}
+	return false
+}
+
+// Known reports whether the type was detected.
+func (t Type) Known() bool {
+	return t.MIME != "" && t.MIME != octetStream
+}
+
+const octetStream = "application/octet-stream"
+
+// Sniff detects the file type by its magic bytes, file extension is not used.
+func Sniff(filename string) (Type, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return Type{}, err
+	}
+	defer file.Close()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return Type{}, err
+	}
+	return SniffBytes(head[:n]), nil
+}
+
+// SniffWith detects the file type by its magic bytes, asking ffprobe of the processor if they are unknown.
+func SniffWith(proc MediaProcessor, filename string) (Type, error) {
+	t, err := Sniff(filename)
+	if err != nil || t.Known() || proc == nil {
+		return t, err
+	}
+
+	output, err := proc.Ffprobe("-v", "error", "-show_entries", "format=format_name:stream=codec_type",
+		"-of", "json", filename)
+	if err != nil {
+		return t, nil
+	}
+	var probe struct {
+		Streams []struct {
+			CodecType string `json:"codec_type"`
+		} `json:"streams"`
+		Format struct {
+			FormatName string `json:"format_name"`
+		} `json:"format"`
+	}
+	if json.Unmarshal(output, &probe) != nil {
+		return t, nil
+	}
+
+	video, audio := false, false
+	for _, stream := range probe.Streams {
+		video = video || stream.CodecType == "video"
+		audio = audio || stream.CodecType == "audio"
+	}
+	reason := fmt.Sprintf("ffprobe format %q", probe.Format.FormatName)
+	switch {
+	case video:
+		return Type{MIME: "video/x-unknown", Reason: reason + " with a video stream"}, nil
+	case audio:
+		return Type{MIME: "audio/x-unknown", Reason: reason + " with an audio stream"}, nil
+	}
+	return t, nil
+}
+
+// SniffBytes detects the type by the leading bytes of a file.
+func SniffBytes(head []byte) Type {
+	for _, sig := range signatures {
+		if t, ok := sig(head); ok {
+			return t
+		}
+	}
+
+	mime := http.DetectContentType(head)
+	if index := strings.IndexByte(mime, ';'); index >= 0 {
+		mime = mime[:index]
+	}
+	if mime == octetStream {
+		return Type{MIME: octetStream, Reason: "unknown signature"}
+	}
+	return Type{MIME: mime, Reason: "http.DetectContentType"}
+}
+
+// ByExtension guesses the type by the filename, it's the fallback for files, which could not be read.
+func ByExtension(filename string) Type {
+	ext := strings.ToLower(filepath.Ext(filename))
+	if mime, ok := extensions[ext]; ok {
+		return Type{MIME: mime, Ext: ext, Reason: "extension " + ext}
+	}
+	return Type{MIME: octetStream, Reason: "unknown extension"}
+}
+
+var extensions = map[string]string{
+	".jpg": "image/jpeg", ".jpeg": "image/jpeg", ".png": "image/png", ".gif": "image/gif",
+	".webp": "image/webp", ".heic": "image/heic", ".jxl": "image/jxl", ".avif": "image/avif",
+	".mp4": "video/mp4", ".mpeg4": "video/mp4", ".mov": "video/quicktime", ".qt": "video/quicktime",
+	".m4v": "video/x-m4v", ".webm": "video/webm", ".mkv": "video/x-matroska", ".avi": "video/x-msvideo",
+	".wmv": "video/x-ms-wmv", ".amv": "video/x-amv",
+	".mp3": "audio/mpeg", ".m4a": "audio/mp4", ".ogg": "audio/ogg", ".oga": "audio/ogg", ".opus": "audio/ogg",
+	".flac": "audio/flac", ".wav": "audio/wav",
+}
+
+type signature func(head []byte) (Type, bool)
+
+func prefix(magic string, mime, ext string) signature {
+	return func(head []byte) (Type, bool) {
+		if bytes.HasPrefix(head, []byte(magic)) {
+			return Type{MIME: mime, Ext: ext, Reason: fmt.Sprintf("%s signature", ext)}, true
+		}
+		return Type{}, false
+	}
+}
+
+var signatures = []signature{
+	prefix("\xFF\xD8\xFF", "image/jpeg", ".jpg"),
+	prefix("\x89PNG\r\n\x1A\n", "image/png", ".png"),
+	prefix("GIF87a", "image/gif", ".gif"),
+	prefix("GIF89a", "image/gif", ".gif"),
+	prefix("\xFF\x0A", "image/jxl", ".jxl"),
+	prefix("\x00\x00\x00\x0CJXL \r\n\x87\n", "image/jxl", ".jxl"),
+	prefix("fLaC", "audio/flac", ".flac"),
+	prefix("ID3", "audio/mpeg", ".mp3"),
+	prefix("%PDF-", "application/pdf", ".pdf"),
+	prefix("\x1F\x8B", "application/gzip", ".gz"),
+	riff,
+	isoBMFF,
+	ebml,
+	ogg,
+	mpegAudio,
+}
+
+func riff(head []byte) (Type, bool) {
+	if len(head) < 12 || !bytes.HasPrefix(head, []byte("RIFF")) {
+		return Type{}, false
+	}
+	switch string(head[8:12]) {
+	case "WEBP":
+		return Type{MIME: "image/webp", Ext: ".webp", Reason: "RIFF WEBP signature"}, true
+	case "AVI ":
+		return Type{MIME: "video/x-msvideo", Ext: ".avi", Reason: "RIFF AVI signature"}, true
+	case "WAVE":
+		return Type{MIME: "audio/wav", Ext: ".wav", Reason: "RIFF WAVE signature"}, true
+	}
+	return Type{}, false
+}
+
+// isoBMFF handles MP4/MOV/M4A/HEIC family, which is distinguished by the major brand of "ftyp" box.
+func isoBMFF(head []byte) (Type, bool) {
+	if len(head) < 12 || string(head[4:8]) != "ftyp" {
+		return Type{}, false
+	}
+	brand := string(head[8:12])
+	reason := fmt.Sprintf("ftyp brand %q", brand)
+	switch brand {
+	case "heic", "heix", "hevc", "hevx", "mif1", "msf1":
+		return Type{MIME: "image/heic", Ext: ".heic", Reason: reason}, true
+	case "avif", "avis":
+		return Type{MIME: "image/avif", Ext: ".avif", Reason: reason}, true
+	case "qt  ":
+		return Type{MIME: "video/quicktime", Ext: ".mov", Reason: reason}, true
+	case "M4A ", "M4B ", "M4P ":
+		return Type{MIME: "audio/mp4", Ext: ".m4a", Reason: reason}, true
+	case "M4V ", "M4VH", "M4VP":
+		return Type{MIME: "video/x-m4v", Ext: ".m4v", Reason: reason}, true
+	}
+	if strings.HasPrefix(brand, "3g") {
+		return Type{MIME: "video/3gpp", Ext: ".3gp", Reason: reason}, true
+	}
+	return Type{MIME: "video/mp4", Ext: ".mp4", Reason: reason}, true
+}
+
+func ebml(head []byte) (Type, bool) {
+	if !bytes.HasPrefix(head, []byte("\x1A\x45\xDF\xA3")) {
+		return Type{}, false
+	}
+	if bytes.Contains(head[:min(len(head), 64)], []byte("webm")) {
+		return Type{MIME: "video/webm", Ext: ".webm", Reason: "EBML webm doctype"}, true
+	}
+	return Type{MIME: "video/x-matroska", Ext: ".mkv", Reason: "EBML matroska doctype"}, true
+}
+
+func ogg(head []byte) (Type, bool) {
+	if !bytes.HasPrefix(head, []byte("OggS")) {
+		return Type{}, false
+	}
+	switch {
+	case bytes.Contains(head, []byte("OpusHead")):
+		return Type{MIME: "audio/ogg", Ext: ".ogg", Reason: "Ogg Opus codec header"}, true
+	case bytes.Contains(head, []byte("\x80theora")):
+		return Type{MIME: "video/ogg", Ext: ".ogv", Reason: "Ogg Theora codec header"}, true
+	case bytes.Contains(head, []byte("\x01vorbis")):
+		return Type{MIME: "audio/x-vorbis+ogg", Ext: ".ogg", Reason: "Ogg Vorbis codec header"}, true
+	}
+	return Type{MIME: "application/ogg", Ext: ".ogg", Reason: "Ogg signature"}, true
+}
+
+// mpegAudio detects MP3 and ADTS AAC by the frame sync, the JPEG signature is checked beforehand.
+func mpegAudio(head []byte) (Type, bool) {
+	if len(head) < 2 || head[0] != 0xFF || head[1]&0xE0 != 0xE0 {
+		return Type{}, false
+	}
+	if head[1]&0x06 == 0 { // layer bits are zero for ADTS
+		return Type{MIME: "audio/aac", Ext: ".aac", Reason: "ADTS frame sync"}, true
+	}
+	return Type{MIME: "audio/mpeg", Ext: ".mp3", Reason: "MPEG audio frame sync"}, true
+}
@@ -0,0 +1,46 @@
+package tgproc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSniffBytes(t *testing.T) {
+	cases := map[string]string{
+		"\xFF\xD8\xFF\xE0\x00\x10JFIF":                                 "image/jpeg",
+		"\x89PNG\r\n\x1A\n\x00\x00":                                    "image/png",
+		"GIF89a\x01\x00":                                               "image/gif",
+		"RIFF\x00\x00\x00\x00WEBPVP8 ":                                 "image/webp",
+		"RIFF\x00\x00\x00\x00AVI LIST":                                 "video/x-msvideo",
+		"\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00":                     "video/mp4",
+		"\x00\x00\x00\x14ftypqt  \x00\x00\x00\x00":                     "video/quicktime",
+		"\x00\x00\x00\x18ftypheic\x00\x00\x00\x00":                     "image/heic",
+		"\x00\x00\x00\x18ftypM4A \x00\x00\x00\x00":                     "audio/mp4",
+		"\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x84webm":         "video/webm",
+		"OggS\x00\x02" + string(make([]byte, 22)) + "\x01\x13OpusHead": "audio/ogg",
+		"ID3\x03\x00":      "audio/mpeg",
+		"\xFF\xFB\x90\x00": "audio/mpeg",
+		"\xFF\xF1\x50\x80": "audio/aac",
+		"fLaC\x00\x00":     "audio/flac",
+		"\x00\x01\x02\x03": "application/octet-stream",
+	}
+	for head, mime := range cases {
+		assert.Equal(t, mime, SniffBytes([]byte(head)).MIME, "%q", head)
+	}
+}
+
+func TestSniffIgnoresExtension(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "video.jpeg")
+	require.NoError(t, os.WriteFile(filename, []byte("\x00\x00\x00\x18ftypisom\x00\x00\x02\x00"), 0o644))
+
+	sniffed, err := Sniff(filename)
+	require.NoError(t, err)
+	assert.Equal(t, "video/mp4", sniffed.MIME)
+	assert.NotEmpty(t, sniffed.Reason)
+
+	assert.Equal(t, "image/jpeg", ByExtension(filename).MIME)
+}
@@ -0,0 +1,127 @@
+// Package tgproctest provides a fake tgproc.MediaProcessor, which records the commands it's given.
+package tgproctest
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/heilkit/tg/tgproc"
+)
+
+// ImageCall is a recorded Processor.Image call.
+type ImageCall struct {
+	Src string
+	Dst string
+	Opt tgproc.ImageOpt
+}
+
+// Processor is a fake tgproc.MediaProcessor. It records the commands, and by default
+// Image is unsupported, Ffmpeg does nothing and Ffprobe returns the Probe fixture.
+// The On* functions replace the default behaviour, i.e. to write the outputs of the commands.
+type Processor struct {
+	// Probe is the fixture in testdata of the tested package, which Ffprobe returns.
+	Probe string
+
+	OnImage   func(src string, dst string, opt tgproc.ImageOpt) error
+	OnFfmpeg  func(args ...string) ([]byte, error)
+	OnFfprobe func(args ...string) ([]byte, error)
+
+	mu      sync.Mutex
+	images  []ImageCall
+	ffmpeg  [][]string
+	ffprobe [][]string
+}
+
+var _ tgproc.MediaProcessor = &Processor{}
+
+// New returns a fake processor, which probes the files with the testdata/probe fixture.
+func New(probe string) *Processor {
+	return &Processor{Probe: probe}
+}
+
+func (p *Processor) Image(src string, dst string, opt tgproc.ImageOpt) error {
+	p.mu.Lock()
+	p.images = append(p.images, ImageCall{Src: src, Dst: dst, Opt: opt})
+	p.mu.Unlock()
+
+	if p.OnImage == nil {
+		return tgproc.ErrUnsupported
+	}
+	return p.OnImage(src, dst, opt)
+}
+
+func (p *Processor) Ffmpeg(args ...string) ([]byte, error) {
+	p.mu.Lock()
+	p.ffmpeg = append(p.ffmpeg, args)
+	p.mu.Unlock()
+
+	if p.OnFfmpeg == nil {
+		return nil, nil
+	}
+	return p.OnFfmpeg(args...)
+}
+
+func (p *Processor) Ffprobe(args ...string) ([]byte, error) {
+	p.mu.Lock()
+	p.ffprobe = append(p.ffprobe, args)
+	p.mu.Unlock()
+
+	if p.OnFfprobe == nil {
+		return os.ReadFile(filepath.Join("testdata", p.Probe))
+	}
+	return p.OnFfprobe(args...)
+}
+
+// ImageCalls returns the recorded Image calls.
+func (p *Processor) ImageCalls() []ImageCall {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]ImageCall(nil), p.images...)
+}
+
+// FfmpegCalls returns the arguments of the recorded Ffmpeg calls.
+func (p *Processor) FfmpegCalls() [][]string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([][]string(nil), p.ffmpeg...)
+}
+
+// FfprobeCalls returns the arguments of the recorded Ffprobe calls.
+func (p *Processor) FfprobeCalls() [][]string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([][]string(nil), p.ffprobe...)
+}
+
+// Output returns the output file of ffmpeg arguments, i.e. the last one.
+func Output(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[len(args)-1]
+}
+
+// WriteFile writes a file with the head, i.e. a magic number, into a temporary directory of the test.
+func WriteFile(t testing.TB, name string, head string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(filename, []byte(head), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+// WriteSize makes the file of the given size, i.e. the output of a command.
+func WriteSize(filename string, size int64) error {
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := file.Truncate(size); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,35 @@
+package tgproctest
+
+import (
+	"os"
+	"testing"
+
+	"github.com/heilkit/tg/tgproc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessor(t *testing.T) {
+	proc := New("missing.json")
+	assert.ErrorIs(t, proc.Image("src.png", "dst.png", tgproc.ImageOpt{Width: 512}), tgproc.ErrUnsupported)
+	_, err := proc.Ffmpeg("-i", "src.mp4", "dst.mp4")
+	require.NoError(t, err)
+	_, err = proc.Ffprobe("src.mp4")
+	assert.Error(t, err)
+
+	assert.Equal(t, []ImageCall{{Src: "src.png", Dst: "dst.png", Opt: tgproc.ImageOpt{Width: 512}}}, proc.ImageCalls())
+	assert.Equal(t, [][]string{{"-i", "src.mp4", "dst.mp4"}}, proc.FfmpegCalls())
+	assert.Equal(t, [][]string{{"src.mp4"}}, proc.FfprobeCalls())
+	assert.Equal(t, "dst.mp4", Output(proc.FfmpegCalls()[0]))
+
+	filename := WriteFile(t, "dst.mp4", "head")
+	proc.OnFfmpeg = func(args ...string) ([]byte, error) {
+		return []byte("done"), WriteSize(Output(args), 1024)
+	}
+	output, err := proc.Ffmpeg("-i", "src.mp4", filename)
+	require.NoError(t, err)
+	assert.Equal(t, "done", string(output))
+	stat, err := os.Stat(filename)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1024, stat.Size())
+}
@@ -0,0 +1,173 @@
+package tgsticker
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc"
+	"os"
+	"strconv"
+)
+
+// staticAttempts of Static, the lossy WEBP ones are tried, once the PNG is too big.
+var staticAttempts = []struct {
+	ext     string
+	quality int
+}{{".png", 0}, {".webp", 90}, {".webp", 75}, {".webp", 60}}
+
+// Static converts StickerSet.PNG image of any supported format into a 512px PNG.
+// If the PNG is bigger than MaxStaticSize, it's encoded into WEBP with a decreasing quality, until it fits.
+// REQUIRES `convert` on the system, could be passed via Opt.Convert, or use tgproc.Native() as Opt.Processor.
+func Static(opts ...*Opt) tg.StickerModifier {
+	options := parseOpts(opts...)
+
+	return func(set *tg.StickerSet, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgsticker.Static: %v", err)
+			}
+		}()
+
+		if set == nil || set.PNG == nil || set.PNG.FileLocal == "" {
+			return nil
+		}
+
+		size := int64(0)
+		for i, attempt := range staticAttempts {
+			tmpFile, err := ws.CreateTemp(options.TempDir, "*_sticker"+attempt.ext)
+			if err != nil {
+				return err
+			}
+			_ = tmpFile.Close()
+
+			err = options.Processor.Image(set.PNG.FileLocal, tmpFile.Name(), tgproc.ImageOpt{
+				Width:   Side,
+				Height:  Side,
+				Mode:    tgproc.ResizeFit,
+				Quality: attempt.quality,
+			})
+			if i > 0 && errors.Is(err, tgproc.ErrUnsupported) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			stat, err := os.Stat(tmpFile.Name())
+			if err != nil {
+				return err
+			}
+			size = stat.Size()
+			if size <= MaxStaticSize {
+				file := tg.FromDisk(tmpFile.Name())
+				set.PNG = &file
+				return nil
+			}
+		}
+
+		return fmt.Errorf("sticker is %d bytes, must be up to %d", size, MaxStaticSize)
+	}
+}
+
+// Video converts StickerSet.WebM of any video format (GIF, MP4...) into a VP9 WEBM video sticker:
+// 512px, up to 3 seconds, up to 30 fps, no audio, up to 256KB. The bitrate is lowered until the file fits.
+// REQUIRES `ffmpeg`, `ffprobe` on the system, could be passed via Opt.
+func Video(opts ...*Opt) tg.StickerModifier {
+	options := parseOpts(opts...)
+	const attempts = 4
+
+	return func(set *tg.StickerSet, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgsticker.Video: %v", err)
+			}
+		}()
+
+		if set == nil || set.WebM == nil || set.WebM.FileLocal == "" {
+			return nil
+		}
+
+		duration := float64(MaxDuration)
+		if probed, err := probeDuration(options.Processor, set.WebM.FileLocal); err == nil && probed > 0 {
+			duration = min(probed, duration)
+		}
+		bitrate := int64(float64(MaxVideoSize*8) * 0.9 / duration)
+
+		size := int64(0)
+		for attempt := 0; attempt < attempts; attempt++ {
+			tmpFile, err := ws.CreateTemp(options.TempDir, "*_sticker.webm")
+			if err != nil {
+				return err
+			}
+			_ = tmpFile.Close()
+
+			_, err = options.Processor.Ffmpeg("-y", "-i", set.WebM.FileLocal,
+				"-t", strconv.Itoa(MaxDuration),
+				"-an",
+				"-vf", fmt.Sprintf("fps=%d,scale=if(gte(iw\\,ih)\\,%d\\,-2):if(gte(iw\\,ih)\\,-2\\,%d)", MaxFrameRate, Side, Side),
+				"-c:v", "libvpx-vp9",
+				"-pix_fmt", "yuva420p",
+				"-b:v", strconv.FormatInt(bitrate, 10),
+				"-maxrate", strconv.FormatInt(bitrate, 10),
+				"-bufsize", strconv.FormatInt(bitrate, 10),
+				"-f", "webm",
+				tmpFile.Name())
+			if err != nil {
+				return err
+			}
+
+			stat, err := os.Stat(tmpFile.Name())
+			if err != nil {
+				return err
+			}
+			size = stat.Size()
+			if size <= MaxVideoSize {
+				file := tg.FromDisk(tmpFile.Name())
+				set.WebM = &file
+				set.Video = true
+				return nil
+			}
+			bitrate = int64(float64(bitrate) * float64(MaxVideoSize) / float64(size) * 0.9)
+		}
+
+		return fmt.Errorf("could not fit into %d bytes, the last attempt is %d bytes", MaxVideoSize, size)
+	}
+}
+
+// TGS validates StickerSet.TGS animated sticker, see ValidateTGS.
+func TGS() tg.StickerModifier {
+	return func(set *tg.StickerSet, ws *tg.Workspace) (err error) {
+		if set == nil || set.TGS == nil || set.TGS.FileLocal == "" {
+			return nil
+		}
+		if err := ValidateTGS(set.TGS.FileLocal); err != nil {
+			return fmt.Errorf("tgsticker.TGS: %v", err)
+		}
+		set.Animated = true
+		return nil
+	}
+}
+
+// Auto applies Static, Video and TGS to the corresponding files.
+func Auto(opts ...*Opt) tg.StickerModifier {
+	mods := []tg.StickerModifier{Static(opts...), Video(opts...), TGS()}
+	return func(set *tg.StickerSet, ws *tg.Workspace) (err error) {
+		for _, mod := range mods {
+			if err := mod(set, ws); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func probeDuration(proc tgproc.MediaProcessor, filename string) (float64, error) {
+	output, err := proc.Ffprobe("-v", "error", "-show_entries", "format=duration", "-of", "csv=p=0", filename)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(string(bytes.TrimSpace(output)), 64)
+}
@@ -0,0 +1,162 @@
+package tgsticker
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc"
+	"github.com/heilkit/tg/tgproc/tgproctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// imageTo makes the Image calls write the outputs of the sizes, one per call.
+func imageTo(proc *tgproctest.Processor, sizes ...int64) {
+	proc.OnImage = func(src string, dst string, opt tgproc.ImageOpt) error {
+		size := sizes[0]
+		sizes = sizes[1:]
+		return tgproctest.WriteSize(dst, size)
+	}
+}
+
+func TestStatic(t *testing.T) {
+	ws := tg.NewWorkspace(0)
+	defer ws.Cleanup()
+	newSet := func(t *testing.T) *tg.StickerSet {
+		file := tg.FromDisk(tgproctest.WriteFile(t, "sticker.jpg", "\xFF\xD8\xFF\xE0\x00\x10JFIF"))
+		return &tg.StickerSet{PNG: &file}
+	}
+
+	t.Run("PNG", func(t *testing.T) {
+		proc := tgproctest.New("")
+		imageTo(proc, MaxStaticSize)
+		set := newSet(t)
+		require.NoError(t, Static(&Opt{Processor: proc, TempDir: t.TempDir()})(set, ws))
+
+		calls := proc.ImageCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, tgproc.ImageOpt{Width: Side, Height: Side, Mode: tgproc.ResizeFit}, calls[0].Opt)
+		assert.Equal(t, ".png", filepath.Ext(calls[0].Dst))
+		assert.Equal(t, calls[0].Dst, set.PNG.FileLocal)
+	})
+
+	t.Run("WEBP", func(t *testing.T) {
+		proc := tgproctest.New("")
+		imageTo(proc, 2*MaxStaticSize, MaxStaticSize+1, MaxStaticSize)
+		set := newSet(t)
+		require.NoError(t, Static(&Opt{Processor: proc, TempDir: t.TempDir()})(set, ws))
+
+		// the PNG is too big, so the quality of WEBP is lowered until it fits
+		calls := proc.ImageCalls()
+		require.Len(t, calls, 3)
+		assert.Equal(t, ".webp", filepath.Ext(calls[1].Dst))
+		assert.Equal(t, 90, calls[1].Opt.Quality)
+		assert.Equal(t, 75, calls[2].Opt.Quality)
+		assert.Equal(t, calls[2].Dst, set.PNG.FileLocal)
+	})
+
+	t.Run("TooBig", func(t *testing.T) {
+		proc := tgproctest.New("")
+		imageTo(proc, 2*MaxStaticSize, 2*MaxStaticSize, 2*MaxStaticSize, 2*MaxStaticSize)
+		set := newSet(t)
+		filename := set.PNG.FileLocal
+		err := Static(&Opt{Processor: proc, TempDir: t.TempDir()})(set, ws)
+		assert.ErrorContains(t, err, "tgsticker.Static: sticker is")
+		assert.Len(t, proc.ImageCalls(), 4)
+		assert.Equal(t, filename, set.PNG.FileLocal)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		// the processor can't encode WEBP, so the PNG is the only attempt
+		proc := tgproctest.New("")
+		proc.OnImage = func(src string, dst string, opt tgproc.ImageOpt) error {
+			if filepath.Ext(dst) != ".png" {
+				return tgproc.ErrUnsupported
+			}
+			return tgproctest.WriteSize(dst, 2*MaxStaticSize)
+		}
+		err := Static(&Opt{Processor: proc, TempDir: t.TempDir()})(newSet(t), ws)
+		assert.ErrorContains(t, err, "sticker is "+strconv.Itoa(2*MaxStaticSize)+" bytes")
+		assert.Len(t, proc.ImageCalls(), 2)
+
+		err = Static(&Opt{Processor: tgproctest.New(""), TempDir: t.TempDir()})(newSet(t), ws)
+		assert.ErrorContains(t, err, tgproc.ErrUnsupported.Error())
+	})
+}
+
+func TestVideo(t *testing.T) {
+	ws := tg.NewWorkspace(0)
+	defer ws.Cleanup()
+	newSet := func(t *testing.T) *tg.StickerSet {
+		file := tg.FromDisk(tgproctest.WriteFile(t, "sticker.gif", "GIF89a\x01\x00"))
+		return &tg.StickerSet{WebM: &file}
+	}
+	// encodeTo makes the ffmpeg calls write the outputs of the sizes, one per call.
+	encodeTo := func(proc *tgproctest.Processor, sizes ...int64) {
+		proc.OnFfprobe = func(args ...string) ([]byte, error) {
+			return []byte("2.500000\n"), nil
+		}
+		proc.OnFfmpeg = func(args ...string) ([]byte, error) {
+			size := sizes[0]
+			sizes = sizes[1:]
+			return nil, tgproctest.WriteSize(tgproctest.Output(args), size)
+		}
+	}
+	argAfter := func(args []string, flag string) string {
+		for i := 0; i < len(args)-1; i++ {
+			if args[i] == flag {
+				return args[i+1]
+			}
+		}
+		return ""
+	}
+
+	t.Run("Bitrate", func(t *testing.T) {
+		proc := tgproctest.New("")
+		encodeTo(proc, 2*MaxVideoSize, MaxVideoSize)
+		set := newSet(t)
+		require.NoError(t, Video(&Opt{Processor: proc, TempDir: t.TempDir()})(set, ws))
+
+		// the bitrate is computed from the probed duration, and lowered in proportion to the oversize
+		calls := proc.FfmpegCalls()
+		require.Len(t, calls, 2)
+		duration := 2.5
+		bitrate := int64(float64(MaxVideoSize*8) * 0.9 / duration)
+		assert.Equal(t, strconv.FormatInt(bitrate, 10), argAfter(calls[0], "-b:v"))
+		bitrate = int64(float64(bitrate) * float64(MaxVideoSize) / float64(2*MaxVideoSize) * 0.9)
+		assert.Equal(t, strconv.FormatInt(bitrate, 10), argAfter(calls[1], "-b:v"))
+		assert.Equal(t, "libvpx-vp9", argAfter(calls[1], "-c:v"))
+		assert.Equal(t, strconv.Itoa(MaxDuration), argAfter(calls[1], "-t"))
+		assert.Contains(t, calls[1], "-an")
+
+		assert.Equal(t, tgproctest.Output(calls[1]), set.WebM.FileLocal)
+		assert.True(t, set.Video)
+	})
+
+	t.Run("Duration", func(t *testing.T) {
+		// the duration isn't probed, so it's the maximal one
+		proc := tgproctest.New("missing.json")
+		proc.OnFfmpeg = func(args ...string) ([]byte, error) {
+			return nil, tgproctest.WriteSize(tgproctest.Output(args), MaxVideoSize)
+		}
+		require.NoError(t, Video(&Opt{Processor: proc, TempDir: t.TempDir()})(newSet(t), ws))
+		require.Len(t, proc.FfmpegCalls(), 1)
+		duration := float64(MaxDuration)
+		bitrate := int64(float64(MaxVideoSize*8) * 0.9 / duration)
+		assert.Equal(t, strconv.FormatInt(bitrate, 10), argAfter(proc.FfmpegCalls()[0], "-b:v"))
+	})
+
+	t.Run("TooBig", func(t *testing.T) {
+		proc := tgproctest.New("")
+		encodeTo(proc, 2*MaxVideoSize, 2*MaxVideoSize, 2*MaxVideoSize, 2*MaxVideoSize)
+		set := newSet(t)
+		filename := set.WebM.FileLocal
+		err := Video(&Opt{Processor: proc, TempDir: t.TempDir()})(set, ws)
+		assert.ErrorContains(t, err, "tgsticker.Video: could not fit")
+		assert.Len(t, proc.FfmpegCalls(), 4)
+		assert.Equal(t, filename, set.WebM.FileLocal)
+		assert.False(t, set.Video)
+	})
+}
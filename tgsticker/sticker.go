@@ -0,0 +1,81 @@
+// Package tgsticker provides tg.StickerModifier functions, preparing files to suit Telegram sticker requirements.
+//
+//	https://core.telegram.org/stickers#static-stickers-and-emoji
+//	https://core.telegram.org/stickers#video-stickers-and-emoji
+//	https://core.telegram.org/stickers#animated-stickers-and-emoji
+package tgsticker
+
+import (
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc"
+)
+
+const (
+	// Side of a sticker, one of the dimensions must be exactly Side, the other one could be less.
+	Side = 512
+
+	// MaxStaticSize of a PNG/WEBP sticker.
+	MaxStaticSize = 512 << 10
+	// MaxVideoSize of a WEBM sticker.
+	MaxVideoSize = 256 << 10
+	// MaxAnimatedSize of a TGS sticker.
+	MaxAnimatedSize = 64 << 10
+	// MaxLottieSize of the Lottie JSON of a TGS sticker, once it's decompressed.
+	MaxLottieSize = 1 << 20
+
+	// MaxDuration of video and animated stickers, in seconds.
+	MaxDuration = 3
+	// MaxFrameRate of video and animated stickers.
+	MaxFrameRate = 30
+	// AnimatedFrameRate of TGS stickers, could be either 30 or 60.
+	AnimatedFrameRate = 60
+
+	ffmpeg  = "ffmpeg"
+	ffprobe = "ffprobe"
+	convert = "convert"
+)
+
+// Opt for modifiers. Not all of them are used every time.
+type Opt struct {
+	Ffmpeg  string
+	Ffprobe string
+	Convert string
+	TempDir string
+
+	// Processor runs the actual commands, defaulted to tgproc.Exec with Ffmpeg, Ffprobe and Convert binaries.
+	Processor tgproc.MediaProcessor
+}
+
+func (opts *Opt) Defaults() *Opt {
+	if opts == nil {
+		opts = &Opt{}
+	}
+	if opts.Ffmpeg == "" {
+		opts.Ffmpeg = ffmpeg
+	}
+	if opts.Ffprobe == "" {
+		opts.Ffprobe = ffprobe
+	}
+	if opts.Convert == "" {
+		opts.Convert = convert
+	}
+	if opts.Processor == nil {
+		opts.Processor = tgproc.Exec(&tgproc.ExecOpt{Ffmpeg: opts.Ffmpeg, Ffprobe: opts.Ffprobe, Convert: opts.Convert})
+	}
+	return opts
+}
+
+func parseOpts(opts ...*Opt) *Opt {
+	options := &Opt{}
+	if len(opts) != 0 {
+		options = opts[0]
+	}
+	return options.Defaults()
+}
+
+// bound returns a copy of the options, which processor is stopped with the workspace, see tg.Workspace.Context.
+func (opts *Opt) bound(ws *tg.Workspace) *Opt {
+	bound := *opts
+	bound.Processor = tgproc.WithContext(ws.Context(), opts.Processor)
+	return &bound
+}
@@ -0,0 +1,73 @@
+package tgsticker
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+type lottie struct {
+	TGS       *int     `json:"tgs"`
+	Width     int      `json:"w"`
+	Height    int      `json:"h"`
+	FrameRate float64  `json:"fr"`
+	InPoint   float64  `json:"ip"`
+	OutPoint  float64  `json:"op"`
+	Layers    []any    `json:"layers"`
+	Assets    []lottie `json:"assets"`
+}
+
+// ValidateTGS checks, whether the file is a gzipped Lottie animation, which Telegram accepts as an animated sticker:
+// not bigger than 64KB, 512x512, 60 fps, not longer than 3 seconds.
+func ValidateTGS(filename string) error {
+	stat, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+	if stat.Size() > MaxAnimatedSize {
+		return fmt.Errorf("tgs file is %d bytes, must be up to %d", stat.Size(), MaxAnimatedSize)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return fmt.Errorf("tgs file is not gzipped: %v", err)
+	}
+	defer reader.Close()
+
+	// a small gzip could be a huge json
+	data, err := io.ReadAll(io.LimitReader(reader, MaxLottieSize+1))
+	if err != nil {
+		return fmt.Errorf("tgs file is not gzipped: %v", err)
+	}
+	if len(data) > MaxLottieSize {
+		return fmt.Errorf("tgs animation is over %d bytes, once decompressed", MaxLottieSize)
+	}
+
+	var anim lottie
+	if err := json.Unmarshal(data, &anim); err != nil {
+		return fmt.Errorf("tgs file is not a lottie json: %v", err)
+	}
+
+	switch {
+	case anim.TGS == nil || *anim.TGS != 1:
+		return fmt.Errorf(`tgs file must contain "tgs": 1`)
+	case anim.Width != Side || anim.Height != Side:
+		return fmt.Errorf("tgs animation is %dx%d, must be %dx%d", anim.Width, anim.Height, Side, Side)
+	case anim.FrameRate != 30 && anim.FrameRate != AnimatedFrameRate:
+		return fmt.Errorf("tgs animation is %v fps, must be 30 or %d", anim.FrameRate, AnimatedFrameRate)
+	case (anim.OutPoint-anim.InPoint)/anim.FrameRate > MaxDuration:
+		return fmt.Errorf("tgs animation is %.2f seconds, must be up to %d",
+			(anim.OutPoint-anim.InPoint)/anim.FrameRate, MaxDuration)
+	case len(anim.Layers) == 0:
+		return fmt.Errorf("tgs animation has no layers")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package tgsticker
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTGS(t *testing.T, json string) string {
+	filename := filepath.Join(t.TempDir(), "sticker.tgs")
+	file, err := os.Create(filename)
+	require.NoError(t, err)
+	defer file.Close()
+
+	writer := gzip.NewWriter(file)
+	_, err = writer.Write([]byte(json))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return filename
+}
+
+func TestValidateTGS(t *testing.T) {
+	valid := writeTGS(t, `{"tgs":1,"v":"5.5.2","fr":60,"ip":0,"op":180,"w":512,"h":512,"layers":[{}]}`)
+	assert.NoError(t, ValidateTGS(valid))
+
+	tooLong := writeTGS(t, `{"tgs":1,"fr":60,"ip":0,"op":240,"w":512,"h":512,"layers":[{}]}`)
+	assert.Error(t, ValidateTGS(tooLong))
+
+	wrongSize := writeTGS(t, `{"tgs":1,"fr":60,"ip":0,"op":60,"w":256,"h":512,"layers":[{}]}`)
+	assert.Error(t, ValidateTGS(wrongSize))
+
+	notTGS := writeTGS(t, `{"fr":60,"ip":0,"op":60,"w":512,"h":512,"layers":[{}]}`)
+	assert.Error(t, ValidateTGS(notTGS))
+
+	plain := filepath.Join(t.TempDir(), "plain.tgs")
+	require.NoError(t, os.WriteFile(plain, []byte(`{"tgs":1}`), 0o644))
+	assert.Error(t, ValidateTGS(plain))
+
+	// the gzip bomb is within MaxAnimatedSize
+	bomb := writeTGS(t, `{"tgs":1,"fr":60,"ip":0,"op":60,"w":512,"h":512,"layers":[{}],"nm":"`+
+		strings.Repeat(" ", MaxLottieSize)+`"}`)
+	stat, err := os.Stat(bomb)
+	require.NoError(t, err)
+	require.Less(t, stat.Size(), int64(MaxAnimatedSize))
+	assert.ErrorContains(t, ValidateTGS(bomb), "decompressed")
+}
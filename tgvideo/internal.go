@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -23,9 +23,9 @@ type fileMetadata struct {
 	} `json:"format"`
 }
 
-func getFileMetadata(ffprobe, filename string) (*fileMetadata, error) {
-	output, err := exec.Command(ffprobe, "-v", "error", "-select_streams", "v:0", "-show_entries", "stream=width,height",
-		"-of", "json", "-show_format", filename).Output()
+func getFileMetadata(proc tgproc.MediaProcessor, filename string) (*fileMetadata, error) {
+	output, err := proc.Ffprobe("-v", "error", "-select_streams", "v:0", "-show_entries", "stream=width,height",
+		"-of", "json", "-show_format", filename)
 	if err != nil {
 		return nil, fmt.Errorf("%v\n%s", err, string(output))
 	}
@@ -55,23 +55,23 @@ func formatDuration(seconds float64) string {
 		trailingZeros(d/time.Second%60, 2), trailingZeros(d/time.Millisecond%1000, 3))
 }
 
-func formatPreview(tmpDir string, convert string, filename string) (string, error) {
-	tempFile, err := os.CreateTemp(tmpDir, "*_small_preview_heilkit_tg.jpg")
+func formatPreview(ws *tg.Workspace, tmpDir string, proc tgproc.MediaProcessor, filename string) (string, error) {
+	tempFile, err := ws.CreateTemp(tmpDir, "*_small_preview.jpg")
 	if err != nil {
 		return "", err
 	}
 	defer tempFile.Close()
 
-	output, err := exec.Command(convert, filename, "-resize", "320x320", "-quality", "87", tempFile.Name()).CombinedOutput()
+	err = proc.Image(filename, tempFile.Name(), tgproc.ImageOpt{Width: 320, Height: 320, Mode: tgproc.ResizeFit, Quality: 87})
 	if err != nil {
-		return tempFile.Name(), fmt.Errorf("%v\n%s", err, string(output))
+		return tempFile.Name(), err
 	}
 
 	return tempFile.Name(), nil
 }
 
-func makeThumbnailAtAlt(tmpDir string, ffmpeg string, filename string, at string) (string, error) {
-	tmpBig, err := os.CreateTemp(tmpDir, "*_heilkit_tg_big_preview.jpg")
+func makeThumbnailAtAlt(ws *tg.Workspace, tmpDir string, proc tgproc.MediaProcessor, filename string, at string) (string, error) {
+	tmpBig, err := ws.CreateTemp(tmpDir, "*_big_preview.jpg")
 	if err != nil {
 		return "", err
 	}
@@ -79,14 +79,13 @@ func makeThumbnailAtAlt(tmpDir string, ffmpeg string, filename string, at string
 		_ = tmpBig.Close()
 	}()
 
-	output, err := exec.Command(ffmpeg, "-y", "-i", filename,
+	_, err = proc.Ffmpeg("-y", "-i", filename,
 		"-ss", at,
 		"-vframes", "1",
 		"-q:v", "1", "-qmin", "1", "-qmax", "1", "-vf", makeScaleRule(320, 320),
-		tmpBig.Name()).
-		CombinedOutput()
+		tmpBig.Name())
 	if err != nil {
-		return "", fmt.Errorf("%v\n%s", err, string(output))
+		return "", err
 	}
 
 	return tmpBig.Name(), nil
@@ -111,13 +110,6 @@ func filetype(filename string) string {
 	return filename[index:]
 }
 
-func wrapExecError(err error, output []byte) error {
-	if err == nil || len(output) == 0 {
-		return err
-	}
-	return fmt.Errorf("err: %s\nout: %s", err.Error(), string(output))
-}
-
 func parseOpts(opts ...*Opt) *Opt {
 	options := &Opt{}
 	if len(opts) != 0 {
@@ -126,11 +118,18 @@ func parseOpts(opts ...*Opt) *Opt {
 	return options.Defaults()
 }
 
+// bound returns a copy of the options, which processor is stopped with the workspace, see tg.Workspace.Context.
+func (opts *Opt) bound(ws *tg.Workspace) *Opt {
+	bound := *opts
+	bound.Processor = tgproc.WithContext(ws.Context(), opts.Processor)
+	return &bound
+}
+
 func getSetMetadata(video *tg.Video, opt *Opt) (meta *fileMetadata, duration float64, err error) {
 	if video == nil || video.FileLocal == "" {
 		return nil, 0, nil
 	}
-	meta, err = getFileMetadata(opt.Ffprobe, video.FileLocal)
+	meta, err = getFileMetadata(opt.Processor, video.FileLocal)
 	if err != nil {
 		return
 	}
@@ -156,24 +155,18 @@ func makeScaleRule(width int, height int) string {
 	return fmt.Sprintf("scale=if(gte(iw\\,ih)\\,min(%d\\,iw)\\,-2):if(lt(iw\\,ih)\\,min(%d\\,ih)\\,-2)", width, height)
 }
 
-func isVideoTypeSupported(filename string) bool {
-	supportedTypes := []string{".mp4", ".mpeg4", ".mov"}
-	lower := strings.ToLower(filename)
-	for _, type_ := range supportedTypes {
-		if strings.HasSuffix(lower, type_) {
-			return true
-		}
+func sniff(filename string, proc tgproc.MediaProcessor) tgproc.Type {
+	sniffed, err := tgproc.SniffWith(proc, filename)
+	if err != nil {
+		return tgproc.ByExtension(filename)
 	}
-	return false
+	return sniffed
 }
 
-func isVideoTypeConvertableByCopy(filename string) bool {
-	supportedTypes := []string{".webm", ".m4v"}
-	lower := strings.ToLower(filename)
-	for _, type_ := range supportedTypes {
-		if strings.HasSuffix(lower, type_) {
-			return true
-		}
-	}
-	return false
+func isVideoTypeSupported(sniffed tgproc.Type) bool {
+	return sniffed.Is("video/mp4", "video/quicktime")
+}
+
+func isVideoTypeConvertableByCopy(sniffed tgproc.Type) bool {
+	return sniffed.Is("video/webm", "video/x-m4v")
 }
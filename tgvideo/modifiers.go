@@ -4,8 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/heilkit/tg"
-	"os"
-	"os/exec"
 	"strings"
 )
 
@@ -15,7 +13,8 @@ func Convert(opts ...*Opt) tg.VideoModifier {
 	options := parseOpts(opts...)
 
 	scaleRule := makeScaleRule(options.Width, options.Height)
-	return func(video *tg.Video) (temporaries []string, err error) {
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
 		defer func() {
 			if err != nil {
 				err = fmt.Errorf("tgvideo.Convert: %v", err)
@@ -23,47 +22,48 @@ func Convert(opts ...*Opt) tg.VideoModifier {
 		}()
 
 		if video == nil || video.FileLocal == "" {
-			return nil, nil
+			return nil
 		}
 
-		tmpFile, err := os.CreateTemp(options.TempDir, fmt.Sprintf("*_heilkit_tg_%s", filetype(video.FileLocal)))
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*"+filetype(video.FileLocal))
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		output, err := exec.Command(options.Ffmpeg, "-y",
+		_, err = options.Processor.Ffmpeg("-y",
 			"-i", video.FileLocal,
 			"-vf", scaleRule,
 			"-vcodec", "libx264",
 			"-acodec", "aac",
 			"-preset", options.Preset,
-			tmpFile.Name()).
-			CombinedOutput()
+			tmpFile.Name())
 		if err != nil {
-			return []string{tmpFile.Name()}, wrapExecError(err, output)
+			return err
 		}
 
 		video.FileLocal = tmpFile.Name()
-		return []string{tmpFile.Name()}, nil
+		return nil
 	}
 }
 
 // ConvertIfNeeded ensures a video is converted to a type supported by Telegram.
 // REQUIRES `ffmpeg` on the system, which could be passed via Opt.Convert.
 func ConvertIfNeeded(opts ...*Opt) tg.VideoModifier {
-	convert := Convert(opts...)
-	convertByCopy := ConvertByCopy(opts...)
-	return func(video *tg.Video) (temporaries []string, err error) {
-		filename := video.FileLocal
+	options := parseOpts(opts...)
+	convert := Convert(options)
+	convertByCopy := ConvertByCopy(options)
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		sniffed := sniff(video.FileLocal, options.Processor)
 		switch {
-		case isVideoTypeSupported(filename):
-			return nil, nil
+		case isVideoTypeSupported(sniffed):
+			return nil
 
-		case isVideoTypeConvertableByCopy(filename):
-			return convertByCopy(video)
+		case isVideoTypeConvertableByCopy(sniffed):
+			return convertByCopy(video, ws)
 
 		default:
-			return convert(video)
+			return convert(video, ws)
 		}
 	}
 }
@@ -73,32 +73,33 @@ func ConvertIfNeeded(opts ...*Opt) tg.VideoModifier {
 func ConvertByCopy(opts ...*Opt) tg.VideoModifier {
 	options := parseOpts(opts...)
 
-	return func(video *tg.Video) (temporaries []string, err error) {
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
 		defer func() {
 			if err != nil {
 				err = fmt.Errorf("tgvideo.ConvertByCopy: %v", err)
 			}
 		}()
 
-		tmpFile, err := os.CreateTemp(options.TempDir, "*_heilkit_tg.mp4")
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*.mp4")
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		output, err := exec.Command(options.Ffmpeg, "-y",
+		_, err = options.Processor.Ffmpeg("-y",
 			"-i", video.FileLocal,
 			tmpFile.Name(),
 			"-c", "copy",
-		).CombinedOutput()
+		)
 		if err != nil {
-			return []string{tmpFile.Name()}, wrapExecError(err, output)
+			return err
 		}
 
 		video.FileLocal = tmpFile.Name()
 		if index := strings.LastIndex(video.FileName, "."); index != -1 {
 			video.FileName = video.FileName[0:index] + ".mp4"
 		}
-		return []string{tmpFile.Name()}, nil
+		return nil
 	}
 }
 
@@ -107,9 +108,10 @@ func ConvertByCopy(opts ...*Opt) tg.VideoModifier {
 func EnsureMeta(opts ...*Opt) tg.VideoModifier {
 	options := parseOpts(opts...)
 
-	return func(video *tg.Video) (temporaries []string, err error) {
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
 		_, _, err = getSetMetadata(video, options)
-		return nil, err
+		return err
 	}
 }
 
@@ -118,34 +120,34 @@ func EnsureMeta(opts ...*Opt) tg.VideoModifier {
 func EmbedMetadata[T any](meta T, opts ...*Opt) tg.VideoModifier {
 	options := parseOpts(opts...)
 
-	return func(video *tg.Video) (temporaries []string, err error) {
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
 		defer func() {
 			if err != nil {
 				err = fmt.Errorf("tgvideo.EmbedMetadata: %v", err)
 			}
 		}()
 
-		tmpFile, err := os.CreateTemp(options.TempDir, "*_heilkit_tg.mp4")
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*.mp4")
 		if err != nil {
-			return nil, err
+			return err
 		}
 		defer tmpFile.Close()
 
 		args := []string{"-y", "-i", video.FileLocal}
 		metadata, err := json.Marshal(meta)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		args = append(args, "-metadata", "comment="+string(metadata), "-c", "copy", tmpFile.Name())
 
-		output, err := exec.Command(options.Ffmpeg, args...).
-			CombinedOutput()
+		_, err = options.Processor.Ffmpeg(args...)
 		if err != nil {
-			return []string{tmpFile.Name()}, wrapExecError(err, output)
+			return err
 		}
 
 		video.FileLocal = tmpFile.Name()
-		return []string{tmpFile.Name()}, nil
+		return nil
 	}
 }
 
@@ -154,20 +156,21 @@ func EmbedMetadata[T any](meta T, opts ...*Opt) tg.VideoModifier {
 func ThumbnailFrom(filename string, opts ...*Opt) tg.VideoModifier {
 	options := parseOpts(opts...)
 
-	return func(video *tg.Video) (temporaries []string, err error) {
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
 		defer func() {
 			if err != nil {
 				err = fmt.Errorf("tgvideo.ThumbnailFrom: %v", err)
 			}
 		}()
 
-		extraFile, err := formatPreview(options.TempDir, options.Convert, filename)
+		extraFile, err := formatPreview(ws, options.TempDir, options.Processor, filename)
 		if err != nil {
-			return []string{extraFile}, err
+			return err
 		}
 		video.Thumbnail = &tg.Photo{File: tg.FromDisk(extraFile)}
 		_, _, err = getSetMetadata(video, options)
-		return []string{extraFile}, err
+		return err
 	}
 }
 
@@ -186,7 +189,8 @@ func ThumbnailAt(position interface{}, opts ...*Opt) tg.VideoModifier {
 
 	options := parseOpts(opts...)
 
-	return func(video *tg.Video) (filename []string, err error) {
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
 		defer func() {
 			if err != nil {
 				err = fmt.Errorf("tgvideo.ThumbnailAt: %v", err)
@@ -198,18 +202,18 @@ func ThumbnailAt(position interface{}, opts ...*Opt) tg.VideoModifier {
 			}
 		}()
 		if video == nil || video.FileLocal == "" {
-			return nil, nil
+			return nil
 		}
 
 		_, videoDuration, err := getSetMetadata(video, options)
 
-		thumbnail, err := makeThumbnailAtAlt(options.TempDir, options.Ffmpeg, video.FileLocal, calcThumbnailPosition(videoDuration, position))
+		thumbnail, err := makeThumbnailAtAlt(ws, options.TempDir, options.Processor, video.FileLocal, calcThumbnailPosition(videoDuration, position))
 		if err != nil {
-			return []string{thumbnail}, err
+			return err
 		}
 
 		video.Thumbnail = &tg.Photo{File: tg.FromDisk(thumbnail)}
-		return []string{thumbnail}, err
+		return err
 	}
 }
 
@@ -218,7 +222,8 @@ func ThumbnailAt(position interface{}, opts ...*Opt) tg.VideoModifier {
 func Mute(opts ...*Opt) tg.VideoModifier {
 	options := parseOpts(opts...)
 
-	return func(video *tg.Video) (temporaries []string, err error) {
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
 		defer func() {
 			if err != nil {
 				err = fmt.Errorf("tgvideo.Mute: %v", err)
@@ -226,33 +231,73 @@ func Mute(opts ...*Opt) tg.VideoModifier {
 		}()
 
 		if video == nil || video.FileLocal == "" {
-			return nil, nil
+			return nil
 		}
 
-		tmpFile, err := os.CreateTemp(options.TempDir, fmt.Sprintf("*_heilkit_tg_%s", filetype(video.FileLocal)))
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*"+filetype(video.FileLocal))
 		if err != nil {
-			return nil, err
+			return err
 		}
 		defer tmpFile.Close()
 
-		output, err := exec.Command(options.Ffmpeg, "-y", "-i", video.FileLocal, "-vcodec", "copy", "-an", tmpFile.Name()).
-			CombinedOutput()
+		_, err = options.Processor.Ffmpeg("-y", "-i", video.FileLocal, "-vcodec", "copy", "-an", tmpFile.Name())
 		if err != nil {
-			return nil, wrapExecError(err, output)
+			return err
 		}
 
 		video.FileLocal = tmpFile.Name()
-		return []string{tmpFile.Name()}, nil
+		return nil
 	}
 }
 
 // OnError allows set action on error for the wrapped tg.VideoModifier. If wrapper returns nil, the error is muted.
-func OnError(mod tg.VideoModifier, fn func(temporaries []string, err error) error) tg.VideoModifier {
-	return func(video *tg.Video) (temporaries []string, err error) {
-		ret_, err_ := mod(video)
-		if err_ != nil {
-			err = fn(ret_, err_)
+// Temporaries of the failed modifier are still owned by the workspace.
+func OnError(mod tg.VideoModifier, fn func(err error) error) tg.VideoModifier {
+	return func(video *tg.Video, ws *tg.Workspace) error {
+		if err := mod(video, ws); err != nil {
+			return fn(err)
+		}
+		return nil
+	}
+}
+
+// ToVideoNote crops a video to a centered square, scales it down to 640px and cuts it to 60 seconds,
+// so it could be sent as a round tg.VideoNote, use with VideoNote.With or Video.ToVideoNote.
+// REQUIRES `ffmpeg`, `ffprobe` on the system, could be passed via Opt.
+func ToVideoNote(opts ...*Opt) tg.VideoModifier {
+	options := parseOpts(opts...)
+
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgvideo.ToVideoNote: %v", err)
+			}
+		}()
+
+		if video == nil || video.FileLocal == "" {
+			return nil
+		}
+
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*_note.mp4")
+		if err != nil {
+			return err
 		}
-		return ret_, err
+		_ = tmpFile.Close()
+
+		_, err = options.Processor.Ffmpeg("-y", "-i", video.FileLocal,
+			"-t", fmt.Sprintf("%d", videoNoteMaxDuration),
+			"-vf", fmt.Sprintf("crop=min(iw\\,ih):min(iw\\,ih),scale=trunc(min(%d\\,iw)/2)*2:-2", videoNoteMaxLength),
+			"-vcodec", "libx264",
+			"-acodec", "aac",
+			"-preset", options.Preset,
+			tmpFile.Name())
+		if err != nil {
+			return err
+		}
+
+		video.FileLocal = tmpFile.Name()
+		_, _, err = getSetMetadata(video, options)
+		return err
 	}
 }
@@ -3,7 +3,6 @@ package tgvideo
 import (
 	"encoding/json"
 	"fmt"
-	"os/exec"
 )
 
 // ExtractMetadata gets you metadata at format/tags/comment.
@@ -32,10 +31,9 @@ func ExtractMetadata[T any](filename string, opts ...*Opt) (T, error) {
 func ExtractMetadataAll[T any](filename string, opts ...*Opt) (*T, error) {
 	options := parseOpts(opts...)
 
-	output, err := exec.Command(options.Ffprobe, filename, "-print_format", "json", "-show_format").
-		Output()
+	output, err := options.Processor.Ffprobe(filename, "-print_format", "json", "-show_format")
 	if err != nil {
-		return nil, fmt.Errorf("while executing %s: %v", options.Ffprobe, err)
+		return nil, err
 	}
 
 	var ret T
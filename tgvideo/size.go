@@ -0,0 +1,212 @@
+package tgvideo
+
+import (
+	"fmt"
+	"github.com/heilkit/tg"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxSize of a file, uploaded with the official Bot API server.
+	MaxSize int64 = 50 << 20
+	// MaxSizeLocal of a file, uploaded with a local Bot API server.
+	MaxSizeLocal int64 = 2000 << 20
+
+	// sizeHeadroom leaves space for the container overhead and bitrate fluctuations.
+	sizeHeadroom     = 0.95
+	audioBitrate     = 128_000
+	audioBitrateLow  = 48_000
+	minVideoBitrate  = 64_000
+	fitSizeAttempts  = 4
+	fitSizeDownscale = 0.75
+)
+
+// FitReport describes the result of FitSize.
+type FitReport struct {
+	Size     int64 // achieved file size in bytes
+	Bitrate  int64 // video bitrate of the last attempt, bits/s
+	Width    int
+	Height   int
+	Attempts int
+}
+
+// FitSize re-encodes a video with two-pass libx264, so it's not bigger than maxBytes (i.e. MaxSize).
+// Bitrate is computed from the duration, if the result is still too big, the resolution is decreased progressively.
+// Videos that already fit are left untouched. The achieved size is reported via Opt.OnFit and Video.FileSize.
+// REQUIRES `ffmpeg`, `ffprobe` on the system, could be passed via Opt.
+func FitSize(maxBytes int64, opts ...*Opt) tg.VideoModifier {
+	options := parseOpts(opts...)
+
+	return func(video *tg.Video, ws *tg.Workspace) (err error) {
+		options := options.bound(ws)
+		defer func() {
+			if err != nil {
+				err = fmt.Errorf("tgvideo.FitSize: %v", err)
+			}
+		}()
+
+		if video == nil || video.FileLocal == "" {
+			return nil
+		}
+		if stat, err := os.Stat(video.FileLocal); err == nil && stat.Size() <= maxBytes {
+			return nil
+		}
+
+		meta, duration, err := getSetMetadata(video, options)
+		if err != nil {
+			return err
+		}
+		if duration <= 0 || meta == nil || len(meta.Streams) == 0 {
+			return fmt.Errorf("could not get the video duration and dimensions")
+		}
+
+		audio := int64(audioBitrate)
+		budget := int64(float64(maxBytes*8) * sizeHeadroom / duration)
+		if budget-audio < minVideoBitrate*4 {
+			audio = audioBitrateLow
+		}
+		bitrate := budget - audio
+		if bitrate < minVideoBitrate {
+			return fmt.Errorf("%.0fs video can't fit into %d bytes, consider tgvideo.Split", duration, maxBytes)
+		}
+
+		side := min(max(meta.Streams[0].Width, meta.Streams[0].Height), max(options.Width, options.Height))
+		report := FitReport{}
+		for attempt := 1; attempt <= fitSizeAttempts; attempt++ {
+			output, err := twoPassEncode(ws, video.FileLocal, options, makeScaleRule(side, side), bitrate, audio)
+			if err != nil {
+				return err
+			}
+
+			stat, err := os.Stat(output)
+			if err != nil {
+				return err
+			}
+			report = FitReport{Size: stat.Size(), Bitrate: bitrate, Attempts: attempt}
+			if stat.Size() <= maxBytes {
+				video.FileLocal = output
+				if _, _, err := getSetMetadata(video, options); err != nil {
+					return err
+				}
+				report.Width, report.Height = video.Width, video.Height
+				if options.OnFit != nil {
+					options.OnFit(report)
+				}
+				return nil
+			}
+
+			bitrate = int64(float64(bitrate) * float64(maxBytes) / float64(stat.Size()) * sizeHeadroom)
+			side = max(int(float64(side)*fitSizeDownscale), 2)
+			if bitrate < minVideoBitrate {
+				break
+			}
+		}
+
+		return fmt.Errorf("could not fit into %d bytes in %d attempts, the last one is %d bytes",
+			maxBytes, report.Attempts, report.Size)
+	}
+}
+
+func twoPassEncode(ws *tg.Workspace, filename string, options *Opt, scaleRule string, bitrate, audio int64) (output string, err error) {
+	tmpFile, err := ws.CreateTemp(options.TempDir, "*.mp4")
+	if err != nil {
+		return "", err
+	}
+	_ = tmpFile.Close()
+
+	passLog := strings.TrimSuffix(tmpFile.Name(), ".mp4") + "_pass"
+	ws.Track(passLog+"-0.log", passLog+"-0.log.mbtree")
+
+	videoArgs := []string{"-y", "-i", filename,
+		"-vf", scaleRule,
+		"-c:v", "libx264",
+		"-preset", options.Preset,
+		"-b:v", strconv.FormatInt(bitrate, 10),
+		"-passlogfile", passLog,
+	}
+
+	if _, err := options.Processor.Ffmpeg(append(videoArgs, "-pass", "1", "-an", "-f", "mp4", os.DevNull)...); err != nil {
+		return "", err
+	}
+	_, err = options.Processor.Ffmpeg(append(videoArgs, "-pass", "2",
+		"-c:a", "aac", "-b:a", strconv.FormatInt(audio, 10),
+		"-movflags", "+faststart",
+		tmpFile.Name())...)
+	if err != nil {
+		return "", err
+	}
+
+	return tmpFile.Name(), nil
+}
+
+// Split cuts a long video into parts, each of them is not bigger than maxBytes.
+// Parts are cut without re-encoding, the ones that still do not fit are processed with FitSize.
+// The parts are owned by the workspace, clean it up after the album is sent.
+// Note, that the returned Album could be longer than 10 items.
+// REQUIRES `ffmpeg`, `ffprobe` on the system, could be passed via Opt.
+func Split(ws *tg.Workspace, filename string, maxBytes int64, opts ...*Opt) (album tg.Album, err error) {
+	options := parseOpts(opts...).bound(ws)
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("tgvideo.Split: %v", err)
+		}
+	}()
+
+	stat, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	source := &tg.Video{File: tg.FromDisk(filename)}
+	_, duration, err := getSetMetadata(source, options)
+	if err != nil {
+		return nil, err
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("could not get the video duration")
+	}
+
+	parts := int(math.Ceil(float64(stat.Size()) / (float64(maxBytes) * sizeHeadroom)))
+	if parts <= 1 {
+		return tg.Album{source}, nil
+	}
+
+	segment := duration / float64(parts)
+	base := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	fit := FitSize(maxBytes, options)
+	for i := 0; i < parts; i++ {
+		tmpFile, err := ws.CreateTemp(options.TempDir, "*.mp4")
+		if err != nil {
+			return album, err
+		}
+		_ = tmpFile.Close()
+
+		_, err = options.Processor.Ffmpeg("-y",
+			"-ss", strconv.FormatFloat(segment*float64(i), 'f', 3, 64),
+			"-i", filename,
+			"-t", strconv.FormatFloat(segment, 'f', 3, 64),
+			"-c", "copy",
+			"-avoid_negative_ts", "make_zero",
+			tmpFile.Name())
+		if err != nil {
+			return album, err
+		}
+
+		part := &tg.Video{
+			File:     tg.FromDisk(tmpFile.Name()),
+			FileName: fmt.Sprintf("%s_%d.mp4", base, i+1),
+		}
+		if err := fit(part, ws); err != nil {
+			return album, err
+		}
+		if _, _, err := getSetMetadata(part, options); err != nil {
+			return album, err
+		}
+		album = append(album, part)
+	}
+
+	return album, nil
+}
@@ -0,0 +1,264 @@
+package tgvideo
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/heilkit/tg"
+	"github.com/heilkit/tg/tgproc/tgproctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newVideo(t *testing.T) *tg.Video {
+	return &tg.Video{File: tg.FromDisk(tgproctest.WriteFile(t, "clip.mp4", "\x00\x00\x00\x18ftypisom\x00\x00\x02\x00")), FileName: "clip.mp4"}
+}
+
+// argAfter returns the value of the ffmpeg flag.
+func argAfter(args []string, flag string) string {
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == flag {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// encodeTo makes the second passes of twoPassEncode write the outputs of the sizes, one per attempt.
+func encodeTo(proc *tgproctest.Processor, sizes ...int64) {
+	proc.OnFfmpeg = func(args ...string) ([]byte, error) {
+		if argAfter(args, "-pass") != "2" {
+			return nil, nil
+		}
+		size := sizes[0]
+		sizes = sizes[1:]
+		return nil, tgproctest.WriteSize(tgproctest.Output(args), size)
+	}
+}
+
+// secondPasses returns the ffmpeg calls of the encoding attempts.
+func secondPasses(proc *tgproctest.Processor) (passes [][]string) {
+	for _, args := range proc.FfmpegCalls() {
+		if argAfter(args, "-pass") == "2" {
+			passes = append(passes, args)
+		}
+	}
+	return passes
+}
+
+func TestEnsureMeta(t *testing.T) {
+	ws := tg.NewWorkspace(0)
+	defer ws.Cleanup()
+
+	video := newVideo(t)
+	require.NoError(t, EnsureMeta(&Opt{Processor: tgproctest.New("clip.json")})(video, ws))
+	assert.Equal(t, 1280, video.Width)
+	assert.Equal(t, 720, video.Height)
+	assert.Equal(t, 12, video.Duration)
+	assert.Equal(t, "video/mp4", video.MIME)
+	assert.EqualValues(t, 16, video.FileSize)
+
+	err := EnsureMeta(&Opt{Processor: tgproctest.New("missing.json")})(newVideo(t), ws)
+	assert.Error(t, err)
+}
+
+func TestExtractMetadata(t *testing.T) {
+	type comment struct {
+		ID     int    `json:"id"`
+		Source string `json:"source"`
+	}
+	opt := &Opt{Processor: tgproctest.New("clip.json")}
+
+	meta, err := ExtractMetadata[comment]("clip.mp4", opt)
+	require.NoError(t, err)
+	assert.Equal(t, comment{ID: 42, Source: "camera"}, meta)
+
+	all, err := ExtractMetadataAll[map[string]any]("clip.mp4", opt)
+	require.NoError(t, err)
+	assert.Equal(t, "12.480000", (*all)["format"].(map[string]any)["duration"])
+}
+
+func TestThumbnailAt(t *testing.T) {
+	ws := tg.NewWorkspace(0)
+	defer ws.Cleanup()
+
+	proc := tgproctest.New("clip.json")
+	video := newVideo(t)
+	require.NoError(t, ThumbnailAt(0.5, &Opt{Processor: proc, TempDir: t.TempDir()})(video, ws))
+	require.Len(t, proc.FfmpegCalls(), 1)
+	assert.Contains(t, proc.FfmpegCalls()[0], "00:00:06.000")
+	assert.NotNil(t, video.Thumbnail)
+
+	assert.Equal(t, "01:02:03.000", formatDuration(3723))
+	assert.Equal(t, "00:00:01.5", calcThumbnailPosition(10, "00:00:01.5"))
+}
+
+func TestFitSize(t *testing.T) {
+	const duration = 12.48 // of the clip.json fixture
+	budget := func(maxBytes int64) int64 { return int64(float64(maxBytes*8) * sizeHeadroom / duration) }
+
+	t.Run("Fits", func(t *testing.T) {
+		ws := tg.NewWorkspace(0)
+		defer ws.Cleanup()
+
+		proc := tgproctest.New("clip.json")
+		video := newVideo(t)
+		filename := video.FileLocal
+		require.NoError(t, FitSize(1<<20, &Opt{Processor: proc})(video, ws))
+		assert.Equal(t, filename, video.FileLocal)
+		assert.Empty(t, proc.FfmpegCalls())
+		assert.Empty(t, proc.FfprobeCalls())
+	})
+
+	t.Run("Bitrate", func(t *testing.T) {
+		ws := tg.NewWorkspace(0)
+		defer ws.Cleanup()
+
+		const maxBytes = 4 << 20
+		proc := tgproctest.New("clip.json")
+		encodeTo(proc, maxBytes-1)
+		reports := []FitReport{}
+		video := newVideo(t)
+		require.NoError(t, tgproctest.WriteSize(video.FileLocal, maxBytes+1))
+
+		require.NoError(t, FitSize(maxBytes, &Opt{Processor: proc, TempDir: t.TempDir(), OnFit: func(report FitReport) {
+			reports = append(reports, report)
+		}})(video, ws))
+		passes := secondPasses(proc)
+		require.Len(t, passes, 1)
+		bitrate := budget(maxBytes) - audioBitrate
+		assert.Equal(t, strconv.FormatInt(bitrate, 10), argAfter(passes[0], "-b:v"))
+		assert.Equal(t, strconv.Itoa(audioBitrate), argAfter(passes[0], "-b:a"))
+		assert.Equal(t, makeScaleRule(1280, 1280), argAfter(passes[0], "-vf"))
+		// the first pass goes with the same bitrate, and without the audio
+		require.Len(t, proc.FfmpegCalls(), 2)
+		assert.Equal(t, "1", argAfter(proc.FfmpegCalls()[0], "-pass"))
+		assert.Equal(t, strconv.FormatInt(bitrate, 10), argAfter(proc.FfmpegCalls()[0], "-b:v"))
+		assert.Contains(t, proc.FfmpegCalls()[0], "-an")
+
+		assert.Equal(t, tgproctest.Output(passes[0]), video.FileLocal)
+		assert.EqualValues(t, maxBytes-1, video.FileSize)
+		assert.Equal(t, []FitReport{{Size: maxBytes - 1, Bitrate: bitrate, Width: 1280, Height: 720, Attempts: 1}}, reports)
+	})
+
+	t.Run("LowAudio", func(t *testing.T) {
+		ws := tg.NewWorkspace(0)
+		defer ws.Cleanup()
+
+		// the budget is less than the audio and 4 minimal video bitrates
+		const maxBytes = 512 << 10
+		require.Less(t, budget(maxBytes)-audioBitrate, int64(minVideoBitrate*4))
+		proc := tgproctest.New("clip.json")
+		encodeTo(proc, maxBytes)
+		video := newVideo(t)
+		require.NoError(t, tgproctest.WriteSize(video.FileLocal, maxBytes+1))
+
+		require.NoError(t, FitSize(maxBytes, &Opt{Processor: proc, TempDir: t.TempDir()})(video, ws))
+		passes := secondPasses(proc)
+		require.Len(t, passes, 1)
+		assert.Equal(t, strconv.Itoa(audioBitrateLow), argAfter(passes[0], "-b:a"))
+		assert.Equal(t, strconv.FormatInt(budget(maxBytes)-audioBitrateLow, 10), argAfter(passes[0], "-b:v"))
+	})
+
+	t.Run("Downscale", func(t *testing.T) {
+		ws := tg.NewWorkspace(0)
+		defer ws.Cleanup()
+
+		const maxBytes = 4 << 20
+		proc := tgproctest.New("clip.json")
+		encodeTo(proc, 2*maxBytes, maxBytes+maxBytes/2, maxBytes)
+		var report FitReport
+		video := newVideo(t)
+		require.NoError(t, tgproctest.WriteSize(video.FileLocal, 2*maxBytes))
+
+		require.NoError(t, FitSize(maxBytes, &Opt{Processor: proc, TempDir: t.TempDir(), OnFit: func(r FitReport) {
+			report = r
+		}})(video, ws))
+		passes := secondPasses(proc)
+		require.Len(t, passes, 3)
+		assert.Equal(t, makeScaleRule(1280, 1280), argAfter(passes[0], "-vf"))
+		assert.Equal(t, makeScaleRule(960, 960), argAfter(passes[1], "-vf"))
+		assert.Equal(t, makeScaleRule(720, 720), argAfter(passes[2], "-vf"))
+
+		// the bitrate is lowered in proportion to the oversize
+		bitrate := budget(maxBytes) - audioBitrate
+		for i, size := range []int64{2 * maxBytes, maxBytes + maxBytes/2} {
+			assert.Equal(t, strconv.FormatInt(bitrate, 10), argAfter(passes[i], "-b:v"))
+			bitrate = int64(float64(bitrate) * float64(maxBytes) / float64(size) * sizeHeadroom)
+		}
+		assert.Equal(t, strconv.FormatInt(bitrate, 10), argAfter(passes[2], "-b:v"))
+		assert.Equal(t, FitReport{Size: maxBytes, Bitrate: bitrate, Width: 1280, Height: 720, Attempts: 3}, report)
+		assert.Equal(t, tgproctest.Output(passes[2]), video.FileLocal)
+	})
+
+	t.Run("Oversized", func(t *testing.T) {
+		ws := tg.NewWorkspace(0)
+		defer ws.Cleanup()
+
+		const maxBytes = 4 << 20
+		proc := tgproctest.New("clip.json")
+		encodeTo(proc, 2*maxBytes, 2*maxBytes, 2*maxBytes, 2*maxBytes)
+		video := newVideo(t)
+		filename := video.FileLocal
+		require.NoError(t, tgproctest.WriteSize(filename, 2*maxBytes))
+
+		err := FitSize(maxBytes, &Opt{Processor: proc, TempDir: t.TempDir()})(video, ws)
+		assert.ErrorContains(t, err, "in 4 attempts")
+		assert.Len(t, secondPasses(proc), fitSizeAttempts)
+		assert.Equal(t, filename, video.FileLocal)
+	})
+
+	t.Run("TooLong", func(t *testing.T) {
+		ws := tg.NewWorkspace(0)
+		defer ws.Cleanup()
+
+		// even the minimal video bitrate doesn't fit
+		const maxBytes = 64 << 10
+		proc := tgproctest.New("clip.json")
+		video := newVideo(t)
+		require.NoError(t, tgproctest.WriteSize(video.FileLocal, maxBytes+1))
+
+		err := FitSize(maxBytes, &Opt{Processor: proc, TempDir: t.TempDir()})(video, ws)
+		assert.ErrorContains(t, err, "consider tgvideo.Split")
+		assert.Empty(t, proc.FfmpegCalls())
+	})
+}
+
+func TestSplit(t *testing.T) {
+	ws := tg.NewWorkspace(0)
+	defer ws.Cleanup()
+
+	const maxBytes = 4 << 20
+	proc := tgproctest.New("clip.json")
+	filename := newVideo(t).FileLocal
+	require.NoError(t, tgproctest.WriteSize(filename, 3*maxBytes))
+
+	// the parts are cut without re-encoding, and they already fit
+	album, err := Split(ws, filename, maxBytes, &Opt{Processor: proc, TempDir: t.TempDir()})
+	require.NoError(t, err)
+	require.Len(t, album, 4)
+	cuts := proc.FfmpegCalls()
+	require.Len(t, cuts, 4)
+	for i, cut := range cuts {
+		assert.Equal(t, strconv.FormatFloat(3.12*float64(i), 'f', 3, 64), argAfter(cut, "-ss"))
+		assert.Equal(t, "3.120", argAfter(cut, "-t"))
+		assert.Equal(t, filename, argAfter(cut, "-i"))
+		assert.Equal(t, "copy", argAfter(cut, "-c"))
+
+		part := album[i].(*tg.Video)
+		assert.Equal(t, tgproctest.Output(cut), part.FileLocal)
+		assert.Equal(t, "clip_"+strconv.Itoa(i+1)+".mp4", part.FileName)
+		assert.Equal(t, 1280, part.Width)
+	}
+
+	// the video, which fits, is the only part
+	require.NoError(t, tgproctest.WriteSize(filename, maxBytes/2))
+	album, err = Split(ws, filename, maxBytes, &Opt{Processor: proc, TempDir: t.TempDir()})
+	require.NoError(t, err)
+	require.Len(t, album, 1)
+	assert.Equal(t, filename, album[0].(*tg.Video).FileLocal)
+	assert.Len(t, proc.FfmpegCalls(), 4)
+
+	_, err = Split(ws, filename, maxBytes, &Opt{Processor: tgproctest.New("missing.json")})
+	assert.Error(t, err)
+}
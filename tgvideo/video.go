@@ -1,10 +1,15 @@
 package tgvideo
 
+import "github.com/heilkit/tg/tgproc"
+
 const (
 	ffmpeg  = "ffmpeg"
 	ffprobe = "ffprobe"
 	convert = "convert"
 	preset  = "fast"
+
+	videoNoteMaxLength   = 640
+	videoNoteMaxDuration = 60
 )
 
 // Opt for modifiers. Not all of them are used every time.
@@ -16,6 +21,12 @@ type Opt struct {
 	Ffprobe string
 	Convert string
 	TempDir string
+
+	// OnFit is called with the FitSize result.
+	OnFit func(FitReport)
+
+	// Processor runs the actual commands, defaulted to tgproc.Exec with Ffmpeg, Ffprobe and Convert binaries.
+	Processor tgproc.MediaProcessor
 }
 
 func (opts *Opt) Defaults() *Opt {
@@ -40,5 +51,8 @@ func (opts *Opt) Defaults() *Opt {
 	if opts.Preset == "" {
 		opts.Preset = preset
 	}
+	if opts.Processor == nil {
+		opts.Processor = tgproc.Exec(&tgproc.ExecOpt{Ffmpeg: opts.Ffmpeg, Ffprobe: opts.Ffprobe, Convert: opts.Convert})
+	}
 	return opts
 }
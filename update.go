@@ -28,7 +28,7 @@ type Update struct {
 // ProcessUpdate processes a single incoming update.
 // A started bot calls this function automatically.
 func (b *Bot) ProcessUpdate(u Update) {
-	c := b.NewContext(u)
+	c := &nativeContext{b: b, u: u, reply: b.replies.take(u.ID)}
 
 	if u.Message != nil {
 		m := u.Message
@@ -191,6 +191,7 @@ func (b *Bot) ProcessUpdate(u Update) {
 
 		if m.MigrateTo != 0 {
 			m.MigrateFrom = m.Chat.ID
+			b.migrated(m.MigrateFrom, m.MigrateTo)
 			b.handle(OnMigration, c)
 			return
 		}
@@ -254,7 +255,7 @@ func (b *Bot) ProcessUpdate(u Update) {
 			match := cbackRx.FindAllStringSubmatch(data, -1)
 			if match != nil {
 				unique, payload := match[0][1], match[0][3]
-				if handler, ok := b.handlers["\f"+unique]; ok {
+				if handler, ok := b.handler("\f" + unique); ok {
 					u.Callback.Unique = unique
 					u.Callback.Data = payload
 					b.runHandler(handler, c, unique)
@@ -313,8 +314,15 @@ func (b *Bot) ProcessUpdate(u Update) {
 	}
 }
 
+func (b *Bot) handler(end string) (HandlerFunc, bool) {
+	b.handlersMu.RLock()
+	defer b.handlersMu.RUnlock()
+	handler, ok := b.handlers[end]
+	return handler, ok
+}
+
 func (b *Bot) handle(end string, c Context) bool {
-	if handler, ok := b.handlers[end]; ok {
+	if handler, ok := b.handler(end); ok {
 		b.runHandler(handler, c, end)
 		return true
 	}
@@ -356,7 +364,15 @@ func (b *Bot) handleMedia(c Context) bool {
 }
 
 func (b *Bot) runHandler(h HandlerFunc, c Context, endpoint string) {
+	id := c.Update().ID
+	b.inflight.add()
+	b.acks.begin(id)
+	release := func() {
+		b.acks.done(id)
+		b.inflight.done()
+	}
 	f := func() {
+		defer release()
 		if b.logger != nil {
 			handleStart := time.Now()
 			defer func() {
@@ -368,9 +384,18 @@ func (b *Bot) runHandler(h HandlerFunc, c Context, endpoint string) {
 		}
 	}
 
-	if b.synchronous {
+	b.run(c, f, release)
+}
+
+// run runs f of the context the way the handlers are: right away, by the dispatcher or in a goroutine.
+// release is called instead of f, if the dispatcher drops it on stop.
+func (b *Bot) run(c Context, f, release func()) {
+	switch {
+	case b.synchronous:
 		f()
-	} else {
+	case b.dispatcher != nil:
+		b.dispatcher.dispatch(c, f, release)
+	default:
 		go f()
 	}
 }
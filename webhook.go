@@ -2,10 +2,16 @@ package tg
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // A WebhookTLS specifies the path to a key and a cert so the poller can open
@@ -36,7 +42,7 @@ type WebhookEndpoint struct {
 // bot you should consider to use the LongPoller instead of a WebHook.
 //
 // You can also leave the Listen field empty. In this case it is up to the caller to
-// add the Webhook to a http-mux.
+// add the Webhook to a http-mux, see Mount.
 type Webhook struct {
 	Listen         string   `json:"url"`
 	MaxConnections int      `json:"max_connections"`
@@ -55,10 +61,57 @@ type Webhook struct {
 	TLS      *WebhookTLS
 	Endpoint *WebhookEndpoint
 
-	dest chan<- Update
-	bot  *Bot
+	// ReplyAfterHandling makes the webhook reply to telegram only after the handlers of the update finish,
+	// so telegram redelivers the update, if the bot crashes meanwhile. Slow handlers hold the connections
+	// (see MaxConnections) and could exceed the timeout of telegram, which also leads to redelivery,
+	// so handlers should be idempotent, see middleware.Dedup.
+	ReplyAfterHandling bool `json:"-"`
+
+	// ReplyInResponse puts the first Context.Send, Reply or Respond of the handlers of an update
+	// into the response to the webhook request, which saves a request and its latency.
+	// The request is held until the handlers finish, so it's sent as usual, if the handlers
+	// make one more request, or don't finish within ReplyTimeout. Requests, which upload files, are sent as usual.
+	// Any request of the bot to the same chat, i.e. Context.Edit or Bot.Send, sends the held one first,
+	// so the order within the chat is kept. The requests to the other chats or without a chat could go first.
+	//
+	// Telegram doesn't tell the result of a request in the response, so its errors are not reported,
+	// and the returned message is empty.
+	ReplyInResponse bool `json:"-"`
+	// ReplyTimeout limits the wait for the handlers with ReplyInResponse, defaulted to 1 second.
+	ReplyTimeout time.Duration `json:"-"`
+
+	// Path is the path of the webhook on the listener, defaulted to "/".
+	// It's appended to the URL, sent to telegram, unless Endpoint is set.
+	Path string `json:"-"`
+	// HealthPath is the path of the health endpoint, defaulted to "/healthz".
+	// It replies 200, while the bot is polling, and 503 otherwise.
+	HealthPath string `json:"-"`
+
+	// AllowedIPs are the CIDRs of the allowed clients, i.e. TelegramSubnets. Empty means any.
+	AllowedIPs []string `json:"-"`
+	// TrustedProxies are the CIDRs of the proxies, which X-Forwarded-For and X-Real-IP headers are trusted.
+	TrustedProxies []string `json:"-"`
+
+	// MaxBodySize limits the size of an update in bytes, defaulted to 1 MiB.
+	MaxBodySize int64 `json:"-"`
+
+	mu             sync.RWMutex
+	dest           chan<- Update
+	bot            *Bot
+	allowedNets    []*net.IPNet
+	trustedProxies []*net.IPNet
 }
 
+// TelegramSubnets are the subnets, telegram sends the webhook requests from.
+var TelegramSubnets = []string{"149.154.160.0/20", "91.108.4.0/22"}
+
+const (
+	// defaultWebhookBodySize is the default of Webhook.MaxBodySize.
+	defaultWebhookBodySize = 1 << 20
+	// defaultWebhookReplyTimeout is the default of Webhook.ReplyTimeout.
+	defaultWebhookReplyTimeout = time.Second
+)
+
 func (h *Webhook) getFiles() map[string]File {
 	m := make(map[string]File)
 
@@ -102,13 +155,13 @@ func (h *Webhook) getParams() map[string]string {
 	}
 
 	if h.TLS != nil {
-		params["url"] = "https://" + h.Listen
+		params["url"] = "https://" + h.Listen + h.Path
 	} else {
 		// this will not work with telegram, they want TLS
 		// but i allow this because telegram will send an error
 		// when you register this hook. in their docs they write
 		// that port 80/http is allowed ...
-		params["url"] = "http://" + h.Listen
+		params["url"] = "http://" + h.Listen + h.Path
 	}
 	if h.Endpoint != nil {
 		params["url"] = h.Endpoint.PublicURL
@@ -117,57 +170,265 @@ func (h *Webhook) getParams() map[string]string {
 }
 
 func (h *Webhook) Poll(b *Bot, dest chan Update, stop chan struct{}) {
-	if err := b.SetWebhook(h); err != nil {
+	if err := h.parseNets(); err != nil {
 		b.OnError(err, nil)
-		close(stop)
 		return
 	}
+	// store the variables so the HTTP-handler can use 'em,
+	// attached before the webhook is set, so the handlers, added meanwhile, resubscribe it
+	h.attach(b, dest)
+	defer h.attach(nil, nil)
 
-	// store the variables so the HTTP-handler can use 'em
-	h.dest = dest
-	h.bot = b
+	b.subscribeMu.Lock()
+	err := b.SetWebhook(h)
+	b.subscribeMu.Unlock()
+	if err != nil {
+		b.OnError(err, nil)
+		return
+	}
 
 	if h.Listen == "" {
-		h.waitForStop(stop)
+		<-stop
 		return
 	}
 
+	mux := http.NewServeMux()
+	h.Mount(mux)
 	s := &http.Server{
 		Addr:    h.Listen,
-		Handler: h,
+		Handler: mux,
 	}
 
-	go func(stop chan struct{}) {
-		h.waitForStop(stop)
+	go func() {
+		<-stop
 		s.Shutdown(context.Background())
-	}(stop)
+	}()
 
 	if h.TLS != nil {
-		s.ListenAndServeTLS(h.TLS.Cert, h.TLS.Key)
+		err = s.ListenAndServeTLS(h.TLS.Cert, h.TLS.Key)
 	} else {
-		s.ListenAndServe()
+		err = s.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
+		b.OnError(err, nil)
+		<-stop
+	}
+}
+
+func (h *Webhook) attach(b *Bot, dest chan<- Update) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.bot, h.dest = b, dest
+}
+
+func (h *Webhook) attached() (*Bot, chan<- Update) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.bot, h.dest
+}
+
+// Mount registers the webhook at Path and the health endpoint at HealthPath,
+// so the webhook could share a server. Leave Listen empty then.
+func (h *Webhook) Mount(mux *http.ServeMux) {
+	path, healthPath := h.Path, h.HealthPath
+	if path == "" {
+		path = "/"
+	}
+	if healthPath == "" {
+		healthPath = "/healthz"
+	}
+	mux.Handle(path, h)
+	mux.HandleFunc(healthPath, h.serveHealth)
+}
+
+func (h *Webhook) serveHealth(w http.ResponseWriter, r *http.Request) {
+	if b, _ := h.attached(); b == nil {
+		http.Error(w, "not polling", http.StatusServiceUnavailable)
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
+
+// parseNets parses AllowedIPs and TrustedProxies.
+func (h *Webhook) parseNets() error {
+	allowed, err := parseCIDRs(h.AllowedIPs)
+	if err != nil {
+		return err
 	}
+	trusted, err := parseCIDRs(h.TrustedProxies)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.allowedNets, h.trustedProxies = allowed, trusted
+	return nil
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	nets := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("telebot: webhook: %v", err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
 }
 
-func (h *Webhook) waitForStop(stop chan struct{}) {
-	<-stop
-	close(stop)
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the IP of the client, trusting the proxy headers only from the trusted proxies.
+func (h *Webhook) clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+
+	h.mu.RLock()
+	trusted := h.trustedProxies
+	h.mu.RUnlock()
+	if ip == nil || !containsIP(trusted, ip) {
+		return ip
+	}
+
+	// the rightmost address, which is not a trusted proxy, is the client
+	forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if hop == nil {
+			break
+		}
+		ip = hop
+		if !containsIP(trusted, hop) {
+			return hop
+		}
+	}
+	if realIP := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); realIP != nil {
+		return realIP
+	}
+	return ip
 }
 
 // The handler simply reads the update from the body of the requests
-// and writes them to the update channel.
+// and writes them to the update channel. With ReplyAfterHandling,
+// it waits for the handlers of the update before replying.
+//
+// It replies 405 on a non-POST request, 403 to a client, which is not in AllowedIPs,
+// 401 on an invalid secret token, 413 on a body over MaxBodySize, 400 on an invalid update,
+// and 503 with Retry-After, if the bot is not polling, or Bot.Updates is full.
 func (h *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if h.SecretToken != "" && r.Header.Get("X-Telegram-Bot-Api-Secret-Token") != h.SecretToken {
-		h.bot.debug(fmt.Errorf("invalid secret token in request"))
+	b, dest := h.attached()
+	if b == nil {
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "not polling", http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	h.mu.RLock()
+	allowed := h.allowedNets
+	h.mu.RUnlock()
+	if len(allowed) > 0 {
+		if ip := h.clientIP(r); ip == nil || !containsIP(allowed, ip) {
+			b.debug(fmt.Errorf("webhook request from a forbidden address %s", r.RemoteAddr))
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+	}
+
+	token := r.Header.Get("X-Telegram-Bot-Api-Secret-Token")
+	if h.SecretToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(h.SecretToken)) != 1 {
+		b.debug(fmt.Errorf("invalid secret token in request"))
+		http.Error(w, "invalid secret token", http.StatusUnauthorized)
 		return
 	}
 
+	maxBodySize := h.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = defaultWebhookBodySize
+	}
 	var update Update
-	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
-		h.bot.debug(fmt.Errorf("cannot decode update: %v", err))
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&update); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			b.debug(fmt.Errorf("webhook update is over %d bytes", maxBodySize))
+			http.Error(w, "update is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		b.debug(fmt.Errorf("cannot decode update: %v", err))
+		http.Error(w, "cannot decode update", http.StatusBadRequest)
+		return
+	}
+
+	wait := h.ReplyAfterHandling || h.ReplyInResponse
+	var reply *webhookReply
+	if wait {
+		b.acks.track(update.ID)
+	}
+	if h.ReplyInResponse {
+		reply = &webhookReply{bot: b}
+		b.replies.put(update.ID, reply)
+		defer b.replies.take(update.ID)
+	}
+	select {
+	case dest <- update:
+	default:
+		if wait {
+			b.acks.done(update.ID)
+		}
+		b.debug(fmt.Errorf("webhook update %d is rejected, the updates channel is full", update.ID))
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "too many updates", http.StatusServiceUnavailable)
 		return
 	}
-	h.dest <- update
+	if !wait {
+		return
+	}
+
+	handled := b.acks.wait(update.ID)
+	if reply != nil {
+		timeout := h.ReplyTimeout
+		if timeout <= 0 {
+			timeout = defaultWebhookReplyTimeout
+		}
+		timer := b.clock.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case <-handled:
+			if body := reply.take(); body != nil {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(body)
+				return
+			}
+		case <-timer.C():
+			reply.expire()
+		case <-r.Context().Done():
+			reply.expire()
+			return
+		}
+	}
+
+	if h.ReplyAfterHandling {
+		select {
+		case <-handled:
+		case <-r.Context().Done():
+		}
+	}
 }
 
 // Webhook returns the current webhook status.
@@ -189,7 +450,12 @@ func (b *Bot) Webhook() (*Webhook, error) {
 // SetWebhook configures a bot to receive incoming
 // updates via an outgoing webhook.
 func (b *Bot) SetWebhook(w *Webhook) error {
-	_, err := b.sendFiles("setWebhook", w.getFiles(), w.getParams())
+	params := w.getParams()
+	if allowed := b.AllowedUpdates(); len(w.AllowedUpdates) == 0 && len(allowed) > 0 {
+		data, _ := json.Marshal(allowed)
+		params["allowed_updates"] = string(data)
+	}
+	_, err := b.sendFiles("setWebhook", w.getFiles(), params)
 	return err
 }
 
@@ -0,0 +1,161 @@
+package tg
+
+import (
+	"encoding/json"
+	"github.com/heilkit/tg/botapi"
+	"sync"
+)
+
+// webhookReply holds the first request of the handler of a webhook update, so it's sent in the response,
+// see Webhook.ReplyInResponse.
+type webhookReply struct {
+	bot *Bot
+
+	mu      sync.Mutex
+	closed  bool
+	method  string
+	payload json.RawMessage
+	// chat is the chat_id of the held request
+	chat string
+}
+
+// send holds the request of fn, if it's the first one and the webhook is still waiting,
+// otherwise it sends the held request, if any, and then runs fn as usual, i.e. without a recorder.
+// The reply could be nil, then fn is just run.
+func (reply *webhookReply) send(fn func(rec *jobRecorder) error) error {
+	if reply == nil {
+		return fn(nil)
+	}
+
+	reply.mu.Lock()
+	if !reply.closed && reply.method == "" {
+		defer reply.mu.Unlock()
+		method, payload, err := reply.bot.record(fn)
+		if err != nil {
+			// i.e. a local file to upload, the response body can't carry it
+			reply.closed = true
+			return fn(nil)
+		}
+		reply.method, reply.payload = method, payload
+		params, _ := botapi.EncodeParams(payload)
+		reply.chat = params["chat_id"]
+		reply.bot.replies.hold(reply)
+		return nil
+	}
+	// the messages must go in order, so the held one goes first
+	reply.closed = true
+	reply.flushLocked()
+	reply.mu.Unlock()
+	return fn(nil)
+}
+
+// take closes the reply and returns the held request as the response body, or nil.
+func (reply *webhookReply) take() []byte {
+	reply.mu.Lock()
+	defer reply.mu.Unlock()
+
+	reply.closed = true
+	if reply.method == "" {
+		return nil
+	}
+	body := map[string]json.RawMessage{}
+	if err := json.Unmarshal(reply.payload, &body); err != nil {
+		reply.flushLocked()
+		return nil
+	}
+	body["method"], _ = json.Marshal(reply.method)
+	data, err := json.Marshal(body)
+	if err != nil {
+		reply.flushLocked()
+		return nil
+	}
+	reply.bot.replies.release(reply)
+	reply.method, reply.payload = "", nil
+	return data
+}
+
+// expire closes the reply and sends the held request as usual.
+func (reply *webhookReply) expire() {
+	reply.mu.Lock()
+	defer reply.mu.Unlock()
+
+	reply.closed = true
+	reply.flushLocked()
+}
+
+func (reply *webhookReply) flushLocked() {
+	if reply.method == "" {
+		return
+	}
+	reply.bot.replies.release(reply)
+	method, payload := reply.method, reply.payload
+	reply.method, reply.payload = "", nil
+	if _, err := reply.bot.Raw(method, payload); err != nil {
+		reply.bot.OnError(err, nil)
+	}
+}
+
+// webhookReplies are the replies of the webhook updates, which are not processed yet,
+// and the ones, which hold a request.
+type webhookReplies struct {
+	mu      sync.Mutex
+	replies map[int]*webhookReply
+	held    map[*webhookReply]struct{}
+}
+
+func (replies *webhookReplies) put(id int, reply *webhookReply) {
+	replies.mu.Lock()
+	defer replies.mu.Unlock()
+
+	if replies.replies == nil {
+		replies.replies = make(map[int]*webhookReply)
+	}
+	replies.replies[id] = reply
+}
+
+// take removes the reply of the update, returning it or nil.
+func (replies *webhookReplies) take(id int) *webhookReply {
+	replies.mu.Lock()
+	defer replies.mu.Unlock()
+
+	reply := replies.replies[id]
+	delete(replies.replies, id)
+	return reply
+}
+
+func (replies *webhookReplies) hold(reply *webhookReply) {
+	replies.mu.Lock()
+	defer replies.mu.Unlock()
+
+	if replies.held == nil {
+		replies.held = make(map[*webhookReply]struct{})
+	}
+	replies.held[reply] = struct{}{}
+}
+
+func (replies *webhookReplies) release(reply *webhookReply) {
+	replies.mu.Lock()
+	defer replies.mu.Unlock()
+	delete(replies.held, reply)
+}
+
+// flushChat sends the held requests to the chat, so they go before any other request to it,
+// i.e. Context.Edit or Bot.Send of a handler.
+func (replies *webhookReplies) flushChat(chat string) {
+	if chat == "" {
+		return
+	}
+
+	replies.mu.Lock()
+	var flush []*webhookReply
+	for reply := range replies.held {
+		if reply.chat == chat {
+			flush = append(flush, reply)
+		}
+	}
+	replies.mu.Unlock()
+
+	for _, reply := range flush {
+		reply.expire()
+	}
+}
@@ -0,0 +1,152 @@
+package tg
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookServeHTTP(t *testing.T) {
+	b, err := NewBot(Settings{Offline: true, Updates: 1})
+	require.NoError(t, err)
+
+	hook := &Webhook{
+		Path:           "/hook",
+		SecretToken:    "secret",
+		AllowedIPs:     TelegramSubnets,
+		TrustedProxies: []string{"10.0.0.0/8"},
+		MaxBodySize:    64,
+	}
+	require.NoError(t, hook.parseNets())
+	mux := http.NewServeMux()
+	hook.Mount(mux)
+
+	const update = `{"update_id":1}`
+	serve := func(method, path, remote, body string, headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		r.RemoteAddr = remote
+		r.Header.Set("X-Telegram-Bot-Api-Secret-Token", "secret")
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		return w
+	}
+	const telegram = "149.154.167.1:443"
+
+	assert.Equal(t, http.StatusServiceUnavailable, serve("GET", "/healthz", telegram, "", nil).Code)
+	assert.Equal(t, http.StatusServiceUnavailable, serve("POST", "/hook", telegram, update, nil).Code)
+
+	hook.attach(b, b.Updates)
+	assert.Equal(t, http.StatusOK, serve("GET", "/healthz", telegram, "", nil).Code)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, serve("GET", "/hook", telegram, "", nil).Code)
+	assert.Equal(t, http.StatusForbidden, serve("POST", "/hook", "1.2.3.4:443", update, nil).Code)
+	assert.Equal(t, http.StatusForbidden, serve("POST", "/hook", "1.2.3.4:443", update,
+		map[string]string{"X-Forwarded-For": "149.154.167.1"}).Code, "the header of an untrusted client")
+	assert.Equal(t, http.StatusForbidden, serve("POST", "/hook", "10.0.0.1:443", update,
+		map[string]string{"X-Forwarded-For": "149.154.167.1, 1.2.3.4"}).Code, "the client is the last untrusted hop")
+	assert.Equal(t, http.StatusUnauthorized, serve("POST", "/hook", telegram, update,
+		map[string]string{"X-Telegram-Bot-Api-Secret-Token": "wrong"}).Code)
+	assert.Equal(t, http.StatusBadRequest, serve("POST", "/hook", telegram, "{", nil).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, serve("POST", "/hook", telegram,
+		`{"update_id":1,"message":{"text":"`+strings.Repeat("a", 64)+`"}}`, nil).Code)
+
+	assert.Equal(t, http.StatusOK, serve("POST", "/hook", "10.0.0.1:443", update,
+		map[string]string{"X-Forwarded-For": "1.2.3.4, 149.154.167.1, 10.0.0.2"}).Code)
+	assert.Len(t, b.Updates, 1)
+
+	w := serve("POST", "/hook", telegram, update, nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, "the updates channel is full")
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+}
+
+func TestWebhookParams(t *testing.T) {
+	hook := &Webhook{Listen: "example.com:8443", Path: "/hook", TLS: &WebhookTLS{}}
+	assert.Equal(t, "https://example.com:8443/hook", hook.getParams()["url"])
+
+	hook.AllowedIPs = []string{"not a cidr"}
+	assert.Error(t, hook.parseNets())
+}
+
+func TestWebhookReplyInResponse(t *testing.T) {
+	serve := func(hook *Webhook, text string) chan *httptest.ResponseRecorder {
+		done := make(chan *httptest.ResponseRecorder, 1)
+		go func() {
+			body := `{"update_id":1,"message":{"message_id":1,"chat":{"id":1},"text":"` + text + `"}}`
+			w := httptest.NewRecorder()
+			hook.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+			done <- w
+		}()
+		return done
+	}
+
+	b, clk, requests := newJobsTestBot(t, nil)
+	b.Poller = &stopPoller{}
+	release := make(chan struct{})
+	b.Handle(OnText, func(c Context) error {
+		if c.Text() == "slow" {
+			<-release
+		}
+		if err := c.Send("one"); err != nil {
+			return err
+		}
+		switch c.Text() {
+		case "two":
+			return c.Send("two")
+		case "direct":
+			_, err := c.Bot().Send(c.Chat(), "direct")
+			return err
+		}
+		return nil
+	})
+	hook := &Webhook{ReplyInResponse: true}
+	hook.attach(b, b.Updates)
+	go b.Start()
+	defer b.Stop()
+
+	t.Run("Response", func(t *testing.T) {
+		w := <-serve(hook, "one")
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"method":"sendMessage","chat_id":"1","text":"one"}`, w.Body.String())
+		assert.Empty(t, requests())
+	})
+
+	t.Run("Multiple", func(t *testing.T) {
+		w := <-serve(hook, "two")
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, []jobRequest{
+			{"sendMessage", map[string]string{"chat_id": "1", "text": "one"}},
+			{"sendMessage", map[string]string{"chat_id": "1", "text": "two"}},
+		}, requests())
+	})
+
+	t.Run("Direct", func(t *testing.T) {
+		before := len(requests())
+		w := <-serve(hook, "direct")
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, []jobRequest{
+			{"sendMessage", map[string]string{"chat_id": "1", "text": "one"}},
+			{"sendMessage", map[string]string{"chat_id": "1", "text": "direct"}},
+		}, requests()[before:])
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		before := len(requests())
+		done := serve(hook, "slow")
+		clk.BlockUntil(1)
+		clk.Advance(time.Second)
+		w := <-done
+		assert.Empty(t, w.Body.String())
+
+		close(release)
+		assert.Eventually(t, func() bool { return len(requests()) == before+1 }, time.Second, time.Millisecond)
+		assert.Equal(t, "one", requests()[before].params["text"])
+	})
+}
@@ -0,0 +1,200 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WorkspaceMarker is a part of the names of all files created by Workspace.CreateTemp.
+// It lets NewBot tell orphaned temporaries of previous runs from the rest of the files.
+const WorkspaceMarker = "_heilkit_tg_ws"
+
+// ErrWorkspaceQuota is returned, when temporaries of a workspace take more disk space than allowed.
+var ErrWorkspaceQuota = errors.New("telebot: workspace disk quota exceeded")
+
+// WorkspaceSettings configure workspaces of media modifiers.
+type WorkspaceSettings struct {
+	// Quota limits the total size of temporaries of a single media item in bytes, 0 means no limit.
+	Quota int64
+
+	// SweepDirs are swept from orphaned temporaries on NewBot, defaulted to os.TempDir().
+	SweepDirs []string
+
+	// SweepAge is the minimal age of an orphaned temporary to be removed, defaulted to an hour.
+	SweepAge time.Duration
+
+	// NoSweep disables sweeping on NewBot.
+	NoSweep bool
+}
+
+// Workspace owns temporary files created by media modifiers.
+// Senders create a workspace per media item and clean it up once the item is sent,
+// whether the modifiers succeeded, failed or panicked.
+type Workspace struct {
+	mu    sync.Mutex
+	files []string
+	quota int64
+	ctx   context.Context
+}
+
+// NewWorkspace creates a workspace, which temporaries may take at most quota bytes, 0 means no limit.
+// Make sure to call Cleanup, when the files are no longer needed.
+func NewWorkspace(quota int64) *Workspace {
+	return &Workspace{quota: quota}
+}
+
+// NewWorkspace creates a workspace with the bot's quota, see Settings.Workspace.
+func (b *Bot) NewWorkspace() *Workspace {
+	return NewWorkspace(b.workspace.Quota)
+}
+
+// WithContext sets the context of the workspace, the modifiers are stopped, once it's done.
+func (ws *Workspace) WithContext(ctx context.Context) *Workspace {
+	ws.ctx = ctx
+	return ws
+}
+
+// Context is done, when the modifiers should stop, i.e. another item of PrepareMedia failed.
+// Modifiers pass it on to the long-running processes, see tgproc.WithContext.
+func (ws *Workspace) Context() context.Context {
+	if ws == nil || ws.ctx == nil {
+		return context.Background()
+	}
+	return ws.ctx
+}
+
+// CreateTemp works like os.CreateTemp, but the file is owned by the workspace and named with WorkspaceMarker.
+// The marker is put right after the random part, so the extension of the pattern is preserved.
+func (ws *Workspace) CreateTemp(dir, pattern string) (*os.File, error) {
+	if err := ws.Check(); err != nil {
+		return nil, err
+	}
+
+	if i := strings.LastIndex(pattern, "*"); i != -1 {
+		pattern = pattern[:i+1] + WorkspaceMarker + pattern[i+1:]
+	} else {
+		pattern = pattern + "*" + WorkspaceMarker
+	}
+
+	file, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return nil, err
+	}
+	ws.Track(file.Name())
+	return file, nil
+}
+
+// Track hands files created by other means (i.e. ffmpeg logs) over to the workspace.
+func (ws *Workspace) Track(filenames ...string) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	for _, filename := range filenames {
+		if filename != "" {
+			ws.files = append(ws.files, filename)
+		}
+	}
+}
+
+// Files returns the files owned by the workspace, in order of creation.
+func (ws *Workspace) Files() []string {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return append([]string{}, ws.files...)
+}
+
+// Usage returns the total size of the existing files owned by the workspace.
+func (ws *Workspace) Usage() int64 {
+	usage := int64(0)
+	for _, filename := range ws.Files() {
+		if stat, err := os.Stat(filename); err == nil {
+			usage += stat.Size()
+		}
+	}
+	return usage
+}
+
+// Check returns ErrWorkspaceQuota, if the workspace is over its quota, or the error of its context, if it's done.
+// Files are written by external processes, so the quota is checked between the steps, not while writing.
+func (ws *Workspace) Check() error {
+	if err := ws.Context().Err(); err != nil {
+		return err
+	}
+	if ws.quota <= 0 {
+		return nil
+	}
+	if usage := ws.Usage(); usage > ws.quota {
+		return fmt.Errorf("%w: %d > %d bytes", ErrWorkspaceQuota, usage, ws.quota)
+	}
+	return nil
+}
+
+// Cleanup removes all the files owned by the workspace, it's safe to call it multiple times.
+func (ws *Workspace) Cleanup() {
+	ws.mu.Lock()
+	files := ws.files
+	ws.files = nil
+	ws.mu.Unlock()
+
+	for i := len(files) - 1; i >= 0; i-- {
+		_ = os.Remove(files[i])
+	}
+}
+
+// run calls fn, turning a panic into an error, and checks the quota afterward.
+func (ws *Workspace) run(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("telebot: modifier panicked: %v", r)
+		}
+	}()
+
+	if err := fn(); err != nil {
+		return err
+	}
+	return ws.Check()
+}
+
+// applyMods runs the modifiers one by one within the workspace.
+func applyMods[T any, M ~func(T, *Workspace) error](ws *Workspace, media T, mods []M) error {
+	for _, mod := range mods {
+		if err := ws.run(func() error { return mod(media, ws) }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sweepWorkspaces removes orphaned temporaries, left by previous runs, which were killed before cleaning up.
+func sweepWorkspaces(settings WorkspaceSettings) {
+	if settings.NoSweep {
+		return
+	}
+	dirs := settings.SweepDirs
+	if len(dirs) == 0 {
+		dirs = []string{os.TempDir()}
+	}
+	age := settings.SweepAge
+	if age <= 0 {
+		age = time.Hour
+	}
+
+	for _, dir := range dirs {
+		matches, err := filepath.Glob(filepath.Join(dir, "*"+WorkspaceMarker+"*"))
+		if err != nil {
+			continue
+		}
+		for _, filename := range matches {
+			stat, err := os.Lstat(filename)
+			if err != nil || !stat.Mode().IsRegular() || time.Since(stat.ModTime()) < age {
+				continue
+			}
+			_ = os.Remove(filename)
+		}
+	}
+}
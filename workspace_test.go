@@ -0,0 +1,108 @@
+package tg
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspace(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("CreateTemp", func(t *testing.T) {
+		ws := NewWorkspace(0)
+		tmp, err := ws.CreateTemp(dir, "*_note.mp4")
+		require.NoError(t, err)
+		tmp.Close()
+		assert.True(t, strings.HasSuffix(tmp.Name(), WorkspaceMarker+"_note.mp4"))
+
+		tmp, err = ws.CreateTemp(dir, "prefix")
+		require.NoError(t, err)
+		tmp.Close()
+		assert.True(t, strings.HasSuffix(tmp.Name(), WorkspaceMarker))
+
+		ws.Track(filepath.Join(dir, "missing"))
+		assert.Len(t, ws.Files(), 3)
+
+		ws.Cleanup()
+		ws.Cleanup()
+		assert.Empty(t, ws.Files())
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Quota", func(t *testing.T) {
+		ws := NewWorkspace(4)
+		defer ws.Cleanup()
+
+		mod := func(photo *Photo, ws *Workspace) error {
+			tmp, err := ws.CreateTemp(dir, "*.jpg")
+			if err != nil {
+				return err
+			}
+			defer tmp.Close()
+			_, err = tmp.Write([]byte("too large"))
+			return err
+		}
+		err := applyMods(ws, &Photo{}, []ImageModifier{mod})
+		assert.ErrorIs(t, err, ErrWorkspaceQuota)
+
+		_, err = ws.CreateTemp(dir, "*.jpg")
+		assert.ErrorIs(t, err, ErrWorkspaceQuota)
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		ws := NewWorkspace(0)
+		errMod := errors.New("mod failed")
+
+		created := ""
+		mods := []VideoModifier{
+			func(video *Video, ws *Workspace) error {
+				tmp, err := ws.CreateTemp(dir, "*.mp4")
+				if err != nil {
+					return err
+				}
+				created = tmp.Name()
+				return tmp.Close()
+			},
+			func(video *Video, ws *Workspace) error {
+				panic(errMod)
+			},
+			func(video *Video, ws *Workspace) error {
+				t.Fatal("modifiers after the failed one must not run")
+				return nil
+			},
+		}
+		err := applyMods(ws, &Video{}, mods)
+		assert.ErrorContains(t, err, "mod failed")
+
+		ws.Cleanup()
+		assert.NoFileExists(t, created)
+	})
+
+	t.Run("Sweep", func(t *testing.T) {
+		orphan := filepath.Join(dir, "123"+WorkspaceMarker+".mp4")
+		fresh := filepath.Join(dir, "456"+WorkspaceMarker+".mp4")
+		other := filepath.Join(dir, "789_heilkit_tg.mp4")
+		for _, filename := range []string{orphan, fresh, other} {
+			require.NoError(t, os.WriteFile(filename, nil, 0o644))
+		}
+		old := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(orphan, old, old))
+		require.NoError(t, os.Chtimes(other, old, old))
+
+		sweepWorkspaces(WorkspaceSettings{SweepDirs: []string{dir}})
+		assert.NoFileExists(t, orphan)
+		assert.FileExists(t, fresh)
+		assert.FileExists(t, other)
+
+		sweepWorkspaces(WorkspaceSettings{SweepDirs: []string{dir}, SweepAge: time.Nanosecond, NoSweep: true})
+		assert.FileExists(t, fresh)
+	})
+}